@@ -3,6 +3,8 @@ package commands
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -16,27 +18,74 @@ import (
 	yaml "gopkg.in/yaml.v3"
 
 	"kira/internal/config"
+	"kira/internal/validation"
 )
 
 // AssignFlags holds all flags for the assign command.
 type AssignFlags struct {
 	Field       string
+	FieldSet    bool // --field given explicitly; overrides assignment.field_defaults for all items
 	Append      bool
 	Unassign    bool
 	Interactive bool
 	DryRun      bool
+	PruneEmpty  bool   // with Unassign: remove a nested parent map left empty
+	Force       bool   // allow a plain set to replace an array that holds other assignees
+	KnownOnly   bool   // only assign users listed in users.saved_users (no git-history users)
+	JSON        bool   // print results as a JSON array instead of human-readable output
+	SummaryOnly bool   // print only the "N succeeded, M failed" line
+	Pick        bool   // select work items from a numbered list instead of passing IDs
+	PickStatus  string // with Pick: only list work items in this status
+	// FromCodeowners appends the CODEOWNERS owners of each work item's paths to the field
+	FromCodeowners bool
+	Paths          []string // with FromCodeowners: paths to look up instead of the paths front matter field
+	MoveTo         string   // also move each work item to this status, in the same write as the assignment
+	Changelog      string   // append a line per successful change to this file ("" = no changelog)
+	FileList       string   // read work item paths from this file, one per line ("-" = stdin)
+	MaxBatch       int      // process work items in chunks of this size with a resume file (0 = single pass)
+	Resume         bool     // continue the work items listed in the resume file of an interrupted run
+	// AssigneeDisplay selects how users are shown in messages: both, name, or email
+	AssigneeDisplay string
+	Strict          bool   // fail instead of warning when a work item is in a terminal status
+	Due             string // with DueSet: write this date to the due field ("" removes it)
+	DueSet          bool   // --due given explicitly, possibly as "" to clear the due date
+	Message         string // with MessageSet: write this note to the assign_note field ("" removes it)
+	MessageSet      bool   // --message given explicitly, possibly as "" to clear the note
+	ForceType       bool   // with append: allow appending to a field holding a non-user scalar (e.g. a number)
+	RoundRobin      string // assign the work items to the members of this team (assignment.teams) in turn
+	IfAssignee      string // with Unassign: only remove this person (a user identifier) from the field
+	IfAssigneeUser  *UserInfo
+	Swap            bool // replace the user of the first argument with the second in every work item naming them
 }
 
 // Operation name for "no change, already assigned to same user".
 const opAlreadyAssigned = "already_assigned"
 
+// authorIdentifier is the user identifier that resolves to the last git author of each work item file.
+const authorIdentifier = "@author"
+
 // WorkItemUpdateResult tracks the result of updating a single work item.
 type WorkItemUpdateResult struct {
 	WorkItemPath string
 	WorkItemID   string // Display identifier (ID or path)
 	Success      bool
 	Error        error
-	Operation    string // "assign", "unassign", "append", or opAlreadyAssigned
+	Operation    string        // "assign", "unassign", "append", "swap", opAlreadyAssigned, or opSkippedNotAssignee
+	Field        string        // Target field used for this work item
+	MovedTo      string        // Status the work item was moved to (--move)
+	NewID        string        // --move with naming.per_folder_ids: the ID the work item was renumbered to
+	User         string        // Assign/append: email(s) of the user(s) written to the field; --if-assignee: the person
+	Note         string        // The --message note written with the assignment, for the changelog
+	Cleared      []string      // Unassign: the fields that were present and cleared
+	Would        *AssignIntent // Dry-run only: the operation a real run would perform
+}
+
+// AssignIntent describes the operation a dry-run would perform on a work item.
+type AssignIntent struct {
+	Operation string // "assign", "unassign", "append", or "swap"
+	Field     string
+	User      string // Email of the target user; empty for unassign
+	MoveTo    string // Status the work item would be moved to (--move)
 }
 
 var assignCmd = &cobra.Command{
@@ -47,7 +96,81 @@ in the work item's front matter.
 
 Work items can be specified by numeric ID (e.g. 001) or by full path to the
 work item file under the .work/ directory. User identifiers can be numeric
-user numbers from ` + "`kira users`" + `, email addresses, or names.
+user numbers from ` + "`kira users`" + `, email addresses, or names. The literal
+@author resolves, per work item, to the last git author of the work item file.
+
+To give each work item its own assignee, pass id=user pairs instead of a
+trailing user (pairs cannot be mixed with a bare user identifier). Each work
+item may appear only once: 001=alice 001=bob fails before anything is written.
+
+With --pick, work items are chosen from a numbered list (optionally limited to
+one --status) instead of by ID; enter numbers separated by commas or spaces, or
+"all". Only the user identifier is passed as an argument (or use --interactive).
+
+With --interactive, enter a user number, several comma-separated numbers (e.g.
+1,3,4) to append all of them to the field, or 0 to unassign.
+
+--append refuses a field whose current value does not look like a user, such
+as estimate: 5 or blocked: true, instead of turning it into a list; pass
+--force-type to append anyway.
+
+With --move <status>, each work item is also moved to that status folder. The
+assignment, status and updated timestamp are written together: if either the
+assignment or the move fails, the work item is left unchanged. With
+naming.per_folder_ids the new file name and id are part of that write.
+
+With --changelog <path> (or assignment.changelog: true in kira.yml, which writes to
+<work folder>/CHANGELOG.md), a dated line is appended per change, e.g.
+"2024-01-01 assign 001 -> alice@example.com by bob@example.com". Dry runs write nothing.
+
+With assignment.value_transforms in kira.yml, the assignee value is rewritten by regex
+replacements before it is written, e.g. alice+review@gmail.com -> alice@gmail.com. A
+work item whose value cannot be transformed fails and is left unchanged.
+
+With assignment.rich_assignee: true in kira.yml, assignees are written as objects
+recording who was assigned and since when ({email: ..., since: ...}) instead of the
+plain email; --append dedups them by email.
+
+With --file-list <path> (or --stdin-paths, the same as --file-list -), the work item
+paths are read one per line from the file or stdin, e.g. from fd or grep, and only the
+user identifier is passed as an argument. Paths that are not work items under the work
+folder are reported per line in the summary; the other work items are still assigned.
+
+With --max-batch N, work items are processed in chunks of N with a checkpoint line after
+each chunk. The work items not yet finished are kept in <work folder>/.kira-assign-resume;
+if the run is interrupted, continue it with --resume and the same user identifier and
+flags (the resume file replaces the work item arguments).
+
+With --set-from-codeowners, no user identifier is given: the owners of the paths a
+work item touches (its paths front matter field, or --paths) are looked up in the
+repository's CODEOWNERS file, resolved to users, and appended to the field
+(reviewers unless --field is given).
+
+Assigning a work item in a terminal status (terminal_statuses in kira.yml, default
+done, released and abandoned) prints a warning naming the status, in every mode; with
+--strict that work item is left unassigned and the command fails. Move the work item
+back first, or pass --move, to reopen it.
+
+With --round-robin <team>, no user identifier is given: the work items are assigned in
+turn to the members of that team (assignment.teams in kira.yml), starting at the team's
+cursor. The mapping is printed, and the cursor is saved to kira.yml so the next run
+continues the rotation. --dry-run shows the planned distribution only.
+
+With --unassign --if-assignee <user>, only work items assigned to that person are
+changed: a single assignee is cleared, and a list loses only that person. Other work
+items are reported as "skipped: not assigned to <user>", and the summary counts
+cleared and skipped work items.
+
+With --swap <from> <to>, no work items are given: every work item (optionally only
+those in one --status) whose field names from gets to instead. A single assignee is
+replaced, and in a list from is replaced by to, which is kept only once. Work items in a
+terminal status (e.g. done) are left alone unless --status names it. Both users are
+resolved before anything is written; the number of work items changed is reported, and
+--dry-run lists the work items that would change.
+
+With --output json (or --json), nothing else is printed on stdout: the results are written
+as a JSON array with one object per work item (work_item_id, path, success, operation,
+field and error, null or the message), for scripts and agents.
 
 Examples:
   kira assign 001 5
@@ -55,10 +178,30 @@ Examples:
   kira assign .work/1_todo/001-test.prd.md user@example.com
   kira assign 001 --interactive
   kira assign 001 --unassign
+  kira assign 001 --unassign --field metadata.owner --prune-empty
+  kira assign 001 --unassign --field assigned,reviewer,approved_by
+  kira assign 001 002 003 --unassign --if-assignee alice@example.com --dry-run
   kira assign 001 5 --field reviewer
-  kira assign 001 5 --append`,
-	Args: cobra.MinimumNArgs(1),
-	RunE: runAssign,
+  kira assign 001=alice 002=bob 003=5
+  kira assign 001 002 003 --round-robin backend --dry-run
+  kira assign --swap alice@example.com bob@example.com --status doing --dry-run
+  kira assign --pick --status todo 5
+  kira assign --pick --interactive
+  kira assign 001 @author --field reviewer
+  kira assign 001 5 --append
+  kira assign 001 me@example.com --move doing
+  kira assign 001 --set-from-codeowners
+  kira assign 001 --field reviewers --set-from-codeowners --paths internal/api --dry-run
+  kira assign 001 002 5 --dry-run --json
+  kira assign 001 002 5 --output json
+  fd -e prd.md . .work | kira assign --stdin-paths alice@example.com
+  kira assign --file-list items.txt 5 --dry-run
+  kira assign --stdin-paths 5 --max-batch 200 < items.txt
+  kira assign --resume 5 --max-batch 200
+  kira assign 001 002 003 5 --summary-only`,
+	Args:              validateAssignArgCount,
+	ValidArgsFunction: completeWorkItemIDs,
+	RunE:              runAssign,
 }
 
 func init() {
@@ -67,6 +210,45 @@ func init() {
 	assignCmd.Flags().BoolP("unassign", "u", false, "Clear the target field (remove assignment)")
 	assignCmd.Flags().BoolP("interactive", "I", false, "Select user interactively from available users")
 	assignCmd.Flags().Bool("dry-run", false, "Preview what would be done without making changes")
+	assignCmd.Flags().Bool("force", false, "Replace the field even when it lists other assignees that a plain set would remove")
+	assignCmd.Flags().Bool("known-only", false, "Only assign users listed in users.saved_users, not people only found in git history; also set by assignment.require_known_user")
+	assignCmd.Flags().Bool("json", false, "Output results as a JSON array (with --dry-run: validation results and the intended operation)")
+	assignCmd.Flags().String("output", outputFormatText, "Output format: text or json (same as --json)")
+	assignCmd.Flags().Bool("summary-only", false, "Only print the final \"N succeeded, M failed\" line (no per-item output)")
+	assignCmd.Flags().Bool("pick", false, "Select the work items to assign from a numbered list instead of passing IDs")
+	assignCmd.Flags().String("status", "", "With --pick, only list work items in this status (e.g. todo); with --swap, only change them")
+	assignCmd.Flags().String("if-assignee", "", "With --unassign, only remove this user, and only from work items assigned to them")
+	assignCmd.Flags().Bool("prune-empty", false, "With --unassign on a nested field (parent.child), also remove the parent map if it becomes empty")
+	assignCmd.Flags().Bool("set-from-codeowners", false, "Append the CODEOWNERS owners of each work item's paths to the field (default field: reviewers)")
+	assignCmd.Flags().StringSlice("paths", nil, "With --set-from-codeowners, look up these paths instead of the work item's paths field")
+	assignCmd.Flags().String("move", "", "Also move the work items to this status; the assignment and move both happen or neither does")
+	assignCmd.Flags().String("changelog", "", "Append a dated line per change to this file (default with assignment.changelog: <work folder>/CHANGELOG.md)")
+	assignCmd.Flags().String("file-list", "", "Read work item paths from this file, one per line (- reads stdin); only the user identifier is passed as an argument")
+	assignCmd.Flags().Bool("stdin-paths", false, "Read work item paths from stdin, one per line (same as --file-list -)")
+	assignCmd.Flags().Int("max-batch", 0, "Process work items in chunks of N, printing a checkpoint and updating a resume file after each chunk")
+	assignCmd.Flags().Bool("resume", false, "Continue the work items left by an interrupted --max-batch run; only the user identifier is passed as an argument")
+	assignCmd.Flags().String("due", "", "Also write this due date (e.g. 2024-06-30) to the due field; --due \"\" clears it")
+	assignCmd.Flags().String("message", "", "Also record this note (e.g. the reason for a handoff) in the assign_note field and the changelog; --message \"\" clears it")
+	assignCmd.Flags().Bool("strict", false, "Fail instead of warning when a work item is in a terminal status (e.g. done)")
+	assignCmd.Flags().Bool("force-type", false, "Append even when the field holds a value that is not a user, such as a number or boolean")
+	assignCmd.Flags().Bool("swap", false, "Replace the user <from> with the user <to> in the field of every work item naming them (arguments: <from> <to>)")
+	assignCmd.Flags().String("round-robin", "", "Assign the work items to the members of this team (assignment.teams) in turn, continuing the rotation of the previous run")
+	assignCmd.Flags().String("assignee-display", "", "Show users as name, email, or both (\"Name <email>\"); default: output.assignee_display or both")
+}
+
+// validateAssignArgCount requires at least one work item, or with --pick, --file-list,
+// --stdin-paths or --resume at most a user identifier, or with --swap the two users.
+func validateAssignArgCount(cmd *cobra.Command, args []string) error {
+	if swap, _ := cmd.Flags().GetBool("swap"); swap {
+		return cobra.ExactArgs(2)(cmd, args)
+	}
+	pick, _ := cmd.Flags().GetBool("pick")
+	stdinPaths, _ := cmd.Flags().GetBool("stdin-paths")
+	resume, _ := cmd.Flags().GetBool("resume")
+	if pick || stdinPaths || resume || cmd.Flags().Changed("file-list") {
+		return cobra.MaximumNArgs(1)(cmd, args)
+	}
+	return cobra.MinimumNArgs(1)(cmd, args)
 }
 
 // runAssign is the entrypoint for the assign command.
@@ -86,7 +268,26 @@ func runAssign(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if err := applyAssignConfig(&flags, cfg); err != nil {
+		return err
+	}
+
+	if handled, err := runAssignArgumentMode(args, flags, cfg); handled {
+		return err
+	}
+
+	args, err = expandAssignArgs(args, flags, cfg)
+	if err != nil {
+		return err
+	}
+
 	workItems, userIdentifier := parseAssignArgs(args, flags)
+	switch {
+	case flags.RoundRobin != "":
+		return runAssignRoundRobin(workItems, flags, cfg)
+	case hasAssignPairs(workItems):
+		return runAssignPairs(workItems, flags, cfg)
+	}
 
 	if err := validateAssignInput(workItems, userIdentifier, flags, cfg); err != nil {
 		return err
@@ -99,33 +300,296 @@ func runAssign(cmd *cobra.Command, args []string) error {
 	}
 
 	// Phase 3: Collect users and resolve user identifier if provided.
-	users, err := collectUsersForAssignment(cfg)
+	users, err := collectAssignUsers(flags, cfg)
 	if err != nil {
 		return fmt.Errorf("failed to collect users: %w", err)
 	}
 
-	var resolvedUser *UserInfo
-	if userIdentifier != "" {
-		resolvedUser, err = resolveUserIdentifier(userIdentifier, users)
+	if userIdentifier == authorIdentifier {
+		return runAssignToAuthors(workItemPaths, flags, users, cfg)
+	}
+
+	resolvedUser, err := resolveAssignUsers(userIdentifier, &flags, users)
+	if err != nil {
+		return err
+	}
+
+	// Phase 8: Process work item updates with batch processing and progress
+	results, err := processAssignBatches(workItemPaths, resolvedUser, flags, users, cfg)
+	if err != nil {
+		return err
+	}
+	return handleAssignResults(results, workItemPaths, flags, resolvedUser)
+}
+
+// runAssignArgumentMode runs the modes that read their work items in their own way, --swap,
+// --file-list and --set-from-codeowners, and reports whether one of them ran.
+func runAssignArgumentMode(args []string, flags AssignFlags, cfg *config.Config) (bool, error) {
+	switch {
+	case flags.Swap:
+		return true, runAssignSwap(args, flags, cfg)
+	case flags.FileList != "":
+		return true, runAssignFromFileList(args, flags, cfg, os.Stdin)
+	case flags.FromCodeowners:
+		return true, runAssignFromCodeowners(args, flags, cfg)
+	}
+	return false, nil
+}
+
+// resolveOptionalUserIdentifier resolves userIdentifier to a user, or returns nil when it is ""
+// (unassign and interactive mode).
+func resolveOptionalUserIdentifier(userIdentifier string, users []UserInfo) (*UserInfo, error) {
+	if userIdentifier == "" {
+		return nil, nil
+	}
+	return resolveUserIdentifier(userIdentifier, users)
+}
+
+// applyAssignConfig fills in the flags that fall back to kira.yml settings and checks the
+// batch flags.
+func applyAssignConfig(flags *AssignFlags, cfg *config.Config) error {
+	flags.KnownOnly = flags.KnownOnly || requireKnownUser(cfg)
+	flags.Changelog = assignChangelogPath(flags.Changelog, cfg)
+	display, err := resolveAssigneeDisplay(flags.AssigneeDisplay, cfg)
+	if err != nil {
+		return err
+	}
+	flags.AssigneeDisplay = display
+	if err := validateAssignDue(*flags); err != nil {
+		return err
+	}
+	if err := validateAssignNote(*flags); err != nil {
+		return err
+	}
+	if err := validateRoundRobinFlags(*flags); err != nil {
+		return err
+	}
+	if err := validateIfAssigneeFlags(*flags); err != nil {
+		return err
+	}
+	return validateAssignBatchFlags(*flags)
+}
+
+// expandAssignArgs adds the work items chosen with --pick or left by an interrupted run
+// (--resume) to the arguments.
+func expandAssignArgs(args []string, flags AssignFlags, cfg *config.Config) ([]string, error) {
+	switch {
+	case flags.Pick:
+		return pickWorkItemArgs(args, flags, cfg, os.Stdin)
+	case flags.Resume:
+		return resumeWorkItemArgs(args, cfg)
+	default:
+		return args, nil
+	}
+}
+
+// runAssignToAuthors assigns each work item to the last git author of its file.
+// All authors are resolved before any work item is updated.
+func runAssignToAuthors(workItemPaths []string, flags AssignFlags, users []UserInfo, cfg *config.Config) error {
+	if err := rejectAssignBatchFlags(flags, authorIdentifier); err != nil {
+		return err
+	}
+	authors := make([]*UserInfo, len(workItemPaths))
+	for i, path := range workItemPaths {
+		author, err := resolveAuthorIdentifier(path, users, flags.KnownOnly, cfg)
 		if err != nil {
 			return err
 		}
+		authors[i] = author
 	}
+	return runAssignPerItem(workItemPaths, authors, flags, users, cfg)
+}
 
-	// Phase 8: Process work item updates with batch processing and progress
-	results := processWorkItemUpdates(workItemPaths, resolvedUser, flags, users, cfg)
-	return handleAssignResults(results, workItemPaths, flags, resolvedUser)
+// runAssignPairs assigns each work item of id=user pair tokens to its own user.
+// All users are resolved before any work item is updated.
+func runAssignPairs(tokens []string, flags AssignFlags, cfg *config.Config) error {
+	if flags.Unassign || flags.Interactive {
+		return fmt.Errorf("invalid flag combination: id=user pairs cannot be used with --unassign or --interactive")
+	}
+	if err := rejectAssignBatchFlags(flags, "id=user pairs"); err != nil {
+		return err
+	}
+	workItems, identifiers, err := splitAssignPairs(tokens)
+	if err != nil {
+		return err
+	}
+	if err := validateAssignInput(workItems, identifiers[0], flags, cfg); err != nil {
+		return err
+	}
+
+	workItemPaths, err := resolveWorkItems(workItems, cfg)
+	if err != nil {
+		return err
+	}
+	if err := checkDuplicateAssignPaths(workItemPaths, cfg); err != nil {
+		return err
+	}
+	users, err := collectAssignUsers(flags, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to collect users: %w", err)
+	}
+
+	assignees, err := resolvePairAssignees(workItems, identifiers, workItemPaths, flags, users, cfg)
+	if err != nil {
+		return err
+	}
+	return runAssignPerItem(workItemPaths, assignees, flags, users, cfg)
+}
+
+// runAssignPerItem assigns workItemPaths[i] to assignees[i] and reports all results together.
+func runAssignPerItem(workItemPaths []string, assignees []*UserInfo, flags AssignFlags, users []UserInfo, cfg *config.Config) error {
+	var results []WorkItemUpdateResult
+	for i, path := range workItemPaths {
+		results = append(results, processWorkItemUpdates([]string{path}, assignees[i], flags, users, cfg)...)
+	}
+	return handleAssignResults(results, workItemPaths, flags, singleAssignee(assignees))
+}
+
+// singleAssignee returns the user of a single work item's assignment, for its success message.
+// When several work items are assigned to their own users there is no one user to report.
+func singleAssignee(assignees []*UserInfo) *UserInfo {
+	if len(assignees) != 1 {
+		return nil
+	}
+	return assignees[0]
+}
+
+// runAssignFromCodeowners appends the CODEOWNERS owners of each work item's paths to the field.
+// Work items without paths or matching owners are reported as having nothing to do.
+func runAssignFromCodeowners(workItems []string, flags AssignFlags, cfg *config.Config) error {
+	if err := validateCodeownersFlags(workItems, flags); err != nil {
+		return err
+	}
+	if !flags.FieldSet {
+		flags.Field = "reviewers"
+		flags.FieldSet = true
+	}
+	flags.Append = true
+	if err := validateAssignMoveTarget(flags, cfg); err != nil {
+		return err
+	}
+	if err := validateWorkItemTokens(workItems, flags.Field, cfg); err != nil {
+		return err
+	}
+
+	rules, location, err := loadCodeowners(cfg)
+	if err != nil {
+		return err
+	}
+	workItemPaths, err := resolveWorkItems(workItems, cfg)
+	if err != nil {
+		return err
+	}
+	users, err := collectAssignUsers(flags, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to collect users: %w", err)
+	}
+
+	owners, err := resolveCodeownersAssignees(workItemPaths, rules, location, flags, users, cfg)
+	if err != nil {
+		return err
+	}
+
+	results, updatedPaths, lastOwner := applyCodeownerAssignments(workItemPaths, owners, flags, users, cfg)
+	if len(results) == 0 {
+		if flags.JSON {
+			return writeAssignResultsJSON(os.Stdout, results)
+		}
+		return nil
+	}
+	return handleAssignResults(results, updatedPaths, flags, lastOwner)
+}
+
+// applyCodeownerAssignments adds the owners of each work item to it. With --move all owners of
+// a work item are added in the write that moves it.
+func applyCodeownerAssignments(workItemPaths []string, owners [][]*UserInfo, flags AssignFlags, users []UserInfo, cfg *config.Config) ([]WorkItemUpdateResult, []string, *UserInfo) {
+	var results []WorkItemUpdateResult
+	var updatedPaths []string
+	var lastOwner *UserInfo
+	for i, path := range workItemPaths {
+		if len(owners[i]) == 0 {
+			continue
+		}
+		lastOwner = owners[i][len(owners[i])-1]
+		for range owners[i] {
+			updatedPaths = append(updatedPaths, path)
+		}
+		if flags.MoveTo != "" && !flags.DryRun {
+			results = append(results, processCodeownersMove(path, owners[i], flags, cfg)...)
+			continue
+		}
+		for _, owner := range owners[i] {
+			results = append(results, processWorkItemUpdates([]string{path}, owner, flags, users, cfg)...)
+		}
+	}
+	return results, updatedPaths, lastOwner
+}
+
+// validateCodeownersFlags rejects the flags and arguments --set-from-codeowners cannot be combined
+// with.
+func validateCodeownersFlags(workItems []string, flags AssignFlags) error {
+	if flags.Unassign || flags.Interactive || flags.Pick || flags.RoundRobin != "" || hasAssignPairs(workItems) {
+		return fmt.Errorf("invalid flag combination: --set-from-codeowners cannot be used with --unassign, --interactive, --pick, --round-robin or id=user pairs")
+	}
+	return nil
+}
+
+// codeownerUsersForWorkItem resolves the CODEOWNERS owners of a work item's paths to users,
+// printing why there is nothing to do when there are none.
+func codeownerUsersForWorkItem(path string, rules []codeownersRule, location string, flags AssignFlags, users []UserInfo, cfg *config.Config) ([]*UserInfo, error) {
+	displayID := getWorkItemDisplayID(path, cfg)
+	out := os.Stdout
+	if flags.JSON || flags.SummaryOnly {
+		out = os.Stderr
+	}
+
+	frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse work item %s: %w", displayID, err)
+	}
+	paths := workItemCodeownersPaths(frontMatter, flags.Paths)
+	if len(paths) == 0 {
+		fmt.Fprintf(out, "Nothing to do for work item %s: no paths (declare paths: in its front matter or use --paths)\n", displayID)
+		return nil, nil
+	}
+
+	owners := codeownersForPaths(rules, paths)
+	resolved, unresolved := resolveCodeowners(owners, users)
+	for _, owner := range unresolved {
+		fmt.Fprintf(os.Stderr, "Warning: work item %s: CODEOWNERS owner %s does not match a known user, skipping\n", displayID, owner)
+	}
+	if len(resolved) == 0 {
+		fmt.Fprintf(out, "Nothing to do for work item %s: no owners in %s match its paths\n", displayID, location)
+	}
+	return resolved, nil
 }
 
 // handleAssignResults displays batch or single-item output and returns an error if any update failed.
 func handleAssignResults(results []WorkItemUpdateResult, workItemPaths []string, flags AssignFlags, resolvedUser *UserInfo) error {
-	if len(workItemPaths) > 1 || flags.DryRun {
+	if flags.Changelog != "" && !flags.DryRun {
+		if err := appendAssignChangelog(flags.Changelog, results, time.Now()); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to update changelog %s: %v\n", flags.Changelog, err)
+		}
+	}
+	if flags.JSON {
+		if err := writeAssignResultsJSON(os.Stdout, results); err != nil {
+			return err
+		}
+	} else if flags.SummaryOnly {
+		displaySummaryLine(results)
+	} else if len(workItemPaths) > 1 || flags.DryRun {
 		displayBatchSummary(results)
 	} else if len(results) > 0 && !results[0].Success {
 		displayBatchSummary(results)
 	} else if len(results) == 1 && results[0].Success && !flags.DryRun {
 		displaySingleSuccessMessage(results[0], resolvedUser, flags)
+		displayAssignMoveResult(results[0])
 	}
+	return assignResultsError(results)
+}
+
+// assignResultsError returns an error when any work item failed to update.
+func assignResultsError(results []WorkItemUpdateResult) error {
 	for _, result := range results {
 		if !result.Success {
 			return fmt.Errorf("one or more work items failed to update")
@@ -185,6 +649,8 @@ func processUnassignWorkItem(
 	workItemPath string,
 	displayID string,
 	field string,
+	pruneEmpty bool,
+	followUps AssignFlags,
 	showProgress bool,
 	cfg *config.Config,
 ) WorkItemUpdateResult {
@@ -195,7 +661,8 @@ func processUnassignWorkItem(
 		Operation:    "unassign",
 	}
 
-	if err := updateWorkItemFieldUnassign(workItemPath, field, cfg); err != nil {
+	cleared, err := unassignWorkItemFields(workItemPath, splitAssignFields(field), pruneEmpty, followUps, cfg)
+	if err != nil {
 		result.Error = fmt.Errorf("failed to update work item %s: %w", displayID, err)
 		if showProgress {
 			displayWorkItemProgress(result)
@@ -203,6 +670,7 @@ func processUnassignWorkItem(
 		return result
 	}
 	result.Success = true
+	result.Cleared = cleared
 	if showProgress {
 		displayWorkItemProgress(result)
 	}
@@ -215,6 +683,36 @@ func processAppendWorkItem(
 	displayID string,
 	field string,
 	resolvedUser *UserInfo,
+	forceType bool,
+	followUps AssignFlags,
+	showProgress bool,
+	cfg *config.Config,
+) WorkItemUpdateResult {
+	if resolvedUser == nil {
+		result := WorkItemUpdateResult{
+			WorkItemPath: workItemPath,
+			WorkItemID:   displayID,
+			Success:      false,
+			Operation:    "append",
+			Error:        fmt.Errorf("user identifier is required for assignment"),
+		}
+		if showProgress {
+			displayWorkItemProgress(result)
+		}
+		return result
+	}
+	return processAppendUsersWorkItem(workItemPath, displayID, field, []*UserInfo{resolvedUser}, forceType, followUps, showProgress, cfg)
+}
+
+// processAppendUsersWorkItem appends every user in resolvedUsers to the field of a work item in
+// a single write. The operation is already assigned when all of them are in the field already.
+func processAppendUsersWorkItem(
+	workItemPath string,
+	displayID string,
+	field string,
+	resolvedUsers []*UserInfo,
+	forceType bool,
+	followUps AssignFlags,
 	showProgress bool,
 	cfg *config.Config,
 ) WorkItemUpdateResult {
@@ -225,15 +723,12 @@ func processAppendWorkItem(
 		Operation:    "append",
 	}
 
-	if resolvedUser == nil {
-		result.Error = fmt.Errorf("user identifier is required for assignment")
-		if showProgress {
-			displayWorkItemProgress(result)
-		}
-		return result
+	emails := make([]string, 0, len(resolvedUsers))
+	for _, resolvedUser := range resolvedUsers {
+		emails = append(emails, resolvedUser.Email)
 	}
-
-	if err := updateWorkItemFieldAppend(workItemPath, field, resolvedUser.Email, cfg); err != nil {
+	changed, err := updateWorkItemFieldAppendUsers(workItemPath, field, emails, forceType, followUps, cfg)
+	if err != nil {
 		result.Error = fmt.Errorf("failed to update work item %s: %w", displayID, err)
 		if showProgress {
 			displayWorkItemProgress(result)
@@ -241,6 +736,10 @@ func processAppendWorkItem(
 		return result
 	}
 	result.Success = true
+	result.User = strings.Join(emails, ", ")
+	if !changed {
+		result.Operation = opAlreadyAssigned
+	}
 	if showProgress {
 		displayWorkItemProgress(result)
 	}
@@ -253,6 +752,8 @@ func processAssignWorkItem(
 	displayID string,
 	field string,
 	resolvedUser *UserInfo,
+	force bool,
+	followUps AssignFlags,
 	showProgress bool,
 	cfg *config.Config,
 ) WorkItemUpdateResult {
@@ -272,33 +773,31 @@ func processAssignWorkItem(
 	}
 
 	current, err := getCurrentAssignment(workItemPath, field, cfg)
-	if err == nil && current != "" {
-		// Same user: exact email match or display format match
-		if current == resolvedUser.Email || current == formatUserDisplay(*resolvedUser) {
+	if err == nil && isCurrentAssignee(current, resolvedUser) {
+		if err := applyAssignFollowUps(workItemPath, followUps, cfg); err != nil {
+			result.Error = fmt.Errorf("failed to update the due date or note of work item %s: %w", displayID, err)
+		} else {
 			result.Success = true
 			result.Operation = opAlreadyAssigned
+		}
+		if showProgress {
+			displayWorkItemProgress(result)
+		}
+		return result
+	}
+
+	if !force {
+		if err := checkAssignWouldRemove(workItemPath, displayID, field, resolvedUser.Email, cfg); err != nil {
+			result.Error = err
 			if showProgress {
 				displayWorkItemProgress(result)
 			}
 			return result
 		}
-		// Current is comma-separated list (array display); check if email is in it
-		if strings.Contains(current, resolvedUser.Email) {
-			parts := strings.Split(current, ", ")
-			for _, p := range parts {
-				if strings.TrimSpace(p) == resolvedUser.Email {
-					result.Success = true
-					result.Operation = opAlreadyAssigned
-					if showProgress {
-						displayWorkItemProgress(result)
-					}
-					return result
-				}
-			}
-		}
 	}
 
-	if err := updateWorkItemField(workItemPath, field, resolvedUser.Email, cfg); err != nil {
+	changed, err := updateWorkItemField(workItemPath, field, resolvedUser.Email, followUps, cfg)
+	if err != nil {
 		result.Error = fmt.Errorf("failed to update work item %s: %w", displayID, err)
 		if showProgress {
 			displayWorkItemProgress(result)
@@ -306,12 +805,53 @@ func processAssignWorkItem(
 		return result
 	}
 	result.Success = true
+	result.User = resolvedUser.Email
+	if !changed {
+		result.Operation = opAlreadyAssigned
+	}
 	if showProgress {
 		displayWorkItemProgress(result)
 	}
 	return result
 }
 
+// checkAssignWouldRemove refuses a plain set of email that would drop other entries from the
+// field (see assigneesRemovedBySet), or a work item that cannot be parsed to tell.
+func checkAssignWouldRemove(workItemPath, displayID, field, email string, cfg *config.Config) error {
+	removed, err := assigneesRemovedBySet(workItemPath, field, email, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to update work item %s: %w", displayID, err)
+	}
+	if len(removed) > 0 {
+		return fmt.Errorf("assigning %s to %s on work item %s would remove: %s (use --append to add, or --force to replace)",
+			email, field, displayID, strings.Join(removed, ", "))
+	}
+	return nil
+}
+
+// isCurrentAssignee reports whether the current field value (as returned by getCurrentAssignment)
+// already names user: the email or display format, or the email within a comma-separated list.
+func isCurrentAssignee(current string, user *UserInfo) bool {
+	if current == "" {
+		return false
+	}
+	if sameAssigneeEmail(current, user.Email) || current == formatUserDisplay(*user) {
+		return true
+	}
+	for _, part := range strings.Split(current, ", ") {
+		if sameAssigneeEmail(part, user.Email) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameAssigneeEmail reports whether two assignee emails match. Emails are compared ignoring
+// case and surrounding spaces, so Alice@Example.com and alice@example.com are one assignee.
+func sameAssigneeEmail(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
+
 // processSingleWorkItem processes a single work item update.
 func processSingleWorkItem(
 	workItemPath string,
@@ -326,137 +866,347 @@ func processSingleWorkItem(
 		fmt.Printf("Processing work item %s...\n", displayID)
 	}
 
-	// For unassign mode, remove the field
+	// For unassign mode, remove the field (only the person with --if-assignee)
+	if flags.Unassign && flags.IfAssigneeUser != nil {
+		return processUnassignIfAssignee(workItemPath, displayID, flags.Field, flags.IfAssigneeUser, flags, showProgress, cfg)
+	}
 	if flags.Unassign {
-		return processUnassignWorkItem(workItemPath, displayID, flags.Field, showProgress, cfg)
+		return processUnassignWorkItem(workItemPath, displayID, flags.Field, flags.PruneEmpty, flags, showProgress, cfg)
 	}
 
 	// For interactive mode, show selection and process
 	if flags.Interactive {
-		// Get current assignment for this work item
-		currentAssignment, err := getCurrentAssignment(workItemPath, flags.Field, cfg)
-		if err != nil {
-			result := WorkItemUpdateResult{
-				WorkItemPath: workItemPath,
-				WorkItemID:   displayID,
-				Success:      false,
-				Operation:    "interactive",
-				Error:        fmt.Errorf("failed to get current assignment: %w", err),
-			}
-			if showProgress {
-				displayWorkItemProgress(result)
-			}
-			return result
-		}
+		return processInteractiveWorkItem(workItemPath, displayID, flags, showProgress, users, cfg)
+	}
 
-		// Show interactive selection
-		selection, err := showInteractiveSelection(users, currentAssignment, flags.Field, os.Stdin)
-		if err != nil {
-			result := WorkItemUpdateResult{
-				WorkItemPath: workItemPath,
-				WorkItemID:   displayID,
-				Success:      false,
-				Operation:    "interactive",
-				Error:        fmt.Errorf("interactive selection failed: %w", err),
-			}
-			if showProgress {
-				displayWorkItemProgress(result)
-			}
-			return result
-		}
+	// For append mode, handle in Phase 6
+	if flags.Append {
+		return processAppendWorkItem(workItemPath, displayID, flags.Field, resolvedUser, flags.ForceType, flags, showProgress, cfg)
+	}
+
+	// Switch mode: update field with user email
+	return processAssignWorkItem(workItemPath, displayID, flags.Field, resolvedUser, flags.Force, flags, showProgress, cfg)
+}
 
-		// Handle selection: 0 = unassign, 1+ = assign to user
-		if selection == 0 {
-			return processUnassignWorkItem(workItemPath, displayID, flags.Field, showProgress, cfg)
+// processInteractiveWorkItem prompts for users and applies the selection: 0 unassigns, one user
+// is assigned (or appended with --append), and several users are each appended to the field.
+func processInteractiveWorkItem(
+	workItemPath string,
+	displayID string,
+	flags AssignFlags,
+	showProgress bool,
+	users []UserInfo,
+	cfg *config.Config,
+) WorkItemUpdateResult {
+	failed := func(err error) WorkItemUpdateResult {
+		result := WorkItemUpdateResult{
+			WorkItemPath: workItemPath,
+			WorkItemID:   displayID,
+			Success:      false,
+			Operation:    "interactive",
+			Error:        err,
+		}
+		if showProgress {
+			displayWorkItemProgress(result)
 		}
+		return result
+	}
 
-		// Resolve selected user
-		selectedUser, err := findUserByNumber(selection, users)
+	// Get current assignment for this work item
+	currentAssignment, err := getCurrentAssignment(workItemPath, flags.Field, cfg)
+	if err != nil {
+		return failed(fmt.Errorf("failed to get current assignment: %w", err))
+	}
+
+	// Show interactive selection
+	selection, err := showInteractiveSelection(users, currentAssignment, flags.Field, os.Stdin)
+	if err != nil {
+		return failed(fmt.Errorf("interactive selection failed: %w", err))
+	}
+
+	// Handle selection: 0 = unassign, 1+ = assign to user
+	if len(selection) == 1 && selection[0] == 0 {
+		return processUnassignWorkItem(workItemPath, displayID, flags.Field, flags.PruneEmpty, flags, showProgress, cfg)
+	}
+
+	// Resolve every selected user before updating the work item
+	selectedUsers := make([]*UserInfo, 0, len(selection))
+	for _, number := range selection {
+		selectedUser, err := findUserByNumber(number, users)
 		if err != nil {
-			result := WorkItemUpdateResult{
-				WorkItemPath: workItemPath,
-				WorkItemID:   displayID,
-				Success:      false,
-				Operation:    "interactive",
-				Error:        fmt.Errorf("failed to resolve selected user: %w", err),
-			}
-			if showProgress {
-				displayWorkItemProgress(result)
-			}
-			return result
+			return failed(fmt.Errorf("failed to resolve selected user: %w", err))
 		}
-
-		// Process assignment based on append flag
-		if flags.Append {
-			return processAppendWorkItem(workItemPath, displayID, flags.Field, selectedUser, showProgress, cfg)
+		selectedUser, err = transformAssignee(selectedUser, cfg)
+		if err != nil {
+			return failed(fmt.Errorf("work item %s: %w", displayID, err))
 		}
+		selectedUsers = append(selectedUsers, selectedUser)
+	}
 
-		// Switch mode: update field with user email
-		return processAssignWorkItem(workItemPath, displayID, flags.Field, selectedUser, showProgress, cfg)
+	// Several users imply append semantics; they are added to the field in one write
+	if len(selectedUsers) > 1 {
+		return processAppendUsersWorkItem(workItemPath, displayID, flags.Field, selectedUsers, flags.ForceType, flags, showProgress, cfg)
 	}
 
-	// For append mode, handle in Phase 6
+	// Process assignment based on append flag
 	if flags.Append {
-		return processAppendWorkItem(workItemPath, displayID, flags.Field, resolvedUser, showProgress, cfg)
+		return processAppendWorkItem(workItemPath, displayID, flags.Field, selectedUsers[0], flags.ForceType, flags, showProgress, cfg)
 	}
 
 	// Switch mode: update field with user email
-	return processAssignWorkItem(workItemPath, displayID, flags.Field, resolvedUser, showProgress, cfg)
+	return processAssignWorkItem(workItemPath, displayID, flags.Field, selectedUsers[0], flags.Force, flags, showProgress, cfg)
 }
 
 // processWorkItemUpdates processes work item updates based on flags.
 // Returns a slice of results for each work item processed.
 func processWorkItemUpdates(workItemPaths []string, resolvedUser *UserInfo, flags AssignFlags, users []UserInfo, cfg *config.Config) []WorkItemUpdateResult {
 	var results []WorkItemUpdateResult
-	showProgress := len(workItemPaths) > 1
-
-	// Skip if dry-run mode
-	if flags.DryRun {
-		for _, path := range workItemPaths {
-			res := processWorkItemInDryRun(path, cfg)
-			if res.Success {
-				displayID := res.WorkItemID
-				if flags.Unassign {
-					fmt.Printf("Would unassign work item %s\n", displayID)
-				} else if resolvedUser != nil {
-					fmt.Printf("Would assign work item %s to %s\n", displayID, formatUserDisplay(*resolvedUser))
-				}
+	showProgress := len(workItemPaths) > 1 && !flags.JSON && !flags.SummaryOnly
+
+	// Process each work item, choosing its target field by kind unless --field was given
+	for _, workItemPath := range workItemPaths {
+		if err := checkAssignTargetStatus(os.Stderr, workItemPath, flags, cfg); err != nil {
+			results = append(results, terminalStatusResult(workItemPath, err, flags, showProgress && !flags.DryRun, cfg))
+			continue
+		}
+		if flags.DryRun {
+			results = append(results, processWorkItemDryRunUpdate(workItemPath, resolvedUser, flags, cfg))
+			continue
+		}
+		results = append(results, processWorkItemUpdate(workItemPath, resolvedUser, flags, showProgress, users, cfg))
+	}
+
+	return results
+}
+
+// processWorkItemUpdate updates one work item: the assignee value is transformed
+// (assignment.value_transforms), then written to the field for the work item's kind.
+func processWorkItemUpdate(workItemPath string, resolvedUser *UserInfo, flags AssignFlags, showProgress bool, users []UserInfo, cfg *config.Config) WorkItemUpdateResult {
+	displayID := getWorkItemDisplayID(workItemPath, cfg)
+	itemFlags := flags
+	itemFlags.Field = resolveAssignField(workItemPath, flags, cfg)
+	itemUser, err := transformAssignee(resolvedUser, cfg)
+	if err != nil {
+		result := transformFailedResult(workItemPath, displayID, err, showProgress)
+		result.Field = itemFlags.Field
+		return result
+	}
+
+	var result WorkItemUpdateResult
+	if flags.MoveTo != "" {
+		if showProgress {
+			fmt.Printf("Processing work item %s...\n", displayID)
+		}
+		result = processAssignAndMoveWorkItem(workItemPath, displayID, itemUser, itemFlags, showProgress, cfg)
+	} else {
+		result = processSingleWorkItem(workItemPath, displayID, itemUser, itemFlags, showProgress, users, cfg)
+	}
+	result.Field = itemFlags.Field
+	if result.Success && flags.Message != "" && result.Operation != opSkippedNotAssignee {
+		result.Note = flags.Message
+	}
+	return result
+}
+
+// processWorkItemDryRunUpdate validates one work item and describes what a real run would write,
+// including the transformed assignee value.
+func processWorkItemDryRunUpdate(path string, resolvedUser *UserInfo, flags AssignFlags, cfg *config.Config) WorkItemUpdateResult {
+	field := resolveAssignField(path, flags, cfg)
+	itemUser, err := transformAssignee(resolvedUser, cfg)
+	if err != nil {
+		res := transformFailedResult(path, getWorkItemDisplayID(path, cfg), err, false)
+		res.Field = field
+		return res
+	}
+	res := processWorkItemInDryRun(path, cfg)
+	res.Field = field
+	res.Would = dryRunIntent(field, itemUser, flags)
+	if res.Success && flags.IfAssigneeUser != nil {
+		describeIfAssigneeDryRun(&res, field, flags, cfg)
+		return res
+	}
+	if res.Success && !flags.JSON && !flags.SummaryOnly {
+		displayAssignDryRun(path, res.WorkItemID, field, itemUser, flags, cfg)
+	}
+	return res
+}
+
+// displayAssignDryRun prints what a real run would do to one work item.
+func displayAssignDryRun(path, displayID, field string, resolvedUser *UserInfo, flags AssignFlags, cfg *config.Config) {
+	if flags.Unassign {
+		displayUnassignDryRun(path, displayID, field, flags.PruneEmpty, cfg)
+	} else if resolvedUser != nil && flags.Append {
+		fmt.Printf("Would add %s to work item %s (field: %s)\n", formatUserAs(*resolvedUser, flags.AssigneeDisplay), displayID, field)
+	} else if resolvedUser != nil {
+		fmt.Printf("Would assign work item %s to %s (field: %s)\n", displayID, formatUserAs(*resolvedUser, flags.AssigneeDisplay), field)
+	}
+	if note := describeAssignDue(displayID, flags); note != "" {
+		fmt.Println(note)
+	}
+	if note := describeAssignNote(displayID, flags); note != "" {
+		fmt.Println(note)
+	}
+	if flags.MoveTo != "" {
+		displayAssignMoveDryRun(path, displayID, flags, cfg)
+	}
+}
+
+// dryRunIntent describes the operation a real run would perform on a work item.
+func dryRunIntent(field string, resolvedUser *UserInfo, flags AssignFlags) *AssignIntent {
+	intent := &AssignIntent{Operation: "assign", Field: field}
+	switch {
+	case flags.Unassign:
+		intent.Operation = "unassign"
+	case flags.Append:
+		intent.Operation = "append"
+	}
+	if resolvedUser != nil && !flags.Unassign {
+		intent.User = resolvedUser.Email
+	}
+	intent.MoveTo = flags.MoveTo
+	return intent
+}
+
+// writeAssignResultsJSON writes assign results as a JSON array (an empty array when there are none).
+func writeAssignResultsJSON(w io.Writer, results []WorkItemUpdateResult) error {
+	type jsonIntent struct {
+		Operation string `json:"operation"`
+		Field     string `json:"field"`
+		User      string `json:"user,omitempty"`
+		MoveTo    string `json:"move_to,omitempty"`
+	}
+	type jsonResult struct {
+		WorkItemID string      `json:"work_item_id"`
+		Path       string      `json:"path"`
+		Success    bool        `json:"success"`
+		Operation  string      `json:"operation"`
+		Field      string      `json:"field,omitempty"`
+		MovedTo    string      `json:"moved_to,omitempty"`
+		NewID      string      `json:"new_id,omitempty"`
+		Cleared    []string    `json:"cleared,omitempty"`
+		Note       string      `json:"note,omitempty"`
+		Error      *string     `json:"error"`
+		Would      *jsonIntent `json:"would,omitempty"`
+	}
+
+	jsonResults := make([]jsonResult, 0, len(results))
+	for _, result := range results {
+		item := jsonResult{
+			WorkItemID: result.WorkItemID,
+			Path:       result.WorkItemPath,
+			Success:    result.Success,
+			Operation:  result.Operation,
+			Field:      result.Field,
+			MovedTo:    result.MovedTo,
+			NewID:      result.NewID,
+			Cleared:    result.Cleared,
+			Note:       result.Note,
+		}
+		if result.Error != nil {
+			msg := result.Error.Error()
+			item.Error = &msg
+		}
+		if result.Would != nil {
+			item.Would = &jsonIntent{
+				Operation: result.Would.Operation,
+				Field:     result.Would.Field,
+				User:      result.Would.User,
+				MoveTo:    result.Would.MoveTo,
 			}
-			results = append(results, res)
 		}
-		return results
+		jsonResults = append(jsonResults, item)
 	}
 
-	// Process each work item
-	for _, workItemPath := range workItemPaths {
-		displayID := getWorkItemDisplayID(workItemPath, cfg)
-		result := processSingleWorkItem(workItemPath, displayID, resolvedUser, flags, showProgress, users, cfg)
-		results = append(results, result)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonResults)
+}
+
+// displayUnassignDryRun prints the unassign a real run would perform. For a comma-separated
+// --field list it names the fields that are set and would be cleared.
+func displayUnassignDryRun(path, displayID, field string, pruneEmpty bool, cfg *config.Config) {
+	fields := splitAssignFields(field)
+	if len(fields) <= 1 {
+		fmt.Printf("Would unassign work item %s (field: %s)\n", displayID, field)
+		return
+	}
+	frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		return
 	}
+	fmt.Printf("Would unassign work item %s: %s\n", displayID, formatClearedFields(fields, clearFields(frontMatter, fields, pruneEmpty)))
+}
 
-	return results
+// formatClearedFields describes an unassign of fields, e.g. "cleared assigned, reviewer (not set: approved_by)".
+func formatClearedFields(fields, cleared []string) string {
+	wasCleared := make(map[string]bool, len(cleared))
+	for _, name := range cleared {
+		wasCleared[name] = true
+	}
+	var notSet []string
+	for _, name := range fields {
+		if !wasCleared[name] {
+			notSet = append(notSet, name)
+		}
+	}
+	if len(cleared) == 0 {
+		return fmt.Sprintf("none of %s were set", strings.Join(fields, ", "))
+	}
+	description := "cleared " + strings.Join(cleared, ", ")
+	if len(notSet) > 0 {
+		description += fmt.Sprintf(" (not set: %s)", strings.Join(notSet, ", "))
+	}
+	return description
+}
+
+// resolveAssignField returns the target field for a work item: the --field value when given
+// explicitly, else assignment.field_defaults for the item's kind, else the --field default.
+func resolveAssignField(workItemPath string, flags AssignFlags, cfg *config.Config) string {
+	if flags.FieldSet || cfg.Assignment == nil || len(cfg.Assignment.FieldDefaults) == 0 {
+		return flags.Field
+	}
+	frontMatter, _, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return flags.Field
+	}
+	kind, _ := frontMatter["kind"].(string)
+	if field, ok := cfg.Assignment.FieldDefaults[kind]; ok {
+		return field
+	}
+	return flags.Field
 }
 
 // displaySingleSuccessMessage prints the PRD success message for a single work item.
 func displaySingleSuccessMessage(result WorkItemUpdateResult, resolvedUser *UserInfo, flags AssignFlags) {
 	id := result.WorkItemID
+	if result.Field != "" {
+		flags.Field = result.Field
+	}
 	switch result.Operation {
 	case "unassign":
-		fmt.Printf("Unassigned work item %s\n", id)
+		if fields := splitAssignFields(flags.Field); len(fields) > 1 {
+			fmt.Printf("Unassigned work item %s: %s\n", id, formatClearedFields(fields, result.Cleared))
+		} else if len(result.Cleared) == 0 {
+			fmt.Printf("Work item %s has no %s to unassign; nothing changed\n", id, flags.Field)
+		} else {
+			fmt.Printf("Unassigned work item %s\n", id)
+		}
 	case "append":
 		if resolvedUser != nil {
-			fmt.Printf("Added %s to %s for work item %s\n", formatUserDisplay(*resolvedUser), flags.Field, id)
+			fmt.Printf("Added %s to %s for work item %s\n", formatUserAs(*resolvedUser, flags.AssigneeDisplay), flags.Field, id)
 		}
+	case opSkippedNotAssignee:
+		fmt.Printf("Work item %s skipped: not assigned to %s\n", id, result.User)
 	case opAlreadyAssigned:
 		if resolvedUser != nil {
-			fmt.Printf("Work item %s is already assigned to %s. Use --unassign to clear or specify a different user.\n", id, formatUserDisplay(*resolvedUser))
+			fmt.Printf("Work item %s is already assigned to %s. Use --unassign to clear or specify a different user.\n", id, formatUserAs(*resolvedUser, flags.AssigneeDisplay))
 		}
 	case "assign":
 		if resolvedUser != nil {
 			if flags.Field != "assigned" {
-				fmt.Printf("Assigned %s for work item %s to %s\n", flags.Field, id, formatUserDisplay(*resolvedUser))
+				fmt.Printf("Assigned %s for work item %s to %s\n", flags.Field, id, formatUserAs(*resolvedUser, flags.AssigneeDisplay))
 			} else {
-				fmt.Printf("Assigned work item %s to %s\n", id, formatUserDisplay(*resolvedUser))
+				fmt.Printf("Assigned work item %s to %s\n", id, formatUserAs(*resolvedUser, flags.AssigneeDisplay))
 			}
 		}
 	default:
@@ -473,15 +1223,51 @@ func displaySingleSuccessMessage(result WorkItemUpdateResult, resolvedUser *User
 
 // displayWorkItemProgress shows progress for processing a single work item.
 func displayWorkItemProgress(result WorkItemUpdateResult) {
+	if result.Operation == opSkippedNotAssignee {
+		fmt.Printf("  - Work item %s: skipped: not assigned to %s%s\n", result.WorkItemID, result.User, formatResultField(result))
+		return
+	}
 	if result.Success {
 		operation := result.Operation
 		if operation == "validate" {
 			operation = "validated"
 		}
-		fmt.Printf("  ✓ Work item %s: %s successfully\n", result.WorkItemID, operation)
+		fmt.Printf("  ✓ Work item %s: %s successfully%s\n", result.WorkItemID, operation, formatResultField(result))
 	} else {
-		fmt.Printf("  ✗ Work item %s: failed - %v\n", result.WorkItemID, result.Error)
+		fmt.Printf("  ✗ Work item %s: failed%s - %v\n", result.WorkItemID, formatResultField(result), result.Error)
+	}
+}
+
+// formatResultField returns a " (field: name)" suffix when the result records its target field,
+// noting the status the work item was moved to with --move.
+func formatResultField(result WorkItemUpdateResult) string {
+	if result.Field == "" {
+		return ""
+	}
+	if result.MovedTo != "" && result.NewID != "" {
+		return fmt.Sprintf(" (field: %s, moved to %s as %s)", result.Field, result.MovedTo, result.NewID)
+	}
+	if result.MovedTo != "" {
+		return fmt.Sprintf(" (field: %s, moved to %s)", result.Field, result.MovedTo)
+	}
+	return fmt.Sprintf(" (field: %s)", result.Field)
+}
+
+// countAssignResults returns the number of succeeded and failed results.
+func countAssignResults(results []WorkItemUpdateResult) (succeeded, failed int) {
+	for _, result := range results {
+		if result.Success {
+			succeeded++
+		} else {
+			failed++
+		}
 	}
+	return succeeded, failed
+}
+
+// displaySummaryLine prints only the result counts (--summary-only).
+func displaySummaryLine(results []WorkItemUpdateResult) {
+	fmt.Println(formatAssignCounts(results))
 }
 
 // displayBatchSummary displays a summary of batch operation results.
@@ -493,23 +1279,17 @@ func displayBatchSummary(results []WorkItemUpdateResult) {
 	fmt.Println("\nOperation Results:")
 	fmt.Println("───────────────────────────────────────────────────────────────")
 
-	successCount := 0
-	failureCount := 0
 	var failedItems []WorkItemUpdateResult
 
 	for _, result := range results {
-		if result.Success {
-			successCount++
-			displayWorkItemProgress(result)
-		} else {
-			failureCount++
+		if !result.Success {
 			failedItems = append(failedItems, result)
-			displayWorkItemProgress(result)
 		}
+		displayWorkItemProgress(result)
 	}
 
 	fmt.Println("───────────────────────────────────────────────────────────────")
-	fmt.Printf("Summary: %d succeeded, %d failed\n", successCount, failureCount)
+	fmt.Printf("Summary: %s\n", formatAssignCounts(results))
 
 	if len(failedItems) > 0 {
 		fmt.Println("\nFailed work items:")
@@ -540,14 +1320,175 @@ func parseAssignFlags(cmd *cobra.Command) (AssignFlags, error) {
 	if err != nil {
 		return AssignFlags{}, err
 	}
+	pruneEmptyFlag, err := cmd.Flags().GetBool("prune-empty")
+	if err != nil {
+		return AssignFlags{}, err
+	}
+	forceFlag, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return AssignFlags{}, err
+	}
+	knownOnlyFlag, err := cmd.Flags().GetBool("known-only")
+	if err != nil {
+		return AssignFlags{}, err
+	}
+	jsonFlag, err := cmd.Flags().GetBool("json")
+	if err != nil {
+		return AssignFlags{}, err
+	}
+	outputFlag, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return AssignFlags{}, err
+	}
+	if jsonFlag, err = resolveJSONOutput(outputFlag, jsonFlag); err != nil {
+		return AssignFlags{}, err
+	}
+	summaryOnlyFlag, err := cmd.Flags().GetBool("summary-only")
+	if err != nil {
+		return AssignFlags{}, err
+	}
 
-	return AssignFlags{
+	flags := AssignFlags{
 		Field:       field,
+		FieldSet:    cmd.Flags().Changed("field"),
 		Append:      appendFlag,
 		Unassign:    unassignFlag,
 		Interactive: interactiveFlag,
 		DryRun:      dryRunFlag,
-	}, nil
+		PruneEmpty:  pruneEmptyFlag,
+		Force:       forceFlag,
+		KnownOnly:   knownOnlyFlag,
+		JSON:        jsonFlag,
+		SummaryOnly: summaryOnlyFlag,
+	}
+	if err := parseAssignSourceFlags(cmd, &flags); err != nil {
+		return AssignFlags{}, err
+	}
+	if err := parseAssignWriteFlags(cmd, &flags); err != nil {
+		return AssignFlags{}, err
+	}
+	return flags, nil
+}
+
+// parseAssignSourceFlags reads the flags that choose the work items and users: --pick, --status,
+// --set-from-codeowners, --paths, --file-list, --max-batch, --resume, --round-robin and --swap.
+func parseAssignSourceFlags(cmd *cobra.Command, flags *AssignFlags) error {
+	pickFlag, err := cmd.Flags().GetBool("pick")
+	if err != nil {
+		return err
+	}
+	statusFlag, err := cmd.Flags().GetString("status")
+	if err != nil {
+		return err
+	}
+	fromCodeownersFlag, err := cmd.Flags().GetBool("set-from-codeowners")
+	if err != nil {
+		return err
+	}
+	pathsFlag, err := cmd.Flags().GetStringSlice("paths")
+	if err != nil {
+		return err
+	}
+	fileListFlag, err := parseAssignFileListFlag(cmd)
+	if err != nil {
+		return err
+	}
+	maxBatchFlag, err := cmd.Flags().GetInt("max-batch")
+	if err != nil {
+		return err
+	}
+	resumeFlag, err := cmd.Flags().GetBool("resume")
+	if err != nil {
+		return err
+	}
+	roundRobinFlag, err := cmd.Flags().GetString("round-robin")
+	if err != nil {
+		return err
+	}
+	swapFlag, err := cmd.Flags().GetBool("swap")
+	if err != nil {
+		return err
+	}
+
+	flags.Pick = pickFlag
+	flags.PickStatus = statusFlag
+	flags.FromCodeowners = fromCodeownersFlag
+	flags.Paths = pathsFlag
+	flags.FileList = fileListFlag
+	flags.MaxBatch = maxBatchFlag
+	flags.Resume = resumeFlag
+	flags.RoundRobin = strings.TrimSpace(roundRobinFlag)
+	flags.Swap = swapFlag
+	return nil
+}
+
+// parseAssignWriteFlags reads the flags that change what is written and reported: --move,
+// --changelog, --assignee-display, --strict, --due, --message and --force-type.
+func parseAssignWriteFlags(cmd *cobra.Command, flags *AssignFlags) error {
+	moveFlag, err := cmd.Flags().GetString("move")
+	if err != nil {
+		return err
+	}
+	changelogFlag, err := cmd.Flags().GetString("changelog")
+	if err != nil {
+		return err
+	}
+	assigneeDisplayFlag, err := cmd.Flags().GetString("assignee-display")
+	if err != nil {
+		return err
+	}
+	strictFlag, err := cmd.Flags().GetBool("strict")
+	if err != nil {
+		return err
+	}
+	dueFlag, err := cmd.Flags().GetString("due")
+	if err != nil {
+		return err
+	}
+	messageFlag, err := cmd.Flags().GetString("message")
+	if err != nil {
+		return err
+	}
+	forceTypeFlag, err := cmd.Flags().GetBool("force-type")
+	if err != nil {
+		return err
+	}
+	ifAssigneeFlag, err := cmd.Flags().GetString("if-assignee")
+	if err != nil {
+		return err
+	}
+
+	flags.MoveTo = strings.TrimSpace(moveFlag)
+	flags.Changelog = strings.TrimSpace(changelogFlag)
+	flags.AssigneeDisplay = strings.TrimSpace(assigneeDisplayFlag)
+	flags.Strict = strictFlag
+	flags.Due = strings.TrimSpace(dueFlag)
+	flags.DueSet = cmd.Flags().Changed("due")
+	flags.Message = sanitizeAssignNote(messageFlag)
+	flags.MessageSet = cmd.Flags().Changed("message")
+	flags.ForceType = forceTypeFlag
+	flags.IfAssignee = strings.TrimSpace(ifAssigneeFlag)
+	return nil
+}
+
+// parseAssignFileListFlag returns the --file-list value, with --stdin-paths as "-".
+func parseAssignFileListFlag(cmd *cobra.Command) (string, error) {
+	fileList, err := cmd.Flags().GetString("file-list")
+	if err != nil {
+		return "", err
+	}
+	stdinPaths, err := cmd.Flags().GetBool("stdin-paths")
+	if err != nil {
+		return "", err
+	}
+	fileList = strings.TrimSpace(fileList)
+	if !stdinPaths {
+		return fileList, nil
+	}
+	if fileList != "" && fileList != stdinFileList {
+		return "", fmt.Errorf("invalid flag combination: --stdin-paths cannot be used together with --file-list %s", fileList)
+	}
+	return stdinFileList, nil
 }
 
 // parseAssignArgs splits positional arguments into work item identifiers and an optional user identifier.
@@ -556,13 +1497,18 @@ func parseAssignArgs(args []string, flags AssignFlags) (workItems []string, user
 		return nil, ""
 	}
 
+	// id=user pairs carry their own users; all arguments are split later by splitAssignPairs.
+	if hasAssignPairs(args) {
+		return append([]string{}, args...), ""
+	}
+
 	// In unassign mode, all arguments are work items; user identifier is not allowed.
 	if flags.Unassign {
 		return append([]string{}, args...), ""
 	}
 
-	// In interactive mode, user identifier is optional; treat all args as work items.
-	if flags.Interactive {
+	// In interactive and round-robin mode, users come from elsewhere; treat all args as work items.
+	if flags.Interactive || flags.RoundRobin != "" {
 		return append([]string{}, args...), ""
 	}
 
@@ -577,21 +1523,92 @@ func parseAssignArgs(args []string, flags AssignFlags) (workItems []string, user
 	return workItems, userIdentifier
 }
 
+// hasAssignPairs reports whether any argument uses the id=user pair syntax.
+func hasAssignPairs(args []string) bool {
+	for _, arg := range args {
+		if strings.Contains(arg, "=") {
+			return true
+		}
+	}
+	return false
+}
+
+// splitAssignPairs splits id=user tokens into work item identifiers and their user identifiers.
+// Every token must be a pair; a bare work item or trailing user cannot be mixed in. A work item
+// given twice, in two pairs or as a bare argument and in a pair, is an error rather than letting
+// the last pair win.
+func splitAssignPairs(tokens []string) (workItems, identifiers []string, err error) {
+	if err := checkDuplicateAssignPairs(tokens); err != nil {
+		return nil, nil, err
+	}
+	for _, token := range tokens {
+		workItem, identifier, ok := strings.Cut(token, "=")
+		if !ok {
+			return nil, nil, fmt.Errorf("cannot mix id=user pairs with bare argument '%s': use <work-item-id>=<user-identifier> for every work item", token)
+		}
+		workItem, identifier = strings.TrimSpace(workItem), strings.TrimSpace(identifier)
+		if workItem == "" || identifier == "" {
+			return nil, nil, fmt.Errorf("invalid pair '%s': expected <work-item-id>=<user-identifier>", token)
+		}
+		workItems = append(workItems, workItem)
+		identifiers = append(identifiers, identifier)
+	}
+	return workItems, identifiers, nil
+}
+
+// checkDuplicateAssignPairs reports a work item that appears more than once among pair tokens,
+// counting the id of each pair and each bare argument.
+func checkDuplicateAssignPairs(tokens []string) error {
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		workItem, _, _ := strings.Cut(token, "=")
+		workItem = strings.TrimSpace(workItem)
+		if workItem == "" {
+			continue // reported as an invalid pair
+		}
+		if seen[workItem] {
+			return fmt.Errorf("work item %s specified twice", workItem)
+		}
+		seen[workItem] = true
+	}
+	return nil
+}
+
+// checkDuplicateAssignPaths reports a work item given twice in pairs under different forms, such
+// as its ID in one pair and its path in another.
+func checkDuplicateAssignPaths(workItemPaths []string, cfg *config.Config) error {
+	for i, path := range workItemPaths {
+		if containsString(workItemPaths[:i], path) {
+			return fmt.Errorf("work item %s specified twice", getWorkItemDisplayID(path, cfg))
+		}
+	}
+	return nil
+}
+
 // validateAssignInput validates work item identifiers, user identifier, and flag combinations.
 func validateAssignInput(workItems []string, userIdentifier string, flags AssignFlags, cfg *config.Config) error {
-	if err := validateWorkItemsPresent(workItems); err != nil {
+	if err := validateAssignFlagCombinations(userIdentifier, flags); err != nil {
 		return err
 	}
 
-	if err := validateAssignFieldName(flags.Field); err != nil {
+	if err := validateAssignUserIdentifierRequired(userIdentifier, flags); err != nil {
 		return err
 	}
 
-	if err := validateAssignFlagCombinations(userIdentifier, flags); err != nil {
+	if err := validateAssignMoveTarget(flags, cfg); err != nil {
 		return err
 	}
 
-	if err := validateAssignUserIdentifierRequired(userIdentifier, flags); err != nil {
+	return validateWorkItemTokens(workItems, flags.Field, cfg)
+}
+
+// validateWorkItemTokens checks that work items are given, the field name is valid, and every
+// work item token is a valid ID or path.
+func validateWorkItemTokens(workItems []string, field string, cfg *config.Config) error {
+	if err := validateWorkItemsPresent(workItems); err != nil {
+		return err
+	}
+	if err := validateAssignFieldName(field); err != nil {
 		return err
 	}
 
@@ -620,18 +1637,51 @@ func validateWorkItemsPresent(workItems []string) error {
 	return nil
 }
 
+// validateAssignFieldName validates --field, checking each name of a comma-separated list.
 func validateAssignFieldName(field string) error {
-	if strings.TrimSpace(field) == "" {
-		return fmt.Errorf("field name cannot be empty")
-	}
-	if strings.Contains(field, "/") || strings.Contains(field, "\\") || strings.Contains(field, "..") {
-		return fmt.Errorf("invalid field name '%s': field name must not contain path separators or '..'", field)
+	for _, name := range strings.Split(field, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return fmt.Errorf("field name cannot be empty")
+		}
+		if strings.Contains(name, "/") || strings.Contains(name, "\\") || strings.Contains(name, "..") {
+			return fmt.Errorf("invalid field name '%s': field name must not contain path separators or '..'", name)
+		}
 	}
 	return nil
 }
 
+// splitAssignFields splits a comma-separated --field list (used with --unassign) into field names.
+func splitAssignFields(field string) []string {
+	var fields []string
+	for _, name := range strings.Split(field, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
 func validateAssignFlagCombinations(userIdentifier string, flags AssignFlags) error {
+	if flags.PickStatus != "" && !flags.Pick {
+		return fmt.Errorf("invalid flag combination: --status can only be used with --pick")
+	}
+	if len(flags.Paths) > 0 && !flags.FromCodeowners {
+		return fmt.Errorf("invalid flag combination: --paths can only be used with --set-from-codeowners")
+	}
+	if flags.JSON && flags.Interactive {
+		return fmt.Errorf("invalid flag combination: --json cannot be used together with --interactive")
+	}
+	if flags.JSON && flags.SummaryOnly {
+		return fmt.Errorf("invalid flag combination: --json cannot be used together with --summary-only")
+	}
 	if !flags.Unassign {
+		if flags.PruneEmpty {
+			return fmt.Errorf("invalid flag combination: --prune-empty can only be used with --unassign")
+		}
+		if strings.Contains(flags.Field, ",") {
+			return fmt.Errorf("invalid flag combination: a comma-separated --field list can only be used with --unassign")
+		}
 		return nil
 	}
 
@@ -664,8 +1714,29 @@ func isWorkItemPath(token string) bool {
 	return strings.Contains(token, "/") || strings.Contains(token, "\\") || strings.HasSuffix(token, ".md")
 }
 
+// resolveStaleWorkItemPath returns the current path of the work item whose file absPath named,
+// which may have been renamed (a changed title changes the slug) or moved to another status
+// folder since. IDs are the stable handle, so the work item is looked up by the ID its file name
+// starts with first, even when absPath still exists. absPath is returned unchanged when its file
+// name has no ID or no single work item has that ID.
+func resolveStaleWorkItemPath(absPath string, cfg *config.Config) string {
+	id, _, ok := workItemFileNameParts(filepath.Base(absPath))
+	if !ok {
+		return absPath
+	}
+	current, err := findWorkItemFile(id, cfg)
+	if err != nil {
+		return absPath
+	}
+	if resolved, err := filepath.Abs(current); err == nil {
+		return resolved
+	}
+	return absPath
+}
+
 // resolveWorkItemPath resolves a work item identifier (ID or path) to an absolute file path.
-// If identifier is a path, it validates and returns the absolute path.
+// If identifier is a path, it validates it and resolves the work item by the ID in its file name,
+// falling back to the absolute path (see resolveStaleWorkItemPath).
 // If identifier is an ID, it uses findWorkItemFile to locate the file.
 func resolveWorkItemPath(identifier string, cfg *config.Config) (string, error) {
 	// If identifier is a path, validate and return it.
@@ -679,7 +1750,9 @@ func resolveWorkItemPath(identifier string, cfg *config.Config) (string, error)
 		if err != nil {
 			return "", fmt.Errorf("failed to resolve work item path '%s': %w", identifier, err)
 		}
+		absPath = resolveStaleWorkItemPath(absPath, cfg)
 
+		recordResolvedWorkItem(absPath)
 		return absPath, nil
 	}
 
@@ -798,6 +1871,85 @@ func resolveUserIdentifier(identifier string, users []UserInfo) (*UserInfo, erro
 	return nil, fmt.Errorf("user '%s' not found. Run 'kira users' to see available users", identifier)
 }
 
+// collectAssignUsers collects the users kira assign resolves identifiers to. With --known-only
+// (or assignment.require_known_user) only the users in users.saved_users are kept, so people
+// who only appear in the git history cannot be assigned.
+func collectAssignUsers(flags AssignFlags, cfg *config.Config) ([]UserInfo, error) {
+	users, err := collectUsersForAssignment(cfg)
+	if err != nil || !flags.KnownOnly {
+		return users, err
+	}
+	return savedUsersOnly(users, cfg), nil
+}
+
+// savedUsersOnly returns the users whose email is listed in users.saved_users.
+func savedUsersOnly(users []UserInfo, cfg *config.Config) []UserInfo {
+	saved := make(map[string]bool, len(cfg.Users.SavedUsers))
+	for _, savedUser := range cfg.Users.SavedUsers {
+		saved[strings.ToLower(savedUser.Email)] = true
+	}
+	known := make([]UserInfo, 0, len(users))
+	for _, user := range users {
+		if saved[strings.ToLower(user.Email)] {
+			known = append(known, user)
+		}
+	}
+	return known
+}
+
+// requireKnownUser reports whether assignment.require_known_user is enabled.
+func requireKnownUser(cfg *config.Config) bool {
+	return cfg.Assignment != nil && cfg.Assignment.RequireKnownUser
+}
+
+// resolveAuthorIdentifier resolves the @author identifier to the user who last committed
+// the work item file. The author is matched to a known user by exact email; when no known
+// user matches, knownOnly is false and users.use_git_history is enabled, a git-sourced user
+// is synthesized.
+func resolveAuthorIdentifier(workItemPath string, users []UserInfo, knownOnly bool, cfg *config.Config) (*UserInfo, error) {
+	email, name, err := getLastAuthor(workItemPath)
+	if err != nil {
+		return nil, err
+	}
+	if email == "" {
+		return nil, fmt.Errorf("cannot resolve %s for %s: the file has no commits yet. Commit it first or assign a user explicitly", authorIdentifier, workItemPath)
+	}
+
+	for i := range users {
+		if strings.EqualFold(users[i].Email, email) {
+			return &users[i], nil
+		}
+	}
+
+	if knownOnly || !getUseGitHistorySetting(cfg) || shouldIgnoreEmail(email, cfg) {
+		return nil, fmt.Errorf("last author %s of %s is not a known user. Add them to users.saved_users in kira.yml or assign a user explicitly", email, workItemPath)
+	}
+	return &UserInfo{Email: email, Name: name, Source: "git"}, nil
+}
+
+// getLastAuthor returns the email and name of the last commit author of a file.
+// Both are empty when the file has never been committed.
+func getLastAuthor(filePath string) (email, name string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"log", "-1", "--format=%ae|%an", "--", filePath}, "", false)
+	if err != nil {
+		// A repository without any commits cannot have committed the file either
+		if strings.Contains(err.Error(), "does not have any commits yet") {
+			return "", "", nil
+		}
+		return "", "", fmt.Errorf("failed to find last author of %s: %w", filePath, err)
+	}
+
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return "", "", nil
+	}
+	email, name, _ = strings.Cut(output, "|")
+	return strings.TrimSpace(email), strings.TrimSpace(name), nil
+}
+
 // findUserByNumber looks up a user by their numeric identifier (1-based).
 // Returns an error with available range if not found.
 func findUserByNumber(number int, users []UserInfo) (*UserInfo, error) {
@@ -971,6 +2123,9 @@ func parseWorkItemFrontMatter(filePath string, cfg *config.Config) (map[string]i
 		// Preserve id as string from the raw line so YAML never interprets 017 as octal 15.
 		idRaw := extractIDFromYAMLLines(yamlLines)
 		if err := yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), frontMatter); err != nil {
+			if tabErr := validation.FrontMatterTabError(yamlLines); tabErr != nil {
+				err = tabErr
+			}
 			return nil, nil, fmt.Errorf("failed to parse front matter: %w", err)
 		}
 		if idRaw != "" {
@@ -1015,7 +2170,8 @@ func getFieldValue(frontMatter map[string]interface{}, fieldName string) (interf
 
 // getFieldValueAsString retrieves a field value from the front matter and converts it to a string.
 // Returns the string representation and true if the field exists, or empty string and false if it doesn't.
-// Array values are joined with commas. Other types are converted using fmt.Sprintf.
+// Array values are joined with commas, and assignee objects ({email: ..., since: ...}) are shown
+// by their email. Other types are converted using fmt.Sprintf.
 func getFieldValueAsString(frontMatter map[string]interface{}, fieldName string) (string, bool) {
 	value, exists := getFieldValue(frontMatter, fieldName)
 	if !exists {
@@ -1032,9 +2188,11 @@ func getFieldValueAsString(frontMatter map[string]interface{}, fieldName string)
 		// Convert []interface{} to []string for display
 		var strValues []string
 		for _, item := range v {
-			strValues = append(strValues, fmt.Sprintf("%v", item))
+			strValues = append(strValues, assigneeValueString(item))
 		}
 		return strings.Join(strValues, ", "), true
+	case map[string]interface{}:
+		return assigneeValueString(v), true
 	case nil:
 		return "", true // Field exists but is nil, return empty string
 	default:
@@ -1054,35 +2212,8 @@ func writeWorkItemFrontMatter(filePath string, frontMatter map[string]interface{
 	sb.WriteString(yamlSeparator)
 	sb.WriteString("\n")
 
-	// Define hardcoded fields in order
-	hardcodedFields := []string{"id", "title", "status", "kind", "created"}
-	hardcodedSet := make(map[string]bool)
-	for _, field := range hardcodedFields {
-		hardcodedSet[field] = true
-	}
-
-	// Write hardcoded fields first
-	for _, field := range hardcodedFields {
-		if value, exists := frontMatter[field]; exists {
-			if err := writeYAMLFieldValue(&sb, field, value); err != nil {
-				return fmt.Errorf("failed to write field '%s': %w", field, err)
-			}
-		}
-	}
-
-	// Collect and sort other fields
-	var otherFields []string
-	for key := range frontMatter {
-		if !hardcodedSet[key] {
-			otherFields = append(otherFields, key)
-		}
-	}
-	sort.Strings(otherFields)
-
-	// Write other fields in sorted order
-	for _, key := range otherFields {
-		value := frontMatter[key]
-		if err := writeYAMLFieldValue(&sb, key, value); err != nil {
+	for _, key := range orderedFrontMatterKeys(frontMatter) {
+		if err := writeYAMLFieldValue(&sb, key, frontMatter[key]); err != nil {
 			return fmt.Errorf("failed to write field '%s': %w", key, err)
 		}
 	}
@@ -1091,14 +2222,10 @@ func writeWorkItemFrontMatter(filePath string, frontMatter map[string]interface{
 	sb.WriteString(yamlSeparator)
 	sb.WriteString("\n")
 
-	// Write body content
-	if len(bodyLines) > 0 {
-		bodyContent := strings.Join(bodyLines, "\n")
+	// Write body content, ending the file with exactly one newline (blank lines at the end are dropped)
+	if bodyContent := strings.TrimRight(strings.Join(bodyLines, "\n"), "\r\n"); bodyContent != "" {
 		sb.WriteString(bodyContent)
-		// Ensure file ends with newline if body has content
-		if !strings.HasSuffix(bodyContent, "\n") {
-			sb.WriteString("\n")
-		}
+		sb.WriteString("\n")
 	}
 
 	// Write to file with permissions 0o600
@@ -1109,6 +2236,29 @@ func writeWorkItemFrontMatter(filePath string, frontMatter map[string]interface{
 	return nil
 }
 
+// orderedFrontMatterKeys returns the front matter keys in file order: the hardcoded fields
+// (id, title, status, kind, created) first, then all other fields sorted.
+func orderedFrontMatterKeys(frontMatter map[string]interface{}) []string {
+	hardcodedFields := []string{"id", "title", "status", "kind", "created"}
+	hardcodedSet := make(map[string]bool)
+	var keys []string
+	for _, field := range hardcodedFields {
+		hardcodedSet[field] = true
+		if _, exists := frontMatter[field]; exists {
+			keys = append(keys, field)
+		}
+	}
+
+	var otherFields []string
+	for key := range frontMatter {
+		if !hardcodedSet[key] {
+			otherFields = append(otherFields, key)
+		}
+	}
+	sort.Strings(otherFields)
+	return append(keys, otherFields...)
+}
+
 // writeYAMLFieldValue writes a single YAML field to a string builder.
 func writeYAMLFieldValue(sb *strings.Builder, key string, value interface{}) error {
 	switch v := value.(type) {
@@ -1120,6 +2270,9 @@ func writeYAMLFieldValue(sb *strings.Builder, key string, value interface{}) err
 	case bool:
 		fmt.Fprintf(sb, "%s: %v\n", key, v)
 	case []interface{}:
+		if containsMap(v) {
+			return writeYAMLMarshaledField(sb, key, value)
+		}
 		fmt.Fprintf(sb, "%s: [", key)
 		for i, item := range v {
 			if i > 0 {
@@ -1137,25 +2290,55 @@ func writeYAMLFieldValue(sb *strings.Builder, key string, value interface{}) err
 			sb.WriteString(yamlFormatStringValue(item))
 		}
 		sb.WriteString("]\n")
+	case blockSequence:
+		writeYAMLBlockSequence(sb, key, v)
 	case nil:
-		fmt.Fprintf(sb, "%s: null\n", key)
+		// Written as an empty value (reviewer:), as authors leave unset fields, rather than null
+		fmt.Fprintf(sb, "%s:\n", key)
 	default:
-		// For complex types, use YAML marshaling
-		yamlData, err := yaml.Marshal(map[string]interface{}{key: value})
-		if err != nil {
-			return fmt.Errorf("failed to marshal field '%s': %w", key, err)
-		}
-		// Extract the line(s) for this field from the marshaled output
-		yamlStr := strings.TrimSpace(string(yamlData))
-		lines := strings.Split(yamlStr, "\n")
-		for _, line := range lines {
-			sb.WriteString(line)
-			sb.WriteString("\n")
-		}
+		return writeYAMLMarshaledField(sb, key, value)
+	}
+	return nil
+}
+
+// writeYAMLMarshaledField writes a field of a complex type, such as a map or a list of maps,
+// using YAML marshaling.
+func writeYAMLMarshaledField(sb *strings.Builder, key string, value interface{}) error {
+	yamlData, err := yaml.Marshal(map[string]interface{}{key: value})
+	if err != nil {
+		return fmt.Errorf("failed to marshal field '%s': %w", key, err)
+	}
+	// Extract the line(s) for this field from the marshaled output
+	yamlStr := strings.TrimSpace(string(yamlData))
+	lines := strings.Split(yamlStr, "\n")
+	for _, line := range lines {
+		sb.WriteString(emptyNullValue(line))
+		sb.WriteString("\n")
 	}
 	return nil
 }
 
+// containsMap reports whether any item of a list is a map, which the flow style cannot write.
+func containsMap(items []interface{}) bool {
+	for _, item := range items {
+		if _, ok := item.(map[string]interface{}); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// emptyNullValue rewrites a marshaled "key: null" line as "key:", so nested nil values are
+// written as empty values like top-level ones. A "null" string is quoted by the marshaler and a
+// value containing ": " is quoted too, so only real nulls match.
+func emptyNullValue(line string) string {
+	key, ok := strings.CutSuffix(line, ": null")
+	if !ok || strings.Contains(key, ":") {
+		return line
+	}
+	return key + ":"
+}
+
 // yamlFormatStringValue formats a string value for YAML output, adding quotes when necessary.
 func yamlFormatStringValue(s string) string {
 	if needsYAMLQuoting(s) {
@@ -1237,44 +2420,65 @@ func updateTimestamp(frontMatter map[string]interface{}) {
 
 // updateWorkItemField updates a field in a work item's front matter (switch mode).
 // It reads the file, updates the field, updates the timestamp, and writes the file back.
+// When the field already holds userEmail changed is false, and nothing is written unless followUps
+// (--due, --message) change the work item. With assignment.rich_assignee the user is written as
+// an assignee object (see assigneeEntry).
 func updateWorkItemField(
 	filePath string,
 	fieldName string,
 	userEmail string,
+	followUps AssignFlags,
 	cfg *config.Config,
-) error {
+) (changed bool, err error) {
 	// Parse front matter and body
 	frontMatter, bodyLines, err := parseWorkItemFrontMatter(filePath, cfg)
 	if err != nil {
-		return fmt.Errorf("failed to parse work item: %w", err)
+		return false, fmt.Errorf("failed to parse work item: %w", err)
 	}
 
-	// Update field value (switch mode - replaces existing)
-	updateFieldValue(frontMatter, fieldName, userEmail)
+	// Keep the value when it is already set; update field value (switch mode - replaces existing)
+	changed = true
+	switch current := frontMatter[fieldName].(type) {
+	case string, map[string]interface{}:
+		changed = !sameAssigneeEmail(assigneeValueString(current), userEmail)
+	}
+	if changed {
+		setAssigneeValue(frontMatter, fieldName, userEmail, cfg)
+	}
+
+	// --due and --message go in the same write; with nothing to change, leave the file (and its
+	// updated timestamp) untouched
+	if followUpsChanged, _ := setAssignFollowUps(frontMatter, followUps); !changed && !followUpsChanged {
+		return false, nil
+	}
 
 	// Update timestamp
 	updateTimestamp(frontMatter)
 
 	// Write back to file
 	if err := writeWorkItemFrontMatter(filePath, frontMatter, bodyLines); err != nil {
-		return fmt.Errorf("failed to write work item: %w", err)
+		return false, fmt.Errorf("failed to write work item: %w", err)
 	}
 
-	return nil
+	return changed, nil
 }
 
 // Phase 6: Append Mode Logic
 
-// appendToField appends a user email to a field in the front matter (append mode).
+// appendToField appends a user to a field in the front matter (append mode). value is the user's
+// email, or an assignee object ({email: ..., since: ...}) with assignment.rich_assignee; users
+// are compared by email, so an object and a plain email of the same user are duplicates.
 // It handles:
 // - Missing fields: creates field with the new user
 // - Empty string fields: sets to the new user
 // - Single string values: converts to array and appends
 // - Array values ([]string or []interface{}): appends if not duplicate
+// - Assignee objects: converts to array and appends if not duplicate
+// Lists without assignee objects are written as []string.
 func appendToField(
 	frontMatter map[string]interface{},
 	fieldName string,
-	userEmail string,
+	value interface{},
 ) {
 	if frontMatter == nil {
 		frontMatter = make(map[string]interface{})
@@ -1282,64 +2486,48 @@ func appendToField(
 
 	currentValue, exists := frontMatter[fieldName]
 
-	// If field doesn't exist, create it with the new user
-	if !exists {
-		frontMatter[fieldName] = userEmail
+	// If field doesn't exist or is empty, set it to the new user
+	if !exists || currentValue == "" {
+		frontMatter[fieldName] = value
 		return
 	}
 
-	// If field is empty string, set to the new user
-	if str, ok := currentValue.(string); ok {
-		if str == "" {
-			frontMatter[fieldName] = userEmail
-			return
-		}
+	user := assigneeValueString(value)
+	var items []interface{}
+	switch current := currentValue.(type) {
+	case string:
 		// Convert single string to array
-		frontMatter[fieldName] = []string{str, userEmail}
+		frontMatter[fieldName] = assigneeList([]interface{}{current, value})
 		return
-	}
-
-	// If field is []string, append if not duplicate
-	if arr, ok := currentValue.([]string); ok {
-		// Check for duplicates
-		for _, existing := range arr {
-			if existing == userEmail {
-				return // Already exists, don't add duplicate
-			}
+	case []string:
+		for _, item := range current {
+			items = append(items, item)
 		}
-		frontMatter[fieldName] = append(arr, userEmail)
-		return
-	}
-
-	// If field is []interface{}, convert to []string and append if not duplicate
-	if arr, ok := currentValue.([]interface{}); ok {
-		// Convert []interface{} to []string
-		strArr := make([]string, 0, len(arr))
-		for _, item := range arr {
-			// Convert each item to string
-			strItem := fmt.Sprintf("%v", item)
-			strArr = append(strArr, strItem)
-		}
-		// Check for duplicates
-		for _, existing := range strArr {
-			if existing == userEmail {
-				// Already exists, convert to []string but don't add duplicate
-				frontMatter[fieldName] = strArr
-				return
-			}
+	case []interface{}:
+		items = append(items, current...)
+	case map[string]interface{}:
+		if assigneeValueString(current) == user {
+			return // Already assigned, don't create duplicate
 		}
-		// Append new user (no duplicate found)
-		frontMatter[fieldName] = append(strArr, userEmail)
-		return
+		items = []interface{}{current}
+	default:
+		// For other types, convert to string and create array
+		// This handles edge cases like numeric or boolean values
+		strValue := fmt.Sprintf("%v", current)
+		if strValue == user {
+			return // Already matches, don't create duplicate
+		}
+		items = []interface{}{strValue}
 	}
 
-	// For other types, convert to string and create array
-	// This handles edge cases like numeric or boolean values
-	strValue := fmt.Sprintf("%v", currentValue)
-	if strValue == userEmail {
-		return // Already matches, don't create duplicate
+	for _, item := range items {
+		if assigneeValueString(item) == user {
+			// Already exists, normalize the list but don't add duplicate
+			frontMatter[fieldName] = assigneeList(items)
+			return
+		}
 	}
-	frontMatter[fieldName] = []string{strValue, userEmail}
+	frontMatter[fieldName] = assigneeList(append(items, value))
 }
 
 // Phase 7: Unassign Logic
@@ -1358,59 +2546,183 @@ func clearField(frontMatter map[string]interface{}, fieldName string) (existed b
 	return existed
 }
 
-// updateWorkItemFieldUnassign removes a field from a work item's front matter.
-// It reads the file, removes the field, updates the timestamp, and writes the file back.
+// clearNestedField removes a field like clearField, but a dotted name ("metadata.owner") that is not
+// itself a top-level key addresses a key inside a single-level nested map. When pruneEmpty is true and
+// the removal leaves the parent map empty, the parent is removed too. For top-level fields pruneEmpty
+// has no effect.
+func clearNestedField(frontMatter map[string]interface{}, fieldName string, pruneEmpty bool) (existed bool) {
+	if frontMatter == nil {
+		return false
+	}
+	if _, topLevel := frontMatter[fieldName]; topLevel {
+		return clearField(frontMatter, fieldName)
+	}
+
+	parentName, childName, nested := strings.Cut(fieldName, ".")
+	if !nested {
+		return false
+	}
+	parent, ok := frontMatter[parentName].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	existed = clearField(parent, childName)
+	if existed && pruneEmpty && len(parent) == 0 {
+		delete(frontMatter, parentName)
+	}
+	return existed
+}
+
+// updateWorkItemFieldUnassign removes a field (or a comma-separated list of fields) from a work
+// item's front matter. See unassignWorkItemFields.
 func updateWorkItemFieldUnassign(
 	filePath string,
 	fieldName string,
+	pruneEmpty bool,
 	cfg *config.Config,
 ) error {
+	_, err := unassignWorkItemFields(filePath, splitAssignFields(fieldName), pruneEmpty, AssignFlags{}, cfg)
+	return err
+}
+
+// unassignWorkItemFields removes fields from a work item's front matter in one write.
+// It reads the file, removes each field and the follow-up fields (see setAssignFollowUps), updates
+// the timestamp once, and writes the file back. Returns the fields that were present. See
+// clearNestedField for dotted field names and pruneEmpty.
+func unassignWorkItemFields(
+	filePath string,
+	fieldNames []string,
+	pruneEmpty bool,
+	followUps AssignFlags,
+	cfg *config.Config,
+) ([]string, error) {
 	// Parse front matter and body
 	frontMatter, bodyLines, err := parseWorkItemFrontMatter(filePath, cfg)
 	if err != nil {
-		return fmt.Errorf("failed to parse work item: %w", err)
+		return nil, fmt.Errorf("failed to parse work item: %w", err)
 	}
 
-	// Remove field (unassign mode - deletes the field)
-	clearField(frontMatter, fieldName)
+	// Remove fields (unassign mode - deletes the fields)
+	cleared := clearFields(frontMatter, fieldNames, pruneEmpty)
+	_, clearedFollowUps := setAssignFollowUps(frontMatter, followUps)
+	cleared = append(cleared, clearedFollowUps...)
+	if len(cleared) == 0 {
+		// Nothing to remove: leave the file (and its updated timestamp) untouched
+		return nil, nil
+	}
 
-	// Update timestamp (always update, even if field didn't exist)
+	// Update timestamp
 	updateTimestamp(frontMatter)
 
 	// Write back to file
 	if err := writeWorkItemFrontMatter(filePath, frontMatter, bodyLines); err != nil {
-		return fmt.Errorf("failed to write work item: %w", err)
+		return nil, fmt.Errorf("failed to write work item: %w", err)
 	}
 
-	return nil
+	return cleared, nil
+}
+
+// clearFields clears each field with clearNestedField and returns the ones that existed.
+func clearFields(frontMatter map[string]interface{}, fieldNames []string, pruneEmpty bool) []string {
+	var cleared []string
+	for _, fieldName := range fieldNames {
+		if clearNestedField(frontMatter, fieldName, pruneEmpty) {
+			cleared = append(cleared, fieldName)
+		}
+	}
+	return cleared
 }
 
 // updateWorkItemFieldAppend updates a field in a work item's front matter (append mode).
 // It reads the file, appends to the field, updates the timestamp, and writes the file back.
+// When the field already holds userEmail changed is false, and nothing is written unless followUps
+// (--due, --message) change the work item. A field holding
+// a value that is not a user is refused unless forceType is set (see checkAppendTarget).
+// Lists written in block style ("- item" per line) keep that style, so appends produce minimal diffs.
 func updateWorkItemFieldAppend(
 	filePath string,
 	fieldName string,
 	userEmail string,
+	forceType bool,
+	followUps AssignFlags,
 	cfg *config.Config,
-) error {
+) (changed bool, err error) {
+	return updateWorkItemFieldAppendUsers(filePath, fieldName, []string{userEmail}, forceType, followUps, cfg)
+}
+
+// updateWorkItemFieldAppendUsers appends each of userEmails to a field as
+// updateWorkItemFieldAppend does, writing the work item once. changed is false when every user
+// is already in the field; if any user is refused, nothing is written.
+func updateWorkItemFieldAppendUsers(
+	filePath string,
+	fieldName string,
+	userEmails []string,
+	forceType bool,
+	followUps AssignFlags,
+	cfg *config.Config,
+) (changed bool, err error) {
 	// Parse front matter and body
 	frontMatter, bodyLines, err := parseWorkItemFrontMatter(filePath, cfg)
 	if err != nil {
-		return fmt.Errorf("failed to parse work item: %w", err)
+		return false, fmt.Errorf("failed to parse work item: %w", err)
+	}
+
+	// Append to field value (append mode - adds to existing) unless the user is already listed
+	for _, userEmail := range userEmails {
+		if fieldHasValue(frontMatter, fieldName, userEmail) {
+			continue
+		}
+		if err := checkAppendTarget(frontMatter, fieldName, userEmail, forceType); err != nil {
+			return false, err
+		}
+		appendToField(frontMatter, fieldName, assigneeEntry(userEmail, cfg))
+		changed = true
+	}
+	if changed {
+		keepBlockSequences(frontMatter, frontMatterBlockSequences(filePath, cfg))
 	}
 
-	// Append to field value (append mode - adds to existing)
-	appendToField(frontMatter, fieldName, userEmail)
+	// --due and --message go in the same write; with nothing to change, leave the file (and its
+	// updated timestamp) untouched
+	if followUpsChanged, _ := setAssignFollowUps(frontMatter, followUps); !changed && !followUpsChanged {
+		return false, nil
+	}
 
 	// Update timestamp
 	updateTimestamp(frontMatter)
 
 	// Write back to file
 	if err := writeWorkItemFrontMatter(filePath, frontMatter, bodyLines); err != nil {
-		return fmt.Errorf("failed to write work item: %w", err)
+		return false, fmt.Errorf("failed to write work item: %w", err)
 	}
 
-	return nil
+	return changed, nil
+}
+
+// fieldHasValue reports whether the field is value, or is a list that contains value, comparing
+// emails as sameAssigneeEmail does.
+func fieldHasValue(frontMatter map[string]interface{}, fieldName, value string) bool {
+	switch current := frontMatter[fieldName].(type) {
+	case nil:
+		return false
+	case []string:
+		for _, item := range current {
+			if sameAssigneeEmail(item, value) {
+				return true
+			}
+		}
+		return false
+	case []interface{}:
+		for _, item := range current {
+			if sameAssigneeEmail(assigneeValueString(item), value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return sameAssigneeEmail(assigneeValueString(current), value)
+	}
 }
 
 // Phase 9: Interactive Mode
@@ -1431,12 +2743,159 @@ func getCurrentAssignment(workItemPath, fieldName string, cfg *config.Config) (s
 	return value, nil
 }
 
+// assigneesRemovedBySet returns the entries a plain set of email would drop from the field.
+// Only array values are considered: replacing a single scalar assignee is a normal reassignment.
+// Emails compare case-insensitively, so re-setting the same address in another case drops nothing.
+func assigneesRemovedBySet(workItemPath, fieldName, email string, cfg *config.Config) ([]string, error) {
+	frontMatter, _, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse work item: %w", err)
+	}
+	return assigneesRemovedFromFrontMatter(frontMatter, fieldName, email), nil
+}
+
+// assigneesRemovedFromFrontMatter is assigneesRemovedBySet for already parsed front matter.
+func assigneesRemovedFromFrontMatter(frontMatter map[string]interface{}, fieldName, email string) []string {
+	var entries []string
+	switch v := frontMatter[fieldName].(type) {
+	case []string:
+		entries = v
+	case []interface{}:
+		for _, item := range v {
+			entries = append(entries, assigneeValueString(item))
+		}
+	default:
+		return nil
+	}
+
+	var removed []string
+	for _, entry := range entries {
+		if !sameAssigneeEmail(entry, email) {
+			removed = append(removed, entry)
+		}
+	}
+	return removed
+}
+
+// pickWorkItemArgs lets the user pick work items from a numbered list (--pick) and returns the
+// assign arguments: the picked work item paths followed by the given user identifier, if any.
+func pickWorkItemArgs(args []string, flags AssignFlags, cfg *config.Config, inputReader io.Reader) ([]string, error) {
+	if flags.JSON {
+		return nil, fmt.Errorf("invalid flag combination: --pick cannot be used together with --json")
+	}
+	if len(args) == 0 && !flags.Interactive && !flags.Unassign {
+		return nil, fmt.Errorf("user identifier is required with --pick (or use --interactive or --unassign)")
+	}
+	if inputReader == os.Stdin && !stdinIsTerminal() {
+		return nil, fmt.Errorf("--pick requires an interactive terminal; pass work item IDs instead")
+	}
+	if err := validateListStatus(flags.PickStatus, cfg); err != nil {
+		return nil, err
+	}
+
+	items, err := collectListedWorkItems(cfg, flags.PickStatus, false)
+	if err != nil {
+		return nil, err
+	}
+	picked, err := showWorkItemSelection(items, inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	pickedArgs := make([]string, 0, len(picked)+len(args))
+	for _, item := range picked {
+		pickedArgs = append(pickedArgs, item.Path)
+	}
+	return append(pickedArgs, args...), nil
+}
+
+// stdinIsTerminal reports whether stdin is an interactive terminal.
+func stdinIsTerminal() bool {
+	fi, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return (fi.Mode() & os.ModeCharDevice) != 0
+}
+
+// showWorkItemSelection lists work items with numbers and reads a multi-selection
+// (numbers separated by commas or spaces, or "all").
+func showWorkItemSelection(items []listedWorkItem, inputReader io.Reader) ([]listedWorkItem, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no work items available for selection")
+	}
+
+	fmt.Println("Work items:")
+	fmt.Println(strings.Repeat("-", 50))
+	for i, item := range items {
+		fmt.Printf("%d. %s [%s] %s\n", i+1, item.ID, item.Status, item.Title)
+	}
+	fmt.Println()
+
+	const maxRetries = 3
+	reader := bufio.NewReader(inputReader)
+
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		fmt.Print("Select work items (numbers separated by commas or spaces, or 'all'): ")
+		input, err := reader.ReadString('\n')
+		if err != nil && (err != io.EOF || strings.TrimSpace(input) == "") {
+			return nil, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		selection, err := parseWorkItemSelection(input, len(items))
+		if err != nil {
+			fmt.Printf("Invalid selection: %v\n", err)
+			continue
+		}
+
+		picked := make([]listedWorkItem, len(selection))
+		for i, n := range selection {
+			picked[i] = items[n-1]
+		}
+		return picked, nil
+	}
+
+	return nil, fmt.Errorf("too many invalid input attempts")
+}
+
+// parseWorkItemSelection parses a multi-selection of 1-based numbers up to count, in input order
+// without duplicates. "all" selects every item.
+func parseWorkItemSelection(input string, count int) ([]int, error) {
+	input = strings.TrimSpace(input)
+	if strings.EqualFold(input, "all") {
+		selection := make([]int, count)
+		for i := range selection {
+			selection[i] = i + 1
+		}
+		return selection, nil
+	}
+
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("please enter at least one number (1-%d) or 'all'", count)
+	}
+	seen := make(map[int]bool)
+	var selection []int
+	for _, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 || n > count {
+			return nil, fmt.Errorf("'%s' is not a number between 1 and %d", field, count)
+		}
+		if !seen[n] {
+			seen[n] = true
+			selection = append(selection, n)
+		}
+	}
+	return selection, nil
+}
+
 // showInteractiveSelection displays users in a numbered list and prompts for selection.
-// Returns the selected user number (0 for unassign, 1+ for users) or an error.
-// The inputReader parameter allows for testing by providing a mock input source.
-func showInteractiveSelection(users []UserInfo, currentAssignment, fieldName string, inputReader io.Reader) (int, error) {
+// Returns the selected user numbers: []int{0} for unassign, or one or more user numbers entered
+// comma-separated (e.g. 1,3,4), without duplicates. The inputReader parameter allows for testing by
+// providing a mock input source.
+func showInteractiveSelection(users []UserInfo, currentAssignment, fieldName string, inputReader io.Reader) ([]int, error) {
 	if len(users) == 0 {
-		return 0, fmt.Errorf("no users available for selection")
+		return nil, fmt.Errorf("no users available for selection")
 	}
 
 	// Display header
@@ -1463,27 +2922,45 @@ func showInteractiveSelection(users []UserInfo, currentAssignment, fieldName str
 	reader := bufio.NewReader(inputReader)
 
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		fmt.Print("Select user (number): ")
+		fmt.Print("Select user (number, or several separated by commas to add them all): ")
 		input, err := reader.ReadString('\n')
 		if err != nil {
-			return 0, fmt.Errorf("failed to read input: %w", err)
+			return nil, fmt.Errorf("failed to read input: %w", err)
 		}
 
-		input = strings.TrimSpace(input)
-		selection, err := strconv.Atoi(input)
-		if err != nil {
-			fmt.Printf("Invalid input: please enter a number (0-%d)\n", len(users))
+		selection, problem := parseUserSelection(input, len(users))
+		if problem != "" {
+			fmt.Println(problem)
 			continue
 		}
+		return selection, nil
+	}
+
+	return nil, fmt.Errorf("too many invalid input attempts")
+}
 
+// parseUserSelection parses comma-separated user numbers between 0 and count, dropping
+// duplicates. 0 (unassign) must be entered on its own. Returns a message for the user when the
+// input is invalid.
+func parseUserSelection(input string, count int) ([]int, string) {
+	var selection []int
+	seen := make(map[int]bool)
+	for _, field := range strings.Split(input, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Sprintf("Invalid input: please enter a number (0-%d), or several separated by commas", count)
+		}
 		// Validate selection is within valid range
-		if selection < 0 || selection > len(users) {
-			fmt.Printf("Invalid selection: please enter a number between 0 and %d\n", len(users))
-			continue
+		if n < 0 || n > count {
+			return nil, fmt.Sprintf("Invalid selection: please enter numbers between 0 and %d", count)
+		}
+		if !seen[n] {
+			seen[n] = true
+			selection = append(selection, n)
 		}
-
-		return selection, nil
 	}
-
-	return 0, fmt.Errorf("too many invalid input attempts")
+	if len(selection) > 1 && seen[0] {
+		return nil, "Invalid selection: 0 (unassign) cannot be combined with users"
+	}
+	return selection, ""
 }
@@ -0,0 +1,62 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"time"
+	"unicode"
+)
+
+// checkAppendTarget refuses to append userEmail to a field whose current value is a scalar that
+// does not look like a person, such as estimate: 5 or blocked: true, since turning it into a
+// list of people is almost always a mistake. Missing and empty fields, lists, and strings that
+// look like an email or a name are fine, as are assignee objects. forceType (--force-type) skips the check.
+func checkAppendTarget(frontMatter map[string]interface{}, fieldName, userEmail string, forceType bool) error {
+	if forceType {
+		return nil
+	}
+	current := frontMatter[fieldName]
+	switch value := current.(type) {
+	case nil, []string, []interface{}:
+		return nil
+	case string:
+		if value == "" || looksLikeAssignee(value) {
+			return nil
+		}
+	case map[string]interface{}:
+		if _, ok := value[richAssigneeEmailKey]; ok {
+			return nil
+		}
+	}
+	return fmt.Errorf("refusing to append %s to field '%s': its current value %v is a %s, not a user (use --force-type to append anyway)",
+		userEmail, fieldName, current, frontMatterValueType(current))
+}
+
+// looksLikeAssignee reports whether a field value could name a person: an email, or a name,
+// i.e. anything with a letter in it. Values like "5" or "2024-06-30" do not.
+func looksLikeAssignee(value string) bool {
+	for _, r := range value {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// frontMatterValueType names the type of a front matter value for error messages.
+func frontMatterValueType(value interface{}) string {
+	switch value.(type) {
+	case int, int64, uint64, float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case time.Time:
+		return "date"
+	case string:
+		return "string"
+	case map[string]interface{}:
+		return "map"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,178 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// assignResumeFile is the file, inside the work folder, that lists the work items a chunked
+// kira assign run (--max-batch) has not finished yet.
+const assignResumeFile = ".kira-assign-resume"
+
+// assignResumePath returns the path of the resume file for the workspace.
+func assignResumePath(cfg *config.Config) (string, error) {
+	workDir, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve work directory: %w", err)
+	}
+	return filepath.Join(workDir, assignResumeFile), nil
+}
+
+// processAssignBatches processes the work items in chunks of flags.MaxBatch, printing a
+// checkpoint after each chunk. Before each chunk the resume file is rewritten with the work items
+// not yet finished, so an interrupted run can be continued with --resume; it is removed once all
+// chunks are done. Without --max-batch all work items are processed in a single pass (as one
+// chunk with --resume, so the resume file is still removed at the end).
+func processAssignBatches(workItemPaths []string, resolvedUser *UserInfo, flags AssignFlags, users []UserInfo, cfg *config.Config) ([]WorkItemUpdateResult, error) {
+	batchSize := flags.MaxBatch
+	if batchSize <= 0 {
+		if !flags.Resume {
+			return processWorkItemUpdates(workItemPaths, resolvedUser, flags, users, cfg), nil
+		}
+		batchSize = len(workItemPaths)
+	}
+
+	resumePath, err := assignResumePath(cfg)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]string, len(workItemPaths))
+	for i, path := range workItemPaths {
+		identifiers[i] = assignResumeIdentifier(path, cfg)
+	}
+
+	out := os.Stdout
+	if flags.JSON || flags.SummaryOnly {
+		out = os.Stderr
+	}
+	var results []WorkItemUpdateResult
+	for i, chunk := range chunkWorkItems(workItemPaths, batchSize) {
+		done := i * batchSize
+		if !flags.DryRun {
+			if err := writeAssignResumeFile(resumePath, identifiers[done:]); err != nil {
+				return results, fmt.Errorf("failed to write resume file: %w", err)
+			}
+		}
+		results = append(results, processWorkItemUpdates(chunk, resolvedUser, flags, users, cfg)...)
+		displayAssignCheckpoint(out, results, len(workItemPaths))
+	}
+	if !flags.DryRun {
+		if err := os.Remove(resumePath); err != nil && !os.IsNotExist(err) {
+			return results, fmt.Errorf("failed to remove resume file: %w", err)
+		}
+	}
+	return results, nil
+}
+
+// validateAssignBatchFlags checks --max-batch and --resume.
+func validateAssignBatchFlags(flags AssignFlags) error {
+	if flags.MaxBatch < 0 {
+		return fmt.Errorf("--max-batch must be a positive number of work items")
+	}
+	if flags.Resume && (flags.Pick || flags.FileList != "") {
+		return fmt.Errorf("invalid flag combination: --resume cannot be used with --pick or --file-list")
+	}
+	if flags.FromCodeowners {
+		return rejectAssignBatchFlags(flags, "--set-from-codeowners")
+	}
+	return nil
+}
+
+// rejectAssignBatchFlags rejects --max-batch and --resume for mode. Only a single user assigned
+// to a list of work items (or to a --file-list) runs in chunks with a resume file; the other
+// modes (--set-from-codeowners, @author, id=user pairs) process their work items in one pass.
+func rejectAssignBatchFlags(flags AssignFlags, mode string) error {
+	if flags.MaxBatch > 0 || flags.Resume {
+		return fmt.Errorf("invalid flag combination: --max-batch and --resume cannot be used with %s", mode)
+	}
+	return nil
+}
+
+// chunkWorkItems splits paths into consecutive chunks of at most size items.
+func chunkWorkItems(paths []string, size int) [][]string {
+	var chunks [][]string
+	for size < len(paths) {
+		chunks = append(chunks, paths[:size:size])
+		paths = paths[size:]
+	}
+	if len(paths) > 0 {
+		chunks = append(chunks, paths)
+	}
+	return chunks
+}
+
+// displayAssignCheckpoint prints the progress after a chunk, e.g.
+// "Checkpoint: 200/1000 work items processed (199 succeeded, 1 failed)".
+func displayAssignCheckpoint(out io.Writer, results []WorkItemUpdateResult, total int) {
+	succeeded, failed := countAssignResults(results)
+	_, _ = fmt.Fprintf(out, "Checkpoint: %d/%d work items processed (%d succeeded, %d failed)\n", len(results), total, succeeded, failed)
+}
+
+// assignResumeIdentifier returns the work item ID to record in the resume file, or its path when
+// the work item has no ID. IDs stay valid when --move relocates the file.
+func assignResumeIdentifier(path string, cfg *config.Config) string {
+	frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+	if err == nil {
+		if id := frontMatterIDString(frontMatter["id"]); id != "" {
+			return id
+		}
+	}
+	return path
+}
+
+// resumeWorkItemArgs returns the assign arguments for --resume: the work items listed in the
+// resume file followed by the given user identifier, if any.
+func resumeWorkItemArgs(args []string, cfg *config.Config) ([]string, error) {
+	resumePath, err := assignResumePath(cfg)
+	if err != nil {
+		return nil, err
+	}
+	identifiers, err := readAssignResumeFile(resumePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(identifiers) == 0 {
+		return nil, fmt.Errorf("resume file %s lists no work items", resumePath)
+	}
+	return append(identifiers, args...), nil
+}
+
+// writeAssignResumeFile writes the remaining work item identifiers, one per line.
+func writeAssignResumeFile(path string, identifiers []string) error {
+	content := "# Remaining work items of an interrupted kira assign run; continue with kira assign --resume\n" +
+		strings.Join(identifiers, "\n") + "\n"
+	return os.WriteFile(path, []byte(content), 0o600)
+}
+
+// readAssignResumeFile returns the work item identifiers listed in the resume file, skipping
+// blank lines and # comments.
+func readAssignResumeFile(path string) ([]string, error) {
+	// #nosec G304 - path is the resume file inside the work folder
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no interrupted kira assign run to resume (%s does not exist)", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resume file: %w", err)
+	}
+	return parseAssignResumeFile(string(content)), nil
+}
+
+// parseAssignResumeFile parses the content of a resume file.
+func parseAssignResumeFile(content string) []string {
+	var identifiers []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		identifiers = append(identifiers, line)
+	}
+	return identifiers
+}
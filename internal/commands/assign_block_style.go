@@ -0,0 +1,86 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"kira/internal/config"
+)
+
+// blockSequence is a list field to be written in block style ("- item" per line) rather than the
+// flow style ([a, b]) writeYAMLFieldValue uses for lists, so rewriting a work item keeps the style
+// its author chose. Indent is the number of spaces before each "-".
+type blockSequence struct {
+	Items  []interface{}
+	Indent int
+}
+
+// frontMatterBlockSequences returns the top-level front matter fields of the work item at
+// filePath that hold a block-style list, with the indentation of their items. Files whose front
+// matter cannot be read yield no fields, leaving every list in flow style.
+func frontMatterBlockSequences(filePath string, cfg *config.Config) map[string]int {
+	content, err := safeReadFile(filePath, cfg)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(string(content), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != yamlSeparator {
+		return nil
+	}
+	var yamlLines []string
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == yamlSeparator {
+			break
+		}
+		yamlLines = append(yamlLines, line)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil
+	}
+	indents := make(map[string]int)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		value := root.Content[i+1]
+		if value.Kind == yaml.SequenceNode && value.Style&yaml.FlowStyle == 0 && len(value.Content) > 0 {
+			indents[root.Content[i].Value] = value.Column - 1
+		}
+	}
+	return indents
+}
+
+// keepBlockSequences wraps the list fields of frontMatter that were block-style lists (indents,
+// from frontMatterBlockSequences) as blockSequence, so they are written back the same way.
+// Lists of assignee objects are left alone: they are always written in block style.
+func keepBlockSequences(frontMatter map[string]interface{}, indents map[string]int) {
+	for field, indent := range indents {
+		var items []interface{}
+		switch value := frontMatter[field].(type) {
+		case []string:
+			for _, item := range value {
+				items = append(items, item)
+			}
+		case []interface{}:
+			items = value
+		}
+		if len(items) > 0 && !containsMap(items) {
+			frontMatter[field] = blockSequence{Items: items, Indent: indent}
+		}
+	}
+}
+
+// writeYAMLBlockSequence writes a block-style list field, one "- item" line per item.
+func writeYAMLBlockSequence(sb *strings.Builder, key string, value blockSequence) {
+	fmt.Fprintf(sb, "%s:\n", key)
+	indent := strings.Repeat(" ", value.Indent)
+	for _, item := range value.Items {
+		fmt.Fprintf(sb, "%s- %s\n", indent, yamlFormatArrayItem(item))
+	}
+}
@@ -0,0 +1,127 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"kira/internal/config"
+)
+
+// defaultAssignChangelogFile is the changelog kira assign writes to, inside the work folder,
+// when assignment.changelog is enabled and --changelog is not given.
+const defaultAssignChangelogFile = "CHANGELOG.md"
+
+// assignChangelogPath returns the changelog file to append to: --changelog when given, else
+// <work folder>/CHANGELOG.md when assignment.changelog is enabled, else "" (no changelog).
+func assignChangelogPath(flagPath string, cfg *config.Config) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	if cfg.Assignment != nil && cfg.Assignment.Changelog {
+		return filepath.Join(config.GetWorkFolderPath(cfg), defaultAssignChangelogFile)
+	}
+	return ""
+}
+
+// assignChangelogLines formats one line per change made by the successful results, e.g.
+// "2024-01-01 assign 001 -> alice@example.com by bob@example.com". A --move adds its own
+// "move 001 -> doing" line and a --message note is appended as note: "...". Results that
+// changed nothing are left out.
+func assignChangelogLines(results []WorkItemUpdateResult, actor string, now time.Time) []string {
+	date := now.Format("2006-01-02")
+	var lines []string
+	for _, result := range results {
+		if !result.Success || result.Operation == opAlreadyAssigned {
+			continue
+		}
+		field := ""
+		if result.Field != "" && result.Field != "assigned" {
+			field = fmt.Sprintf(" (field: %s)", result.Field)
+		}
+		switch {
+		case result.Operation == "unassign" && len(result.Cleared) > 0:
+			lines = append(lines, fmt.Sprintf("%s unassign %s by %s%s", date, result.WorkItemID, actor, field))
+		case result.User != "":
+			lines = append(lines, fmt.Sprintf("%s %s %s -> %s by %s%s%s", date, result.Operation, result.WorkItemID, result.User, actor, field, changelogNote(result.Note)))
+		}
+		if result.MovedTo != "" {
+			lines = append(lines, fmt.Sprintf("%s move %s -> %s by %s", date, result.WorkItemID, result.MovedTo, actor))
+		}
+	}
+	return lines
+}
+
+// changelogNote formats the --message note appended to a changelog line, or "" without one.
+func changelogNote(note string) string {
+	if note == "" {
+		return ""
+	}
+	return fmt.Sprintf(" note: \"%s\"", note)
+}
+
+// appendAssignChangelog appends the changelog lines for results to path, creating the file
+// when it does not exist. All lines are written with a single append so concurrent runs do
+// not interleave partial lines.
+func appendAssignChangelog(path string, results []WorkItemUpdateResult, now time.Time) error {
+	lines := assignChangelogLines(results, changelogActor(), now)
+	if len(lines) == 0 {
+		return nil
+	}
+	entry := strings.Join(lines, "\n") + "\n"
+	if needsLeadingNewline(path) {
+		entry = "\n" + entry
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+	}
+	// #nosec G304 - path is the changelog chosen by the user (--changelog) or the work folder default
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(entry); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// needsLeadingNewline reports whether the file at path is non-empty and does not end with a
+// newline, so appended lines would otherwise join its last line.
+func needsLeadingNewline(path string) bool {
+	// #nosec G304 - path is the changelog chosen by the user (--changelog) or the work folder default
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer func() { _ = file.Close() }()
+	info, err := file.Stat()
+	if err != nil || info.Size() == 0 {
+		return false
+	}
+	last := make([]byte, 1)
+	if _, err := file.ReadAt(last, info.Size()-1); err != nil {
+		return false
+	}
+	return last[0] != '\n'
+}
+
+// changelogActor returns who made the change: git user.email, else user.name, else "unknown".
+func changelogActor() string {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	for _, key := range []string{"user.email", "user.name"} {
+		if value, err := executeCommand(ctx, "git", []string{"config", key}, "", false); err == nil && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return unknownValue
+}
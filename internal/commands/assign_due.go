@@ -0,0 +1,100 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"kira/internal/config"
+)
+
+// dueField is the front matter field kira assign --due writes.
+const dueField = "due"
+
+// dueDateLayout is the format of --due values and of the due field.
+const dueDateLayout = "2006-01-02"
+
+// validateAssignDue checks a --due value: a date such as 2024-06-30, or "" to clear the field.
+func validateAssignDue(flags AssignFlags) error {
+	if !flags.DueSet {
+		return nil
+	}
+	if flags.Unassign && flags.Due != "" {
+		return fmt.Errorf("invalid flag combination: --due cannot set a date together with --unassign (unassigning clears due)")
+	}
+	if flags.Due == "" {
+		return nil
+	}
+	if _, err := time.Parse(dueDateLayout, flags.Due); err != nil {
+		return fmt.Errorf("invalid --due '%s': use a date like 2024-06-30, or \"\" to clear it", flags.Due)
+	}
+	return nil
+}
+
+// setAssignDue applies --due to frontMatter: a date is written to the due field and "" removes
+// it. Unassigning also removes due, since the deadline belonged to the assignment. Reports whether
+// frontMatter changed.
+func setAssignDue(frontMatter map[string]interface{}, flags AssignFlags) bool {
+	_, hasDue := frontMatter[dueField]
+	switch {
+	case flags.Unassign || (flags.DueSet && flags.Due == ""):
+		delete(frontMatter, dueField)
+		return hasDue
+	case flags.DueSet:
+		if current, ok := parseWorkItemTimestamp(frontMatter[dueField]); ok && current.Format(dueDateLayout) == flags.Due {
+			return false
+		}
+		frontMatter[dueField] = flags.Due
+		return true
+	}
+	return false
+}
+
+// setAssignFollowUps applies --due and --message (or their removal by --unassign) to the front
+// matter an assignment is about to write, so they land in the same write. Reports whether
+// frontMatter changed, and with --unassign the follow-up fields that were removed.
+func setAssignFollowUps(frontMatter map[string]interface{}, flags AssignFlags) (changed bool, cleared []string) {
+	dueChanged := setAssignDue(frontMatter, flags)
+	noteChanged := setAssignNote(frontMatter, flags)
+	if flags.Unassign {
+		if dueChanged {
+			cleared = append(cleared, dueField)
+		}
+		if noteChanged {
+			cleared = append(cleared, assignNoteField)
+		}
+	}
+	return dueChanged || noteChanged, cleared
+}
+
+// applyAssignFollowUps writes only --due and --message to a work item whose assignment itself
+// leaves it unchanged (already assigned to the user), so its due date and note still update.
+func applyAssignFollowUps(workItemPath string, flags AssignFlags, cfg *config.Config) error {
+	if !flags.DueSet && !flags.MessageSet {
+		return nil
+	}
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to parse work item: %w", err)
+	}
+	if changed, _ := setAssignFollowUps(frontMatter, flags); !changed {
+		return nil
+	}
+	updateTimestamp(frontMatter)
+	if err := writeWorkItemFrontMatter(workItemPath, frontMatter, bodyLines); err != nil {
+		return fmt.Errorf("failed to write work item: %w", err)
+	}
+	return nil
+}
+
+// describeAssignDue returns the dry-run note for --due, or "" when --due is not given.
+func describeAssignDue(displayID string, flags AssignFlags) string {
+	switch {
+	case !flags.DueSet:
+		return ""
+	case flags.Due == "":
+		return fmt.Sprintf("Would clear the due date of work item %s", displayID)
+	default:
+		return fmt.Sprintf("Would set the due date of work item %s to %s", displayID, flags.Due)
+	}
+}
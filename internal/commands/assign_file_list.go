@@ -0,0 +1,148 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// stdinFileList is the --file-list value that reads the work item paths from stdin.
+const stdinFileList = "-"
+
+// runAssignFromFileList assigns the single user argument to every work item path listed in
+// flags.FileList, one path per line ("-" reads stdin). Lines that do not resolve to a work item
+// under the work folder are reported as failed results without stopping the others.
+func runAssignFromFileList(args []string, flags AssignFlags, cfg *config.Config, stdin io.Reader) error {
+	userIdentifier, err := validateFileListInput(args, flags, cfg)
+	if err != nil {
+		return err
+	}
+
+	lines, err := readWorkItemFileList(flags.FileList, stdin)
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return fmt.Errorf("no work item paths read from %s", fileListName(flags.FileList))
+	}
+
+	users, err := collectAssignUsers(flags, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to collect users: %w", err)
+	}
+	resolvedUser, err := resolveAssignUsers(userIdentifier, &flags, users)
+	if err != nil {
+		return err
+	}
+
+	// Lines that do not resolve are reported first; the work items run in --max-batch chunks
+	var results []WorkItemUpdateResult
+	paths := make([]string, 0, len(lines))
+	for _, line := range lines {
+		path, err := resolveFileListLine(line.text, cfg)
+		if err != nil {
+			results = append(results, WorkItemUpdateResult{
+				WorkItemPath: line.text,
+				WorkItemID:   fmt.Sprintf("line %d (%s)", line.number, line.text),
+				Error:        err,
+				Operation:    "validate",
+			})
+			continue
+		}
+		paths = append(paths, path)
+	}
+	batchResults, err := processAssignBatches(paths, resolvedUser, flags, users, cfg)
+	if err != nil {
+		return err
+	}
+	results = append(results, batchResults...)
+
+	listed := make([]string, len(lines))
+	for i, line := range lines {
+		listed[i] = line.text
+	}
+	return handleAssignResults(results, listed, flags, resolvedUser)
+}
+
+// validateFileListInput checks the flags and arguments of a --file-list run and returns the
+// user identifier, the only argument allowed.
+func validateFileListInput(args []string, flags AssignFlags, cfg *config.Config) (string, error) {
+	if flags.Pick || flags.Interactive || flags.FromCodeowners || hasAssignPairs(args) {
+		return "", fmt.Errorf("invalid flag combination: --file-list cannot be used with --pick, --interactive, --set-from-codeowners or id=user pairs")
+	}
+	if len(args) > 1 {
+		return "", fmt.Errorf("with --file-list, pass only the user identifier (work item paths are read from the list)")
+	}
+	userIdentifier := ""
+	if len(args) == 1 {
+		userIdentifier = args[0]
+	}
+	if err := validateAssignFlagCombinations(userIdentifier, flags); err != nil {
+		return "", err
+	}
+	if err := validateAssignUserIdentifierRequired(userIdentifier, flags); err != nil {
+		return "", err
+	}
+	if err := validateAssignMoveTarget(flags, cfg); err != nil {
+		return "", err
+	}
+	return userIdentifier, validateAssignFieldName(flags.Field)
+}
+
+// fileListLine is a non-empty line of a --file-list with its 1-based line number.
+type fileListLine struct {
+	number int
+	text   string
+}
+
+// readWorkItemFileList reads the non-empty, trimmed lines of the file list ("-" reads stdin).
+func readWorkItemFileList(fileList string, stdin io.Reader) ([]fileListLine, error) {
+	reader := stdin
+	if fileList != stdinFileList {
+		// #nosec G304 - fileList is the path the user passed to --file-list
+		file, err := os.Open(fileList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file list: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		reader = file
+	}
+
+	var lines []fileListLine
+	scanner := bufio.NewScanner(reader)
+	for number := 1; scanner.Scan(); number++ {
+		if text := strings.TrimSpace(scanner.Text()); text != "" {
+			lines = append(lines, fileListLine{number: number, text: text})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read file list from %s: %w", fileListName(fileList), err)
+	}
+	return lines, nil
+}
+
+// resolveFileListLine resolves one listed work item path and checks that it is a readable file
+// under the work folder.
+func resolveFileListLine(text string, cfg *config.Config) (string, error) {
+	path, err := resolveWorkItemPath(text, cfg)
+	if err != nil {
+		return "", err
+	}
+	if err := validateWorkItemFile(path, cfg); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// fileListName names the file list in messages.
+func fileListName(fileList string) string {
+	if fileList == stdinFileList {
+		return "stdin"
+	}
+	return fileList
+}
@@ -0,0 +1,178 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+
+	"kira/internal/config"
+)
+
+// Operation name for an --if-assignee unassign of a work item not assigned to that person.
+const opSkippedNotAssignee = "skipped"
+
+// validateIfAssigneeFlags checks --if-assignee: it only narrows --unassign, on a single field.
+func validateIfAssigneeFlags(flags AssignFlags) error {
+	if flags.IfAssignee == "" {
+		return nil
+	}
+	if !flags.Unassign {
+		return fmt.Errorf("--if-assignee requires --unassign")
+	}
+	if flags.Interactive || flags.MoveTo != "" {
+		return fmt.Errorf("invalid flag combination: --if-assignee cannot be used with --interactive or --move")
+	}
+	if len(splitAssignFields(flags.Field)) > 1 {
+		return fmt.Errorf("--if-assignee works on a single --field, got %s", flags.Field)
+	}
+	return nil
+}
+
+// resolveAssignUsers resolves the user identifier argument and, with --if-assignee, the person
+// whose assignments are removed (stored in flags.IfAssigneeUser).
+func resolveAssignUsers(userIdentifier string, flags *AssignFlags, users []UserInfo) (*UserInfo, error) {
+	if flags.IfAssignee != "" {
+		person, err := resolveUserIdentifier(flags.IfAssignee, users)
+		if err != nil {
+			return nil, fmt.Errorf("--if-assignee: %w", err)
+		}
+		flags.IfAssigneeUser = person
+	}
+	return resolveOptionalUserIdentifier(userIdentifier, users)
+}
+
+// processUnassignIfAssignee removes person from the field of a work item (--unassign
+// --if-assignee): a single assignee is cleared and a list loses only that person. A work item
+// not assigned to person is left untouched and reported as skipped.
+func processUnassignIfAssignee(
+	workItemPath string,
+	displayID string,
+	field string,
+	person *UserInfo,
+	followUps AssignFlags,
+	showProgress bool,
+	cfg *config.Config,
+) WorkItemUpdateResult {
+	result := WorkItemUpdateResult{
+		WorkItemPath: workItemPath,
+		WorkItemID:   displayID,
+		Operation:    "unassign",
+		User:         person.Email,
+	}
+
+	var clearedFollowUps []string
+	current, err := getCurrentAssignment(workItemPath, field, cfg)
+	if err == nil && !isCurrentAssignee(current, person) {
+		result.Success = true
+		result.Operation = opSkippedNotAssignee
+	} else if err == nil {
+		clearedFollowUps, err = removeAssigneeFromWorkItem(workItemPath, field, person, followUps, cfg)
+	}
+	if err != nil {
+		result.Error = fmt.Errorf("failed to update work item %s: %w", displayID, err)
+	} else if result.Operation != opSkippedNotAssignee {
+		result.Success = true
+		result.Cleared = append([]string{field}, clearedFollowUps...)
+	}
+	if showProgress {
+		displayWorkItemProgress(result)
+	}
+	return result
+}
+
+// removeAssigneeFromWorkItem removes person from the field, along with the follow-up fields
+// (see setAssignFollowUps), and writes the work item back. Returns the follow-up fields removed.
+func removeAssigneeFromWorkItem(workItemPath, field string, person *UserInfo, followUps AssignFlags, cfg *config.Config) ([]string, error) {
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse work item: %w", err)
+	}
+	if !removeAssignee(frontMatter, field, person) {
+		return nil, nil
+	}
+	_, cleared := setAssignFollowUps(frontMatter, followUps)
+	updateTimestamp(frontMatter)
+	if err := writeWorkItemFrontMatter(workItemPath, frontMatter, bodyLines); err != nil {
+		return nil, fmt.Errorf("failed to write work item: %w", err)
+	}
+	return cleared, nil
+}
+
+// removeAssignee removes the entries naming person from a list field, removing the field when
+// none are left, or clears a single-valued field naming person. Reports whether anything changed.
+func removeAssignee(frontMatter map[string]interface{}, field string, person *UserInfo) bool {
+	var items []interface{}
+	switch current := frontMatter[field].(type) {
+	case []string:
+		for _, item := range current {
+			items = append(items, item)
+		}
+	case []interface{}:
+		items = current
+	default:
+		value, exists := frontMatter[field]
+		if !exists || !isCurrentAssignee(assigneeValueString(value), person) {
+			return false
+		}
+		return clearField(frontMatter, field)
+	}
+
+	remaining := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		if !isCurrentAssignee(assigneeValueString(item), person) {
+			remaining = append(remaining, item)
+		}
+	}
+	switch {
+	case len(remaining) == len(items):
+		return false
+	case len(remaining) == 0:
+		delete(frontMatter, field)
+	default:
+		frontMatter[field] = assigneeList(remaining)
+	}
+	return true
+}
+
+// describeIfAssigneeDryRun marks a dry-run result of --unassign --if-assignee as skipped when
+// the work item is not assigned to the person, and prints what a real run would do.
+func describeIfAssigneeDryRun(res *WorkItemUpdateResult, field string, flags AssignFlags, cfg *config.Config) {
+	person := flags.IfAssigneeUser
+	current, err := getCurrentAssignment(res.WorkItemPath, field, cfg)
+	if err != nil {
+		return
+	}
+	if !isCurrentAssignee(current, person) {
+		res.Operation = opSkippedNotAssignee
+		res.User = person.Email
+		res.Would = nil
+	}
+	if flags.JSON || flags.SummaryOnly {
+		return
+	}
+	if res.Operation == opSkippedNotAssignee {
+		fmt.Printf("Would skip work item %s: not assigned to %s\n", res.WorkItemID, formatUserAs(*person, flags.AssigneeDisplay))
+		return
+	}
+	fmt.Printf("Would remove %s from work item %s (field: %s)\n", formatUserAs(*person, flags.AssigneeDisplay), res.WorkItemID, field)
+}
+
+// countSkippedResults returns how many results are --if-assignee skips.
+func countSkippedResults(results []WorkItemUpdateResult) int {
+	skipped := 0
+	for _, result := range results {
+		if result.Operation == opSkippedNotAssignee {
+			skipped++
+		}
+	}
+	return skipped
+}
+
+// formatAssignCounts formats the summary counts, "N succeeded, M failed", or with --if-assignee
+// skips "N cleared, K skipped, M failed".
+func formatAssignCounts(results []WorkItemUpdateResult) string {
+	succeeded, failed := countAssignResults(results)
+	if skipped := countSkippedResults(results); skipped > 0 {
+		return fmt.Sprintf("%d cleared, %d skipped, %d failed", succeeded-skipped, skipped, failed)
+	}
+	return fmt.Sprintf("%d succeeded, %d failed", succeeded, failed)
+}
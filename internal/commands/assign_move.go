@@ -0,0 +1,237 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// validateAssignMoveTarget checks the --move status against status_folders.
+func validateAssignMoveTarget(flags AssignFlags, cfg *config.Config) error {
+	if flags.MoveTo == "" {
+		return nil
+	}
+	if flags.Interactive {
+		return fmt.Errorf("invalid flag combination: --move cannot be used together with --interactive")
+	}
+	if err := validateListStatus(flags.MoveTo, cfg); err != nil {
+		return fmt.Errorf("--move: %w", err)
+	}
+	return nil
+}
+
+// processAssignAndMoveWorkItem assigns (or appends, or unassigns) and moves a work item to
+// flags.MoveTo in one write. The front matter is parsed once, the field, status and updated
+// timestamp are set together, and the result is written to the target status folder before the
+// original file is removed. If any step fails the original file is left unchanged.
+func processAssignAndMoveWorkItem(
+	workItemPath string,
+	displayID string,
+	resolvedUser *UserInfo,
+	flags AssignFlags,
+	showProgress bool,
+	cfg *config.Config,
+) WorkItemUpdateResult {
+	result := WorkItemUpdateResult{
+		WorkItemPath: workItemPath,
+		WorkItemID:   displayID,
+		Operation:    dryRunIntent(flags.Field, resolvedUser, flags).Operation,
+	}
+	if renumber, err := assignAndMoveWorkItem(workItemPath, displayID, []*UserInfo{resolvedUser}, flags, cfg); err != nil {
+		result.Error = err
+	} else {
+		result.Success = true
+		result.MovedTo = flags.MoveTo
+		result.NewID = renumber.renumberedID()
+		if resolvedUser != nil && !flags.Unassign {
+			result.User = resolvedUser.Email
+		}
+	}
+	if showProgress {
+		displayWorkItemProgress(result)
+	}
+	return result
+}
+
+// assignAndMoveWorkItem applies the assignment of each user in turn (one nil user to unassign)
+// and the move to flags.MoveTo in a single parse and write of the work item. With
+// naming.per_folder_ids the new file name and id are part of that write, and the ID change is
+// returned.
+func assignAndMoveWorkItem(workItemPath, displayID string, resolvedUsers []*UserInfo, flags AssignFlags, cfg *config.Config) (*workItemRenumber, error) {
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse work item %s: %w", displayID, err)
+	}
+	if frontMatter == nil {
+		frontMatter = make(map[string]interface{})
+	}
+
+	for _, resolvedUser := range resolvedUsers {
+		if err := applyAssignment(frontMatter, displayID, resolvedUser, flags, cfg); err != nil {
+			return nil, err
+		}
+	}
+	if flags.Append {
+		// As in updateWorkItemFieldAppend, block-style lists keep their style
+		keepBlockSequences(frontMatter, frontMatterBlockSequences(workItemPath, cfg))
+	}
+	setAssignFollowUps(frontMatter, flags)
+	frontMatter["status"] = flags.MoveTo
+	updateTimestamp(frontMatter)
+
+	targetPath, renumber, err := assignMoveTargetPath(workItemPath, flags.MoveTo, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if renumber != nil {
+		frontMatter["id"] = renumber.newID
+	}
+	if err := writeMovedWorkItem(workItemPath, targetPath, frontMatter, bodyLines); err != nil {
+		return nil, fmt.Errorf("failed to assign and move work item %s to %s (work item unchanged): %w", displayID, flags.MoveTo, err)
+	}
+	return renumber, nil
+}
+
+// applyAssignment sets, appends or clears the assignee field in the parsed front matter of a work
+// item, refusing what a separate assign would refuse.
+func applyAssignment(frontMatter map[string]interface{}, displayID string, resolvedUser *UserInfo, flags AssignFlags, cfg *config.Config) error {
+	if resolvedUser == nil && !flags.Unassign {
+		return fmt.Errorf("user identifier is required for assignment")
+	}
+	switch {
+	case flags.Unassign:
+		clearFields(frontMatter, splitAssignFields(flags.Field), flags.PruneEmpty)
+	case flags.Append:
+		if err := checkAppendTarget(frontMatter, flags.Field, resolvedUser.Email, flags.ForceType); err != nil {
+			return fmt.Errorf("failed to update work item %s: %w", displayID, err)
+		}
+		appendToField(frontMatter, flags.Field, assigneeEntry(resolvedUser.Email, cfg))
+	default:
+		if removed := assigneesRemovedFromFrontMatter(frontMatter, flags.Field, resolvedUser.Email); len(removed) > 0 && !flags.Force {
+			return fmt.Errorf("assigning %s to %s on work item %s would remove: %s (use --append to add, or --force to replace)",
+				resolvedUser.Email, flags.Field, displayID, strings.Join(removed, ", "))
+		}
+		setAssigneeValue(frontMatter, flags.Field, resolvedUser.Email, cfg)
+	}
+	return nil
+}
+
+// processCodeownersMove adds all the CODEOWNERS owners of a work item and moves it to
+// flags.MoveTo in one write: moving it after the first owner would leave the next owner updating
+// a file that is gone. Each owner gets a result, all with the outcome of that write.
+func processCodeownersMove(workItemPath string, owners []*UserInfo, flags AssignFlags, cfg *config.Config) []WorkItemUpdateResult {
+	if err := checkAssignTargetStatus(os.Stderr, workItemPath, flags, cfg); err != nil {
+		return []WorkItemUpdateResult{terminalStatusResult(workItemPath, err, flags, false, cfg)}
+	}
+	displayID := getWorkItemDisplayID(workItemPath, cfg)
+	flags.Field = resolveAssignField(workItemPath, flags, cfg)
+	itemUsers := make([]*UserInfo, 0, len(owners))
+	for _, owner := range owners {
+		itemUser, err := transformAssignee(owner, cfg)
+		if err != nil {
+			result := transformFailedResult(workItemPath, displayID, err, false)
+			result.Field = flags.Field
+			return []WorkItemUpdateResult{result}
+		}
+		itemUsers = append(itemUsers, itemUser)
+	}
+
+	renumber, err := assignAndMoveWorkItem(workItemPath, displayID, itemUsers, flags, cfg)
+	results := make([]WorkItemUpdateResult, 0, len(itemUsers))
+	for _, itemUser := range itemUsers {
+		result := WorkItemUpdateResult{
+			WorkItemPath: workItemPath,
+			WorkItemID:   displayID,
+			Operation:    "append",
+			Field:        flags.Field,
+			Success:      err == nil,
+			Error:        err,
+		}
+		if err == nil {
+			result.MovedTo = flags.MoveTo
+			result.NewID = renumber.renumberedID()
+			result.User = itemUser.Email
+			result.Note = flags.Message
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// assignMoveTargetPath returns the absolute path of the work item in the folder of status, and
+// its ID change when it takes the next free ID of that folder (naming.per_folder_ids).
+func assignMoveTargetPath(workItemPath, status string, cfg *config.Config) (string, *workItemRenumber, error) {
+	workFolder, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+	filename := filepath.Base(workItemPath)
+	renumber, err := perFolderRenumber(cfg, workItemPath, filepath.Join(config.GetWorkFolderPath(cfg), cfg.StatusFolders[status]))
+	if err != nil {
+		return "", nil, err
+	}
+	if renumber != nil {
+		filename = renumberedFilename(filename, renumber.newID)
+	}
+	return filepath.Join(workFolder, cfg.StatusFolders[status], filename), renumber, nil
+}
+
+// writeMovedWorkItem writes the updated work item to targetPath and then removes the original.
+// The original is only removed once the new file is written, and the new file is removed again
+// if that fails, so a failure never leaves the work item half updated.
+func writeMovedWorkItem(workItemPath, targetPath string, frontMatter map[string]interface{}, bodyLines []string) error {
+	sourcePath, err := filepath.Abs(workItemPath)
+	if err != nil {
+		return err
+	}
+	if filepath.Clean(sourcePath) == filepath.Clean(targetPath) {
+		return writeWorkItemFrontMatter(sourcePath, frontMatter, bodyLines)
+	}
+
+	if _, err := os.Stat(targetPath); err == nil {
+		return fmt.Errorf("%s already exists", targetPath)
+	}
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create status folder: %w", err)
+	}
+	if err := writeWorkItemFrontMatter(targetPath, frontMatter, bodyLines); err != nil {
+		_ = os.Remove(targetPath)
+		return err
+	}
+	if err := os.Remove(sourcePath); err != nil {
+		_ = os.Remove(targetPath)
+		return fmt.Errorf("failed to remove %s: %w", workItemPath, err)
+	}
+	return nil
+}
+
+// displayAssignMoveDryRun prints the status move a real run would make after the assignment.
+func displayAssignMoveDryRun(path, displayID string, flags AssignFlags, cfg *config.Config) {
+	current := unknownValue
+	if frontMatter, _, err := parseWorkItemFrontMatter(path, cfg); err == nil {
+		if status, ok := frontMatter["status"].(string); ok && status != "" {
+			current = status
+		}
+	}
+	targetPath, renumber, err := assignMoveTargetPath(path, flags.MoveTo, cfg)
+	if err != nil {
+		fmt.Printf("Would fail to move work item %s to %s: %v\n", displayID, flags.MoveTo, err)
+		return
+	}
+	fmt.Printf("Would move work item %s from %s to %s (%s)\n", displayID, current, flags.MoveTo, targetPath)
+	renumber.display(os.Stdout, true)
+}
+
+// displayAssignMoveResult prints the status move of a single successful --move assignment.
+func displayAssignMoveResult(result WorkItemUpdateResult) {
+	switch {
+	case result.MovedTo != "" && result.NewID != "":
+		fmt.Printf("Moved work item %s to %s as %s (IDs are per status folder)\n", result.WorkItemID, result.MovedTo, result.NewID)
+	case result.MovedTo != "":
+		fmt.Printf("Moved work item %s to %s\n", result.WorkItemID, result.MovedTo)
+	}
+}
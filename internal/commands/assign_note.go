@@ -0,0 +1,63 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// assignNoteField is the front matter field kira assign --message writes.
+const assignNoteField = "assign_note"
+
+// sanitizeAssignNote turns a --message value into a single line: line breaks, tabs and other
+// control characters become spaces and runs of spaces are collapsed, so the note can neither
+// break the front matter nor a changelog line.
+func sanitizeAssignNote(message string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, message)
+	return strings.Join(strings.Fields(cleaned), " ")
+}
+
+// validateAssignNote rejects a --message note together with --unassign, which clears the note.
+func validateAssignNote(flags AssignFlags) error {
+	if flags.MessageSet && flags.Unassign && flags.Message != "" {
+		return fmt.Errorf("invalid flag combination: --message cannot set a note together with --unassign (unassigning clears %s)", assignNoteField)
+	}
+	return nil
+}
+
+// setAssignNote applies --message to frontMatter: a note is written to the assign_note field and
+// "" removes it. Unassigning also removes the note, since it belonged to the assignment. Reports
+// whether frontMatter changed.
+func setAssignNote(frontMatter map[string]interface{}, flags AssignFlags) bool {
+	current, hasNote := frontMatter[assignNoteField]
+	switch {
+	case flags.Unassign || (flags.MessageSet && flags.Message == ""):
+		delete(frontMatter, assignNoteField)
+		return hasNote
+	case flags.MessageSet:
+		if current == flags.Message {
+			return false
+		}
+		frontMatter[assignNoteField] = flags.Message
+		return true
+	}
+	return false
+}
+
+// describeAssignNote returns the dry-run note for --message, or "" when --message is not given.
+func describeAssignNote(displayID string, flags AssignFlags) string {
+	switch {
+	case !flags.MessageSet:
+		return ""
+	case flags.Message == "":
+		return fmt.Sprintf("Would clear the assignment note of work item %s", displayID)
+	default:
+		return fmt.Sprintf("Would set the assignment note of work item %s to %q", displayID, flags.Message)
+	}
+}
@@ -0,0 +1,50 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// resolvePairAssignees resolves the user of every id=user pair before any work item is written,
+// so an unknown or ambiguous user aborts the whole batch with nothing changed. Every identifier
+// is tried and all failures are reported together.
+func resolvePairAssignees(workItems, identifiers, workItemPaths []string, flags AssignFlags, users []UserInfo, cfg *config.Config) ([]*UserInfo, error) {
+	assignees := make([]*UserInfo, len(workItemPaths))
+	var failures []string
+	for i, path := range workItemPaths {
+		var err error
+		if identifiers[i] == authorIdentifier {
+			assignees[i], err = resolveAuthorIdentifier(path, users, flags.KnownOnly, cfg)
+		} else {
+			assignees[i], err = resolveUserIdentifier(identifiers[i], users)
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("work item %s: %v", workItems[i], err))
+		}
+	}
+	switch len(failures) {
+	case 0:
+		return assignees, nil
+	case 1:
+		return nil, fmt.Errorf("%s", failures[0])
+	default:
+		return nil, fmt.Errorf("%d users could not be resolved, no work items were changed:\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+}
+
+// resolveCodeownersAssignees resolves the CODEOWNERS owners of every work item before any work
+// item is written, so a work item that cannot be read aborts the batch with nothing changed.
+func resolveCodeownersAssignees(workItemPaths []string, rules []codeownersRule, location string, flags AssignFlags, users []UserInfo, cfg *config.Config) ([][]*UserInfo, error) {
+	owners := make([][]*UserInfo, len(workItemPaths))
+	for i, path := range workItemPaths {
+		resolved, err := codeownerUsersForWorkItem(path, rules, location, flags, users, cfg)
+		if err != nil {
+			return nil, err
+		}
+		owners[i] = resolved
+	}
+	return owners, nil
+}
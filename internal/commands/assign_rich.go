@@ -0,0 +1,64 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"kira/internal/config"
+)
+
+// richAssigneeEmailKey is the key of an assignee object that holds the user's email.
+const richAssigneeEmailKey = "email"
+
+// richAssigneeEnabled reports whether kira assign writes assignees as objects
+// (assignment.rich_assignee).
+func richAssigneeEnabled(cfg *config.Config) bool {
+	return cfg != nil && cfg.Assignment != nil && cfg.Assignment.RichAssignee
+}
+
+// assigneeEntry returns the value kira assign writes for userEmail: the email itself, or with
+// assignment.rich_assignee an object recording who was assigned and since when, e.g.
+// {email: alice@example.com, since: 2024-06-30T09:00:00Z}.
+func assigneeEntry(userEmail string, cfg *config.Config) interface{} {
+	if !richAssigneeEnabled(cfg) {
+		return userEmail
+	}
+	return map[string]interface{}{
+		richAssigneeEmailKey: userEmail,
+		"since":              time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// setAssigneeValue replaces the field with userEmail (switch mode), written as assigneeEntry.
+func setAssigneeValue(frontMatter map[string]interface{}, fieldName, userEmail string, cfg *config.Config) {
+	if richAssigneeEnabled(cfg) {
+		frontMatter[fieldName] = assigneeEntry(userEmail, cfg)
+		return
+	}
+	updateFieldValue(frontMatter, fieldName, userEmail)
+}
+
+// assigneeValueString returns the user an assignee value names: the email key of an assignee
+// object, or the value itself for plain values.
+func assigneeValueString(value interface{}) string {
+	if object, ok := value.(map[string]interface{}); ok {
+		if email, ok := object[richAssigneeEmailKey]; ok {
+			return fmt.Sprintf("%v", email)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// assigneeList returns the list an appended field is written as: []string when every item is a
+// plain value, and []interface{} when assignee objects have to be kept.
+func assigneeList(items []interface{}) interface{} {
+	strValues := make([]string, 0, len(items))
+	for _, item := range items {
+		if _, ok := item.(map[string]interface{}); ok {
+			return items
+		}
+		strValues = append(strValues, fmt.Sprintf("%v", item))
+	}
+	return strValues
+}
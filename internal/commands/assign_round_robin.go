@@ -0,0 +1,152 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"kira/internal/config"
+)
+
+// validateRoundRobinFlags rejects the flags --round-robin cannot be combined with, since they
+// choose the users or read the work items in their own way.
+func validateRoundRobinFlags(flags AssignFlags) error {
+	if flags.RoundRobin == "" {
+		return nil
+	}
+	if flags.Unassign || flags.Interactive || flags.FromCodeowners || flags.FileList != "" || flags.MaxBatch > 0 || flags.Resume {
+		return fmt.Errorf("invalid flag combination: --round-robin cannot be used with --unassign, --interactive, --set-from-codeowners, --file-list, --max-batch or --resume")
+	}
+	return nil
+}
+
+// runAssignRoundRobin assigns the work items to the members of a team in turn (--round-robin),
+// starting with the member at the team's cursor. The mapping is reported before the work items
+// are updated, and afterwards the cursor is saved to kira.yml advanced by the number of work
+// items that were assigned, so the next run continues the rotation and a failed work item does
+// not use up a member's turn. --dry-run shows the planned distribution and leaves the cursor
+// alone.
+func runAssignRoundRobin(workItems []string, flags AssignFlags, cfg *config.Config) error {
+	if hasAssignPairs(workItems) {
+		return fmt.Errorf("invalid flag combination: --round-robin cannot be used with id=user pairs")
+	}
+	team, err := roundRobinTeam(flags.RoundRobin, cfg)
+	if err != nil {
+		return err
+	}
+	if err := validateAssignMoveTarget(flags, cfg); err != nil {
+		return err
+	}
+	if err := validateWorkItemTokens(workItems, flags.Field, cfg); err != nil {
+		return err
+	}
+	workItemPaths, err := resolveWorkItems(workItems, cfg)
+	if err != nil {
+		return err
+	}
+	if err := checkDuplicateAssignPaths(workItemPaths, cfg); err != nil {
+		return err
+	}
+	users, err := collectAssignUsers(flags, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to collect users: %w", err)
+	}
+	members, err := resolveTeamMembers(flags.RoundRobin, team, users)
+	if err != nil {
+		return err
+	}
+
+	assignees := roundRobinAssignees(len(workItemPaths), members, team.Cursor)
+	if !flags.JSON && !flags.SummaryOnly {
+		displayRoundRobinPlan(os.Stdout, flags.RoundRobin, workItemPaths, assignees, flags, cfg)
+	}
+	var results []WorkItemUpdateResult
+	assigned := 0
+	for i, path := range workItemPaths {
+		itemResults := processWorkItemUpdates([]string{path}, assignees[i], flags, users, cfg)
+		if assignResultsError(itemResults) == nil {
+			assigned++
+		}
+		results = append(results, itemResults...)
+	}
+	if !flags.DryRun {
+		next := (team.Cursor%len(members) + assigned) % len(members)
+		if err := saveTeamCursor(cfg, flags.RoundRobin, next); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to save the rotation of team %s: %v\n", flags.RoundRobin, err)
+		}
+	}
+	return handleAssignResults(results, workItemPaths, flags, singleAssignee(assignees))
+}
+
+// roundRobinTeam returns the team of assignment.teams with the given name.
+func roundRobinTeam(name string, cfg *config.Config) (*config.TeamConfig, error) {
+	var teams map[string]*config.TeamConfig
+	if cfg.Assignment != nil {
+		teams = cfg.Assignment.Teams
+	}
+	if team, ok := teams[name]; ok && team != nil {
+		return team, nil
+	}
+	names := make([]string, 0, len(teams))
+	for teamName := range teams {
+		names = append(names, teamName)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("team '%s' not found: no teams are configured in assignment.teams", name)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("team '%s' not found in assignment.teams (available: %s)", name, strings.Join(names, ", "))
+}
+
+// resolveTeamMembers resolves the user identifiers of a team's members.
+func resolveTeamMembers(name string, team *config.TeamConfig, users []UserInfo) ([]*UserInfo, error) {
+	members := make([]*UserInfo, 0, len(team.Members))
+	for _, identifier := range team.Members {
+		member, err := resolveUserIdentifier(strings.TrimSpace(identifier), users)
+		if err != nil {
+			return nil, fmt.Errorf("team %s: %w", name, err)
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// roundRobinAssignees returns the member for each of count work items, starting with the member
+// at cursor and wrapping around. A cursor past the end of the list (e.g. after a member was
+// removed) wraps around too.
+func roundRobinAssignees(count int, members []*UserInfo, cursor int) []*UserInfo {
+	assignees := make([]*UserInfo, count)
+	for i := range assignees {
+		assignees[i] = members[(cursor+i)%len(members)]
+	}
+	return assignees
+}
+
+// displayRoundRobinPlan writes which member each work item goes to.
+func displayRoundRobinPlan(out io.Writer, team string, workItemPaths []string, assignees []*UserInfo, flags AssignFlags, cfg *config.Config) {
+	verb := "Assigning"
+	if flags.DryRun {
+		verb = "[DRY RUN] Would assign"
+	}
+	_, _ = fmt.Fprintf(out, "%s round-robin for team %s:\n", verb, team)
+	for i, path := range workItemPaths {
+		_, _ = fmt.Fprintf(out, "  %s -> %s\n", getWorkItemDisplayID(path, cfg), formatUserAs(*assignees[i], flags.AssigneeDisplay))
+	}
+}
+
+// saveTeamCursor writes the cursor of team (assignment.teams.<team>.cursor) to kira.yml.
+func saveTeamCursor(cfg *config.Config, team string, cursor int) error {
+	dir := cfg.ConfigDir
+	if dir == "" {
+		dir = "."
+	}
+	segments := []string{"assignment", "teams", team, "cursor"}
+	value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(cursor)}
+	return writeConfigValue(config.FilePath(dir), strings.Join(segments, "."), segments, value)
+}
@@ -0,0 +1,54 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"kira/internal/config"
+)
+
+// isOpenStatus reports whether work in status is still open, i.e. status is not a terminal
+// status (terminal_statuses in kira.yml, default done, released and abandoned).
+func isOpenStatus(status string, cfg *config.Config) bool {
+	return !config.IsTerminalStatus(cfg, status)
+}
+
+// checkAssignTargetStatus warns on out when the work item at path is in a terminal status, since
+// assigning finished work is usually a mistake; with --strict it returns an error instead and the
+// work item is left alone. Every assign mode processes its work items through this check.
+// Unassigning is not checked, nor is --move to an open status, which reopens the work item.
+func checkAssignTargetStatus(out io.Writer, path string, flags AssignFlags, cfg *config.Config) error {
+	if flags.Unassign || (flags.MoveTo != "" && isOpenStatus(flags.MoveTo, cfg)) {
+		return nil
+	}
+	frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		return nil // reported when the work item is processed
+	}
+	status, _ := frontMatter["status"].(string)
+	if status == "" || isOpenStatus(status, cfg) {
+		return nil
+	}
+	id := getWorkItemDisplayID(path, cfg)
+	if flags.Strict {
+		return fmt.Errorf("work item %s is in terminal status %s (--strict); to reopen it, run 'kira move %s <status>' first or pass --move <status>", id, status, id)
+	}
+	_, _ = fmt.Fprintf(out, "Warning: work item %s is in terminal status %s; assigning it anyway. To reopen it, run 'kira move %s <status>' first or pass --move <status>\n", id, status, id)
+	return nil
+}
+
+// terminalStatusResult is the failed result of a work item refused by checkAssignTargetStatus.
+func terminalStatusResult(path string, err error, flags AssignFlags, showProgress bool, cfg *config.Config) WorkItemUpdateResult {
+	result := WorkItemUpdateResult{
+		WorkItemPath: path,
+		WorkItemID:   getWorkItemDisplayID(path, cfg),
+		Operation:    "assign",
+		Field:        resolveAssignField(path, flags, cfg),
+		Error:        err,
+	}
+	if showProgress {
+		displayWorkItemProgress(result)
+	}
+	return result
+}
@@ -0,0 +1,227 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"kira/internal/config"
+)
+
+// validateSwapFlags rejects the flags --swap cannot be combined with: it finds the work items
+// itself, by scanning them (optionally limited to --status), and only replaces one user.
+func validateSwapFlags(flags AssignFlags) error {
+	for _, conflict := range []struct {
+		name string
+		set  bool
+	}{
+		{"--unassign", flags.Unassign},
+		{"--append", flags.Append},
+		{"--interactive", flags.Interactive},
+		{"--pick", flags.Pick},
+		{"--if-assignee", flags.IfAssignee != ""},
+		{"--round-robin", flags.RoundRobin != ""},
+		{"--set-from-codeowners", flags.FromCodeowners},
+		{"--file-list", flags.FileList != ""},
+		{"--max-batch", flags.MaxBatch > 0},
+		{"--resume", flags.Resume},
+		{"--move", flags.MoveTo != ""},
+		{"--due", flags.DueSet},
+		{"--message", flags.MessageSet},
+	} {
+		if conflict.set {
+			return fmt.Errorf("invalid flag combination: --swap cannot be used with %s", conflict.name)
+		}
+	}
+	if fields := splitAssignFields(flags.Field); len(fields) != 1 {
+		return fmt.Errorf("--swap works on a single --field, got %s", flags.Field)
+	}
+	return nil
+}
+
+// runAssignSwap replaces the user from with the user to in the field of every work item that
+// names from (kira assign --swap <from> <to>), optionally only in the work items of --status.
+// Work items in a terminal status (e.g. done) keep who did the work unless --status names that
+// status. Both users are resolved before any work item is scanned. --dry-run lists the work
+// items that would change.
+func runAssignSwap(args []string, flags AssignFlags, cfg *config.Config) error {
+	if err := validateSwapFlags(flags); err != nil {
+		return err
+	}
+	if err := validateListStatus(flags.PickStatus, cfg); err != nil {
+		return err
+	}
+	from, to, err := resolveSwapUsers(args[0], args[1], flags.KnownOnly, cfg)
+	if err != nil {
+		return err
+	}
+
+	items, err := collectListedWorkItems(cfg, flags.PickStatus, false)
+	if err != nil {
+		return err
+	}
+	var results []WorkItemUpdateResult
+	for _, item := range items {
+		if flags.PickStatus == "" && config.IsTerminalStatus(cfg, item.Status) {
+			continue
+		}
+		changed, err := swapAssigneeInWorkItem(item.Path, flags.Field, from, to, !flags.DryRun, cfg)
+		if err != nil || changed {
+			results = append(results, swapResult(item, flags, from, to, err))
+		}
+	}
+
+	if flags.Changelog != "" && !flags.DryRun {
+		if err := appendAssignChangelog(flags.Changelog, results, time.Now()); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to update changelog %s: %v\n", flags.Changelog, err)
+		}
+	}
+	if flags.JSON {
+		if err := writeAssignResultsJSON(os.Stdout, results); err != nil {
+			return err
+		}
+	} else {
+		displaySwapSummary(results, flags, from, to)
+	}
+	return assignResultsError(results)
+}
+
+// resolveSwapUsers resolves the users of kira assign --swap. The value written for to has
+// assignment.value_transforms applied, and with knownOnly to must be in users.saved_users;
+// swapping a user for themselves is an error.
+func resolveSwapUsers(fromIdentifier, toIdentifier string, knownOnly bool, cfg *config.Config) (*UserInfo, *UserInfo, error) {
+	users, err := collectUsersForAssignment(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to collect users: %w", err)
+	}
+	from, err := resolveUserIdentifier(fromIdentifier, users)
+	if err != nil {
+		return nil, nil, fmt.Errorf("--swap: %w", err)
+	}
+	if knownOnly {
+		users = savedUsersOnly(users, cfg)
+	}
+	to, err := resolveUserIdentifier(toIdentifier, users)
+	if err != nil {
+		return nil, nil, fmt.Errorf("--swap: %w", err)
+	}
+	if to, err = transformAssignee(to, cfg); err != nil {
+		return nil, nil, fmt.Errorf("--swap: %w", err)
+	}
+	if from.Email == to.Email {
+		return nil, nil, fmt.Errorf("--swap: '%s' and '%s' are the same user (%s)", fromIdentifier, toIdentifier, from.Email)
+	}
+	return from, to, nil
+}
+
+// swapResult is the result of swapping the assignee of a listed work item, printing its
+// progress line unless only the summary or JSON is shown.
+func swapResult(item listedWorkItem, flags AssignFlags, from, to *UserInfo, err error) WorkItemUpdateResult {
+	path := item.Path
+	if absPath, absErr := filepath.Abs(item.Path); absErr == nil {
+		path = absPath // as reported by every other assign mode
+	}
+	result := WorkItemUpdateResult{
+		WorkItemPath: path,
+		WorkItemID:   item.ID,
+		Success:      err == nil,
+		Operation:    "swap",
+		Field:        flags.Field,
+		User:         to.Email,
+	}
+	if err != nil {
+		result.Error = fmt.Errorf("failed to update work item %s: %w", item.ID, err)
+	} else if flags.DryRun {
+		result.Would = &AssignIntent{Operation: "swap", Field: flags.Field, User: to.Email}
+	}
+	switch {
+	case flags.SummaryOnly || flags.JSON:
+	case flags.DryRun && err == nil:
+		fmt.Printf("Would swap %s -> %s in work item %s (field: %s)\n",
+			formatUserAs(*from, flags.AssigneeDisplay), formatUserAs(*to, flags.AssigneeDisplay), item.ID, flags.Field)
+	default:
+		displayWorkItemProgress(result)
+	}
+	return result
+}
+
+// displaySwapSummary prints how many work items had from swapped for to, e.g.
+// "Swapped alice@example.com -> bob@example.com in 3 work item(s)".
+func displaySwapSummary(results []WorkItemUpdateResult, flags AssignFlags, from, to *UserInfo) {
+	succeeded, failed := countAssignResults(results)
+	verb := "Swapped"
+	if flags.DryRun {
+		verb = "Would swap"
+	}
+	summary := fmt.Sprintf("%s %s -> %s in %d work item(s)", verb,
+		formatUserAs(*from, flags.AssigneeDisplay), formatUserAs(*to, flags.AssigneeDisplay), succeeded)
+	if failed > 0 {
+		summary += fmt.Sprintf(", %d failed", failed)
+	}
+	fmt.Println(summary)
+}
+
+// swapAssigneeInWorkItem swaps from for to in the field of a work item and, when write is set
+// and the field changed, writes it back with a new updated timestamp. Reports whether the field
+// named from.
+func swapAssigneeInWorkItem(workItemPath, field string, from, to *UserInfo, write bool, cfg *config.Config) (bool, error) {
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse work item: %w", err)
+	}
+	changed := swapAssignee(frontMatter, field, from, to, cfg)
+	if !changed || !write {
+		return changed, nil
+	}
+	updateTimestamp(frontMatter)
+	if err := writeWorkItemFrontMatter(workItemPath, frontMatter, bodyLines); err != nil {
+		return false, fmt.Errorf("failed to write work item: %w", err)
+	}
+	return true, nil
+}
+
+// swapAssignee replaces from with to in the field: a single assignee naming from becomes to, and
+// in a list the entries naming from are replaced by to, which is kept only once. Reports whether
+// anything changed.
+func swapAssignee(frontMatter map[string]interface{}, field string, from, to *UserInfo, cfg *config.Config) bool {
+	var items []interface{}
+	switch current := frontMatter[field].(type) {
+	case []string:
+		for _, item := range current {
+			items = append(items, item)
+		}
+	case []interface{}:
+		items = current
+	default:
+		value, exists := frontMatter[field]
+		if !exists || !isCurrentAssignee(assigneeValueString(value), from) {
+			return false
+		}
+		setAssigneeValue(frontMatter, field, to.Email, cfg)
+		return true
+	}
+
+	swapped := make([]interface{}, 0, len(items))
+	changed, hasTo := false, false
+	for _, item := range items {
+		name := assigneeValueString(item)
+		switch {
+		case isCurrentAssignee(name, from):
+			changed = true
+			item = assigneeEntry(to.Email, cfg)
+		case !isCurrentAssignee(name, to):
+			swapped = append(swapped, item)
+			continue
+		}
+		if !hasTo {
+			swapped = append(swapped, item)
+			hasTo = true
+		}
+	}
+	if changed {
+		frontMatter[field] = assigneeList(swapped)
+	}
+	return changed
+}
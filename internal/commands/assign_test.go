@@ -2,6 +2,7 @@ package commands
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -172,6 +173,16 @@ func TestValidateAssignInputFlagsAndUserIdentifier(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid flag combination")
 	})
 
+	t.Run("disallows prune-empty without unassign", func(t *testing.T) {
+		flags := AssignFlags{
+			Field:      "metadata.owner",
+			PruneEmpty: true,
+		}
+		err := validateAssignInput([]string{"001"}, "5", flags, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--prune-empty can only be used with --unassign")
+	})
+
 	t.Run("disallows unassign with interactive in this phase", func(t *testing.T) {
 		flags := AssignFlags{
 			Field:       "assigned",
@@ -1080,6 +1091,21 @@ invalid: [unclosed bracket
 		assert.Contains(t, err.Error(), "failed to parse front matter")
 	})
 
+	t.Run("names the line of tab-indented front matter", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		content := "---\nid: \"001\"\ntitle: Test Feature\nassigned:\n\t- dev@example.com\n---\n# Body\n"
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		_, _, err := parseWorkItemFrontMatter(testFilePath, testCfgWithDir(tmpDir))
+		require.Error(t, err)
+		assert.Equal(t, "failed to parse front matter: line 5: YAML uses tabs for indentation; use spaces", err.Error())
+	})
+
 	t.Run("returns error for file not found", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		origDir, _ := os.Getwd()
@@ -1585,6 +1611,56 @@ func TestWriteWorkItemFrontMatter(t *testing.T) {
 		assert.Contains(t, contentStr, "# Test")
 	})
 
+	t.Run("ends the file with exactly one newline", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		frontMatter := map[string]interface{}{"id": "001", "title": "Test"}
+
+		for _, bodyLines := range [][]string{
+			{"# Test"},
+			{"# Test", ""},
+			{"# Test", "", ""},
+			{"# Test", "", "", ""},
+		} {
+			require.NoError(t, writeWorkItemFrontMatter(testFilePath, frontMatter, bodyLines))
+			content, err := os.ReadFile(testFilePath)
+			require.NoError(t, err)
+			assert.True(t, strings.HasSuffix(string(content), "# Test\n"), "body %q: got %q", bodyLines, content)
+		}
+
+		require.NoError(t, writeWorkItemFrontMatter(testFilePath, frontMatter, []string{"", ""}))
+		content, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(string(content), "---\n"), "empty body: got %q", content)
+		assert.False(t, strings.HasSuffix(string(content), "\n\n"), "empty body: got %q", content)
+	})
+
+	t.Run("assign round trip keeps a single trailing newline", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		content := "---\nid: \"001\"\ntitle: Test\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n---\n# Test\n\nBody.\n\n\n"
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		_, err = updateWorkItemField(testFilePath, "assigned", "other@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+
+		written, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(string(written), "\n---\n# Test\n\nBody.\n"), "got %q", written)
+		assert.False(t, strings.HasSuffix(string(written), "\n\n"), "got %q", written)
+	})
+
 	t.Run("preserves field order", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		origDir, _ := os.Getwd()
@@ -1633,6 +1709,41 @@ func TestWriteWorkItemFrontMatter(t *testing.T) {
 		assert.True(t, alphaPos < assignedPos)
 		assert.True(t, assignedPos < zebraPos)
 	})
+	t.Run("writes nil values as empty values and round-trips them unchanged", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+		cfg := testCfgWithDir(tmpDir)
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		original := "---\nid: 001\ntitle: Test Feature\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n" +
+			"metadata:\n  owner:\nreviewer:\n---\n# Test Feature\n"
+		require.NoError(t, os.WriteFile(testFilePath, []byte(original), 0o600))
+
+		frontMatter, bodyLines, err := parseWorkItemFrontMatter(testFilePath, cfg)
+		require.NoError(t, err)
+		value, exists := getFieldValue(frontMatter, "reviewer")
+		require.True(t, exists)
+		assert.Nil(t, value)
+
+		require.NoError(t, writeWorkItemFrontMatter(testFilePath, frontMatter, bodyLines))
+		first := mustReadFile(t, testFilePath)
+		assert.Contains(t, first, "\nreviewer:\n")
+		assert.Contains(t, first, "\nmetadata:\n    owner:\n")
+		assert.NotContains(t, first, "null")
+
+		frontMatter, bodyLines, err = parseWorkItemFrontMatter(testFilePath, cfg)
+		require.NoError(t, err)
+		require.NoError(t, writeWorkItemFrontMatter(testFilePath, frontMatter, bodyLines))
+		assert.Equal(t, first, mustReadFile(t, testFilePath))
+	})
+
+	t.Run("keeps a null string quoted", func(t *testing.T) {
+		var sb strings.Builder
+		require.NoError(t, writeYAMLFieldValue(&sb, "metadata", map[string]interface{}{"note": "null", "owner": nil}))
+		assert.Equal(t, "metadata:\n    note: \"null\"\n    owner:\n", sb.String())
+	})
 }
 
 func TestUpdateFieldValue(t *testing.T) {
@@ -1754,6 +1865,87 @@ func TestUpdateTimestamp(t *testing.T) {
 	})
 }
 
+func TestUpdateWorkItemFieldNoChange(t *testing.T) {
+	setup := func(t *testing.T, content string) (*config.Config, time.Time) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(content), 0o600))
+		past := time.Now().Add(-time.Hour).Truncate(time.Second)
+		require.NoError(t, os.Chtimes(testFilePathPhase5, past, past))
+		return testCfgWithDir(tmpDir), past
+	}
+	assertUntouched := func(t *testing.T, content string, mtime time.Time) {
+		t.Helper()
+		assert.Equal(t, content, mustReadFile(t, testFilePathPhase5))
+		info, err := os.Stat(testFilePathPhase5)
+		require.NoError(t, err)
+		assert.True(t, info.ModTime().Equal(mtime), "mtime changed to %v", info.ModTime())
+	}
+
+	t.Run("setting a field to its current value writes nothing", func(t *testing.T) {
+		cfg, mtime := setup(t, testWorkItemContentWithAssigned)
+
+		changed, err := updateWorkItemField(testFilePathPhase5, "assigned", "user@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assertUntouched(t, testWorkItemContentWithAssigned, mtime)
+	})
+
+	t.Run("emails are compared ignoring case", func(t *testing.T) {
+		content := strings.Replace(testWorkItemContentWithAssigned, "assigned: user@example.com", "assigned: User@Example.com", 1)
+		cfg, mtime := setup(t, content)
+
+		changed, err := updateWorkItemField(testFilePathPhase5, "assigned", "user@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		changed, err = updateWorkItemFieldAppend(testFilePathPhase5, "assigned", "user@example.com", false, AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assertUntouched(t, content, mtime)
+
+		current, err := getCurrentAssignment(testFilePathPhase5, "assigned", cfg)
+		require.NoError(t, err)
+		assert.True(t, isCurrentAssignee(current, &UserInfo{Email: "user@example.com"}))
+		assert.Empty(t, assigneesRemovedFromFrontMatter(map[string]interface{}{"assigned": []interface{}{"User@Example.com"}}, "assigned", "user@example.com"))
+	})
+
+	t.Run("setting a different value reports a change", func(t *testing.T) {
+		cfg, _ := setup(t, testWorkItemContentWithAssigned)
+
+		changed, err := updateWorkItemField(testFilePathPhase5, "assigned", "other@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Contains(t, mustReadFile(t, testFilePathPhase5), "updated:")
+	})
+
+	t.Run("appending a listed user writes nothing", func(t *testing.T) {
+		content := strings.Replace(testWorkItemContentWithAssigned, "assigned: user@example.com", "assigned:\n  - alice@example.com\n  - user@example.com", 1)
+		cfg, mtime := setup(t, content)
+
+		changed, err := updateWorkItemFieldAppend(testFilePathPhase5, "assigned", "user@example.com", false, AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assertUntouched(t, content, mtime)
+	})
+
+	t.Run("reports already assigned instead of an update", func(t *testing.T) {
+		cfg, mtime := setup(t, testWorkItemContentWithAssigned)
+		absPath, err := filepath.Abs(testFilePathPhase5)
+		require.NoError(t, err)
+		user := &UserInfo{Email: "user@example.com"}
+
+		result := processAppendWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, cfg)
+		require.True(t, result.Success)
+		assert.Equal(t, opAlreadyAssigned, result.Operation)
+		assertUntouched(t, testWorkItemContentWithAssigned, mtime)
+	})
+}
+
 func TestUpdateWorkItemField(t *testing.T) {
 	testFilePath := testFilePathPhase5
 
@@ -1779,7 +1971,7 @@ Body content.
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "new@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "new@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify file was updated
@@ -1803,7 +1995,7 @@ Body content.
 
 		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify field was created
@@ -1834,7 +2026,7 @@ updated: 2024-01-01T00:00:00Z
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify timestamp was updated
@@ -1879,7 +2071,7 @@ updated: 2024-01-01T00:00:00Z
 
 		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify updated timestamp was created
@@ -1926,7 +2118,7 @@ tags: [frontend, backend]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify other fields are preserved
@@ -1964,7 +2156,7 @@ More content here.
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify body is preserved
@@ -1988,7 +2180,7 @@ More content here.
 
 		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
 
-		err := updateWorkItemField(testFilePath, "reviewer", "reviewer@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "reviewer", "reviewer@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify custom field was set
@@ -2007,7 +2199,7 @@ More content here.
 
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read work item file")
 	})
@@ -2023,7 +2215,7 @@ More content here.
 		content := testWorkItemContentMalformedYAML
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemField(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemField(testFilePath, "assigned", "user@example.com", AssignFlags{}, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to parse front matter")
 	})
@@ -2298,6 +2490,69 @@ func TestClearField(t *testing.T) {
 	})
 }
 
+func TestClearNestedField(t *testing.T) {
+	t.Run("clears last nested key and keeps empty parent without prune", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"metadata": map[string]interface{}{"owner": "user@example.com"},
+		}
+
+		existed := clearNestedField(frontMatter, "metadata.owner", false)
+
+		assert.True(t, existed)
+		parent, exists := frontMatter["metadata"]
+		require.True(t, exists, "parent map should be kept without prune")
+		assert.Empty(t, parent)
+	})
+
+	t.Run("clears last nested key and removes empty parent with prune", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"metadata": map[string]interface{}{"owner": "user@example.com"},
+			"assigned": "user@example.com",
+		}
+
+		existed := clearNestedField(frontMatter, "metadata.owner", true)
+
+		assert.True(t, existed)
+		_, exists := frontMatter["metadata"]
+		assert.False(t, exists, "empty parent map should be pruned")
+		_, exists = frontMatter["assigned"]
+		assert.True(t, exists)
+	})
+
+	t.Run("keeps non-empty parent with prune", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"metadata": map[string]interface{}{"owner": "user@example.com", "team": "core"},
+		}
+
+		existed := clearNestedField(frontMatter, "metadata.owner", true)
+
+		assert.True(t, existed)
+		assert.Equal(t, map[string]interface{}{"team": "core"}, frontMatter["metadata"])
+	})
+
+	t.Run("top-level field is unaffected by prune", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"assigned": "user@example.com",
+			"reviewer": "reviewer@example.com",
+		}
+
+		existed := clearNestedField(frontMatter, "assigned", true)
+
+		assert.True(t, existed)
+		assert.Equal(t, map[string]interface{}{"reviewer": "reviewer@example.com"}, frontMatter)
+	})
+
+	t.Run("returns false for missing nested key", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"metadata": map[string]interface{}{"team": "core"},
+		}
+
+		assert.False(t, clearNestedField(frontMatter, "metadata.owner", true))
+		assert.False(t, clearNestedField(frontMatter, "other.owner", true))
+		assert.Len(t, frontMatter["metadata"], 1)
+	})
+}
+
 func TestUpdateWorkItemFieldAppend(t *testing.T) {
 	testFilePath := testFilePathPhase5
 
@@ -2310,7 +2565,7 @@ func TestUpdateWorkItemFieldAppend(t *testing.T) {
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
 		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify field was created
@@ -2341,7 +2596,7 @@ assigned: ""
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify field was set (not array)
@@ -2373,7 +2628,7 @@ assigned: alice@example.com
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "bob@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "bob@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify field was converted to array
@@ -2407,7 +2662,7 @@ assigned: [alice@example.com, bob@example.com]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "charlie@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "charlie@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify new user was appended
@@ -2441,7 +2696,7 @@ assigned: [alice@example.com, bob@example.com]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "alice@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "alice@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify duplicate was not added
@@ -2475,7 +2730,7 @@ updated: 2024-01-01T00:00:00Z
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify timestamp was updated
@@ -2531,7 +2786,7 @@ tags: [frontend, backend]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify other fields are preserved
@@ -2569,7 +2824,7 @@ More content here.
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify body is preserved
@@ -2603,7 +2858,7 @@ reviewer: alice@example.com
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "reviewer", "bob@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "reviewer", "bob@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify custom field was updated
@@ -2637,11 +2892,11 @@ assigned: alice@example.com
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
 		// First append
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "bob@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "bob@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Second append
-		err = updateWorkItemFieldAppend(testFilePath, "assigned", "charlie@example.com", testCfgWithDir(tmpDir))
+		_, err = updateWorkItemFieldAppend(testFilePath, "assigned", "charlie@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify all users are in array
@@ -2663,7 +2918,7 @@ assigned: alice@example.com
 
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read work item file")
 	})
@@ -2679,10 +2934,156 @@ assigned: alice@example.com
 		content := testWorkItemContentMalformedYAML
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", testCfgWithDir(tmpDir))
+		_, err := updateWorkItemFieldAppend(testFilePath, "assigned", "user@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to parse front matter")
 	})
+
+	t.Run("refuses to append to numeric and boolean fields unless forced", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+
+		content := `---
+id: "001"
+title: Test Feature
+status: todo
+kind: prd
+created: 2024-01-01
+estimate: 5
+blocked: true
+ticket: "1234"
+---
+# Test Feature
+`
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+		cfg := testCfgWithDir(tmpDir)
+
+		_, err := updateWorkItemFieldAppend(testFilePath, "estimate", "user@example.com", false, AssignFlags{}, cfg)
+		require.EqualError(t, err, "refusing to append user@example.com to field 'estimate': its current value 5 is a number, not a user (use --force-type to append anyway)")
+		_, err = updateWorkItemFieldAppend(testFilePath, "blocked", "user@example.com", false, AssignFlags{}, cfg)
+		require.EqualError(t, err, "refusing to append user@example.com to field 'blocked': its current value true is a boolean, not a user (use --force-type to append anyway)")
+		_, err = updateWorkItemFieldAppend(testFilePath, "ticket", "user@example.com", false, AssignFlags{}, cfg)
+		require.EqualError(t, err, "refusing to append user@example.com to field 'ticket': its current value 1234 is a string, not a user (use --force-type to append anyway)")
+		assert.Equal(t, content, mustReadFile(t, testFilePath), "a refused append must not touch the file")
+
+		changed, err := updateWorkItemFieldAppend(testFilePath, "estimate", "user@example.com", true, AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		frontMatter, _, err := parseWorkItemFrontMatter(testFilePath, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{5, "user@example.com"}, frontMatter["estimate"])
+	})
+
+	t.Run("keeps appending to empty, name and email fields", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"empty":    "",
+			"name":     "Alice",
+			"email":    "alice@example.com",
+			"list":     []interface{}{"alice@example.com"},
+			"nullable": nil,
+		}
+		for field := range frontMatter {
+			assert.NoError(t, checkAppendTarget(frontMatter, field, "bob@example.com", false), field)
+		}
+		assert.NoError(t, checkAppendTarget(frontMatter, "missing", "bob@example.com", false))
+	})
+
+	t.Run("keeps block-style arrays in block style", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		content := `---
+id: "001"
+title: Test Feature
+status: todo
+kind: prd
+created: 2024-01-01
+reviewers:
+  - alice@example.com
+  - bob@example.com
+tags:
+- api
+watchers: [carol@example.com]
+---
+# Test Feature
+`
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		changed, err := updateWorkItemFieldAppend(testFilePath, "reviewers", "dave@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
+		require.NoError(t, err)
+		assert.True(t, changed)
+
+		updatedStr := mustReadFile(t, testFilePath)
+		assert.Contains(t, updatedStr, "reviewers:\n  - alice@example.com\n  - bob@example.com\n  - dave@example.com\n")
+		assert.Contains(t, updatedStr, "tags:\n- api\n")
+		assert.Contains(t, updatedStr, "watchers: [carol@example.com]\n")
+
+		_, err = updateWorkItemFieldAppend(testFilePath, "watchers", "dave@example.com", false, AssignFlags{}, testCfgWithDir(tmpDir))
+		require.NoError(t, err)
+		assert.Contains(t, mustReadFile(t, testFilePath), "watchers: [carol@example.com, dave@example.com]\n")
+	})
+
+	t.Run("keeps block-style arrays in block style with --move", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		content := "---\nid: \"001\"\ntitle: Test Feature\nstatus: todo\nkind: prd\nreviewers:\n  - alice@example.com\n---\n# Test Feature\n"
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		user := &UserInfo{Email: "dave@example.com", Name: "Dave"}
+		flags := AssignFlags{Field: "reviewers", Append: true, MoveTo: "doing"}
+		_, err := assignAndMoveWorkItem(testFilePath, "001", []*UserInfo{user}, flags, testCfgWithDir(tmpDir))
+		require.NoError(t, err)
+
+		moved := mustReadFile(t, filepath.Join(".work/2_doing", filepath.Base(testFilePath)))
+		assert.Contains(t, moved, "reviewers:\n  - alice@example.com\n  - dave@example.com\n")
+	})
+	t.Run("appends several users in one write", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
+		users := []*UserInfo{{Email: "alice@example.com"}, {Email: "bob@example.com"}}
+
+		result := processAppendUsersWorkItem(testFilePath, "001", "assigned", users, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+		require.True(t, result.Success, "%v", result.Error)
+		assert.Equal(t, "append", result.Operation)
+		assert.Equal(t, "alice@example.com, bob@example.com", result.User)
+		assert.Contains(t, mustReadFile(t, testFilePath), "assigned: [alice@example.com, bob@example.com]\n")
+
+		result = processAppendUsersWorkItem(testFilePath, "001", "assigned", users, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+		require.True(t, result.Success, "%v", result.Error)
+		assert.Equal(t, opAlreadyAssigned, result.Operation)
+	})
+
+	t.Run("appends none of several users when one is refused", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		content := strings.Replace(testWorkItemContentPhase5, "created: 2024-01-01\n", "created: 2024-01-01\nestimate: 5\n", 1)
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		_, err := updateWorkItemFieldAppendUsers(testFilePath, "estimate", []string{"alice@example.com", "bob@example.com"}, false, AssignFlags{}, testCfgWithDir(tmpDir))
+		require.Error(t, err)
+		assert.Equal(t, content, mustReadFile(t, testFilePath))
+	})
 }
 
 func TestUpdateWorkItemFieldUnassign(t *testing.T) {
@@ -2699,7 +3100,7 @@ func TestUpdateWorkItemFieldUnassign(t *testing.T) {
 		content := testWorkItemContentWithAssigned
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify field was removed
@@ -2724,7 +3125,7 @@ func TestUpdateWorkItemFieldUnassign(t *testing.T) {
 		content := testWorkItemContentWithAssigned
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify timestamp was added/updated
@@ -2768,7 +3169,7 @@ func TestUpdateWorkItemFieldUnassign(t *testing.T) {
 		content := testWorkItemContentWithAssigned
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		updatedContent, err := os.ReadFile(testFilePath)
@@ -2798,7 +3199,7 @@ reviewer: reviewer@example.com
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "reviewer", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "reviewer", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		updatedContent, err := os.ReadFile(testFilePath)
@@ -2819,15 +3220,11 @@ reviewer: reviewer@example.com
 		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContentPhase5), 0o600))
 
 		// Should not error even if field doesn't exist
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
-		require.NoError(t, err)
-
-		// Timestamp should still be updated
-		updatedContent, err := os.ReadFile(testFilePath)
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
-		updatedStr := string(updatedContent)
 
-		assert.Contains(t, updatedStr, "updated:")
+		// Nothing was removed, so the file and its timestamp are left untouched
+		assert.Equal(t, testWorkItemContentPhase5, mustReadFile(t, testFilePath))
 	})
 
 	t.Run("preserves other front matter fields", func(t *testing.T) {
@@ -2853,7 +3250,7 @@ tags: [frontend, backend]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify other fields are preserved
@@ -2893,7 +3290,7 @@ More content here.
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		// Verify body is preserved
@@ -2915,7 +3312,7 @@ More content here.
 
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read work item file")
 	})
@@ -2931,7 +3328,7 @@ More content here.
 		content := testWorkItemContentMalformedYAML
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to parse front matter")
 	})
@@ -2956,7 +3353,7 @@ assigned: [user1@example.com, user2@example.com]
 `
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		updatedContent, err := os.ReadFile(testFilePath)
@@ -2977,7 +3374,7 @@ assigned: [user1@example.com, user2@example.com]
 		content := testWorkItemContentWithAssigned
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-		err := updateWorkItemFieldUnassign(testFilePath, "assigned", testCfgWithDir(tmpDir))
+		err := updateWorkItemFieldUnassign(testFilePath, "assigned", false, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
 		updatedContent, err := os.ReadFile(testFilePath)
@@ -2988,32 +3385,83 @@ assigned: [user1@example.com, user2@example.com]
 	})
 }
 
-func TestProcessWorkItemUpdatesUnassign(t *testing.T) {
-	testFilePath := testFilePathPhase5
+func TestUpdateWorkItemFieldUnassignPruneEmpty(t *testing.T) {
+	nestedContent := `---
+id: 001
+title: Test Feature
+status: todo
+kind: prd
+created: 2024-01-01
+metadata:
+  owner: user@example.com
+---
 
-	t.Run("unassign clears assigned field", func(t *testing.T) {
+# Test Feature
+`
+
+	t.Run("prunes empty parent map from file", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		origDir, _ := os.Getwd()
 		require.NoError(t, os.Chdir(tmpDir))
 		defer func() { _ = os.Chdir(origDir) }()
 
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(nestedContent), 0o600))
 
-		content := testWorkItemContentWithAssigned
-		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
-
-		flags := AssignFlags{
-			Unassign: true,
-			Field:    "assigned",
-		}
-
-		absPath, err := filepath.Abs(testFilePath)
+		err := updateWorkItemFieldUnassign(testFilePath, "metadata.owner", true, testCfgWithDir(tmpDir))
 		require.NoError(t, err)
 
-		results := processWorkItemUpdates([]string{absPath}, nil, flags, []UserInfo{}, testCfgWithDir(tmpDir))
-		require.Len(t, results, 1)
-		assert.True(t, results[0].Success)
-
+		updatedContent, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.NotContains(t, string(updatedContent), "metadata")
+		assert.NotContains(t, string(updatedContent), "owner")
+	})
+
+	t.Run("keeps empty parent map without prune", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(nestedContent), 0o600))
+
+		err := updateWorkItemFieldUnassign(testFilePath, "metadata.owner", false, testCfgWithDir(tmpDir))
+		require.NoError(t, err)
+
+		updatedContent, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(updatedContent), "metadata: {}")
+		assert.NotContains(t, string(updatedContent), "owner")
+	})
+}
+
+func TestProcessWorkItemUpdatesUnassign(t *testing.T) {
+	testFilePath := testFilePathPhase5
+
+	t.Run("unassign clears assigned field", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+
+		content := testWorkItemContentWithAssigned
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		flags := AssignFlags{
+			Unassign: true,
+			Field:    "assigned",
+		}
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		results := processWorkItemUpdates([]string{absPath}, nil, flags, []UserInfo{}, testCfgWithDir(tmpDir))
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Success)
+
 		updatedContent, err := os.ReadFile(testFilePath)
 		require.NoError(t, err)
 		updatedStr := string(updatedContent)
@@ -3177,13 +3625,10 @@ assigned: user2@example.com
 		results := processWorkItemUpdates([]string{absPath}, nil, flags, []UserInfo{}, testCfgWithDir(tmpDir))
 		require.Len(t, results, 1)
 		assert.True(t, results[0].Success)
+		assert.Empty(t, results[0].Cleared)
 
-		// Timestamp should still be updated
-		updatedContent, err := os.ReadFile(testFilePath)
-		require.NoError(t, err)
-		updatedStr := string(updatedContent)
-
-		assert.Contains(t, updatedStr, "updated:")
+		// Nothing was removed, so the file and its timestamp are left untouched
+		assert.Equal(t, testWorkItemContentPhase5, mustReadFile(t, testFilePath))
 	})
 }
 
@@ -3504,6 +3949,101 @@ created: 2024-01-01
 	})
 }
 
+func TestProcessWorkItemUpdatesFieldDefaultsByKind(t *testing.T) {
+	prdContent := `---
+id: 001
+title: Feature
+status: todo
+kind: prd
+created: 2024-01-01
+---
+# Feature
+`
+	issueContent := `---
+id: 002
+title: Bug
+status: todo
+kind: issue
+created: 2024-01-01
+---
+# Bug
+`
+	prdPath := ".work/1_todo/001-feature.prd.md"
+	issuePath := ".work/1_todo/002-bug.issue.md"
+	user := &UserInfo{Email: "user@example.com", Name: "Test User", Number: 1}
+
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(prdPath, []byte(prdContent), 0o600))
+		require.NoError(t, os.WriteFile(issuePath, []byte(issueContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		cfg.Assignment = &config.AssignmentConfig{FieldDefaults: map[string]string{"issue": "triager"}}
+		return cfg
+	}
+
+	t.Run("picks field per item by kind when --field is not given", func(t *testing.T) {
+		cfg := setup(t)
+		flags := AssignFlags{Field: "assigned"}
+
+		results := processWorkItemUpdates([]string{prdPath, issuePath}, user, flags, nil, cfg)
+
+		require.Len(t, results, 2)
+		assert.True(t, results[0].Success)
+		assert.True(t, results[1].Success)
+		assert.Equal(t, "assigned", results[0].Field)
+		assert.Equal(t, "triager", results[1].Field)
+
+		prd, err := os.ReadFile(prdPath)
+		require.NoError(t, err)
+		assert.Contains(t, string(prd), "assigned: user@example.com")
+		issue, err := os.ReadFile(issuePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(issue), "triager: user@example.com")
+		assert.NotContains(t, string(issue), "assigned:")
+	})
+
+	t.Run("explicit --field overrides kind defaults for all items", func(t *testing.T) {
+		cfg := setup(t)
+		flags := AssignFlags{Field: "reviewer", FieldSet: true}
+
+		results := processWorkItemUpdates([]string{prdPath, issuePath}, user, flags, nil, cfg)
+
+		require.Len(t, results, 2)
+		assert.Equal(t, "reviewer", results[0].Field)
+		assert.Equal(t, "reviewer", results[1].Field)
+		issue, err := os.ReadFile(issuePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(issue), "reviewer: user@example.com")
+		assert.NotContains(t, string(issue), "triager:")
+	})
+
+	t.Run("summary reports field per item", func(t *testing.T) {
+		cfg := setup(t)
+		flags := AssignFlags{Field: "assigned"}
+		results := processWorkItemUpdates([]string{prdPath, issuePath}, user, flags, nil, cfg)
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		displayBatchSummary(results)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+		assert.Contains(t, output, "Work item 001: assign successfully (field: assigned)")
+		assert.Contains(t, output, "Work item 002: assign successfully (field: triager)")
+	})
+}
+
 func TestGetWorkItemDisplayID(t *testing.T) {
 	t.Run("extracts ID from work item file", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -3655,13 +4195,33 @@ func TestDisplaySingleSuccessMessage(t *testing.T) {
 		assert.Equal(t, "Assigned reviewer for work item 002 to Alice <alice@example.com>\n", output)
 	})
 
+	t.Run("assign with an assignee display mode", func(t *testing.T) {
+		result := WorkItemUpdateResult{WorkItemID: "001", Success: true, Operation: "assign"}
+		output := captureStdout(func() {
+			displaySingleSuccessMessage(result, user, AssignFlags{Field: "assigned", AssigneeDisplay: config.AssigneeDisplayName})
+		})
+		assert.Equal(t, "Assigned work item 001 to Alice\n", output)
+
+		output = captureStdout(func() {
+			displaySingleSuccessMessage(result, user, AssignFlags{Field: "assigned", AssigneeDisplay: config.AssigneeDisplayEmail})
+		})
+		assert.Equal(t, "Assigned work item 001 to alice@example.com\n", output)
+	})
+
 	t.Run("unassign", func(t *testing.T) {
-		result := WorkItemUpdateResult{WorkItemID: "001", Success: true, Operation: "unassign"}
+		result := WorkItemUpdateResult{WorkItemID: "001", Success: true, Operation: "unassign", Cleared: []string{"assigned"}}
 		flags := AssignFlags{Field: "assigned"}
 		output := captureStdout(func() { displaySingleSuccessMessage(result, nil, flags) })
 		assert.Equal(t, "Unassigned work item 001\n", output)
 	})
 
+	t.Run("unassign of a field that is not set", func(t *testing.T) {
+		result := WorkItemUpdateResult{WorkItemID: "001", Success: true, Operation: "unassign"}
+		flags := AssignFlags{Field: "assigned"}
+		output := captureStdout(func() { displaySingleSuccessMessage(result, nil, flags) })
+		assert.Equal(t, "Work item 001 has no assigned to unassign; nothing changed\n", output)
+	})
+
 	t.Run("append", func(t *testing.T) {
 		result := WorkItemUpdateResult{WorkItemID: "002", Success: true, Operation: "append"}
 		flags := AssignFlags{Field: "reviewer"}
@@ -3786,11 +4346,11 @@ func TestProcessWorkItemUpdatesDryRunOutput(t *testing.T) {
 	})
 }
 
-func TestProcessAssignWorkItemAlreadyAssigned(t *testing.T) {
+func TestAssignDryRunJSON(t *testing.T) {
 	testFilePath := testFilePathPhase5
-	content := testWorkItemContentWithAssigned // assigned: user@example.com
+	content := testWorkItemContentWithAssigned
 
-	t.Run("when current assignment equals user skips write and returns already_assigned", func(t *testing.T) {
+	t.Run("dry-run with json prints only validation results", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		origDir, _ := os.Getwd()
 		require.NoError(t, os.Chdir(tmpDir))
@@ -3798,488 +4358,2451 @@ func TestProcessAssignWorkItemAlreadyAssigned(t *testing.T) {
 
 		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
 		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+		brokenPath := ".work/1_todo/002-broken.prd.md"
+		require.NoError(t, os.WriteFile(brokenPath, []byte("---\nid: [\n---\n"), 0o600))
 
 		absPath, err := filepath.Abs(testFilePath)
 		require.NoError(t, err)
+		absBroken, err := filepath.Abs(brokenPath)
+		require.NoError(t, err)
 
-		// User with same email as current assignment
-		user := &UserInfo{Email: "user@example.com", Name: "Current User", Number: 1}
-		result := processAssignWorkItem(absPath, "001", "assigned", user, false, testCfgWithDir(tmpDir))
-
-		require.True(t, result.Success)
-		assert.Equal(t, "already_assigned", result.Operation)
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
 
-		// File must be unchanged (no timestamp update, same assigned value)
-		readBack, err := os.ReadFile(testFilePath)
-		require.NoError(t, err)
-		readStr := string(readBack)
-		assert.Contains(t, readStr, "assigned: user@example.com")
-		// updated timestamp should not be present in original content; if we had skipped write, it stays absent
-		assert.NotRegexp(t, `updated: \d{4}-\d{2}-\d{2}T`, readStr)
-	})
+		user := &UserInfo{Email: "bob@example.com", Name: "Bob", Number: 1}
+		flags := AssignFlags{Field: "assigned", DryRun: true, JSON: true}
+		paths := []string{absPath, absBroken}
+		results := processWorkItemUpdates(paths, user, flags, []UserInfo{}, testCfgWithDir(tmpDir))
+		handleErr := handleAssignResults(results, paths, flags, user)
 
-	t.Run("when current assignment differs updates file and returns assign", func(t *testing.T) {
-		tmpDir := t.TempDir()
-		origDir, _ := os.Getwd()
-		require.NoError(t, os.Chdir(tmpDir))
-		defer func() { _ = os.Chdir(origDir) }()
+		_ = w.Close()
+		os.Stdout = oldStdout
 
-		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
-		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
 
-		absPath, err := filepath.Abs(testFilePath)
-		require.NoError(t, err)
+		require.Error(t, handleErr, "a failed validation should still fail the command")
+
+		var parsed []struct {
+			WorkItemID string  `json:"work_item_id"`
+			Success    bool    `json:"success"`
+			Operation  string  `json:"operation"`
+			Error      *string `json:"error"`
+			Would      *struct {
+				Operation string `json:"operation"`
+				Field     string `json:"field"`
+				User      string `json:"user"`
+			} `json:"would"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &parsed), "stdout must be pure JSON: %s", buf.String())
+		require.Len(t, parsed, 2)
 
-		user := &UserInfo{Email: "other@example.com", Name: "Other", Number: 2}
-		result := processAssignWorkItem(absPath, "001", "assigned", user, false, testCfgWithDir(tmpDir))
+		assert.Equal(t, "001", parsed[0].WorkItemID)
+		assert.True(t, parsed[0].Success)
+		assert.Equal(t, "validate", parsed[0].Operation)
+		assert.Nil(t, parsed[0].Error)
+		require.NotNil(t, parsed[0].Would)
+		assert.Equal(t, "assign", parsed[0].Would.Operation)
+		assert.Equal(t, "assigned", parsed[0].Would.Field)
+		assert.Equal(t, "bob@example.com", parsed[0].Would.User)
 
-		require.True(t, result.Success)
-		assert.Equal(t, "assign", result.Operation)
+		assert.False(t, parsed[1].Success)
+		require.NotNil(t, parsed[1].Error)
 
 		readBack, err := os.ReadFile(testFilePath)
 		require.NoError(t, err)
-		assert.Contains(t, string(readBack), "assigned: other@example.com")
+		assert.NotContains(t, string(readBack), "bob@example.com")
+	})
+
+	t.Run("unassign intent has no user", func(t *testing.T) {
+		intent := dryRunIntent("reviewer", &UserInfo{Email: "bob@example.com"}, AssignFlags{Unassign: true})
+		assert.Equal(t, &AssignIntent{Operation: "unassign", Field: "reviewer"}, intent)
+	})
+
+	t.Run("empty results encode as empty array", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeAssignResultsJSON(&buf, nil))
+		assert.Equal(t, "[]", strings.TrimSpace(buf.String()))
+	})
+
+	t.Run("json cannot be combined with interactive", func(t *testing.T) {
+		err := validateAssignFlagCombinations("", AssignFlags{JSON: true, Interactive: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--json")
 	})
 }
 
-func TestDisplayBatchSummary(t *testing.T) {
-	t.Run("displays summary for successful operations", func(t *testing.T) {
-		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+func TestAssignPairs(t *testing.T) {
+	t.Run("parseAssignArgs keeps pair tokens together", func(t *testing.T) {
+		workItems, user := parseAssignArgs([]string{"001=alice", "002=bob"}, AssignFlags{})
+		assert.Equal(t, []string{"001=alice", "002=bob"}, workItems)
+		assert.Equal(t, "", user)
+	})
 
-		results := []WorkItemUpdateResult{
-			{
-				WorkItemPath: "/path/to/001",
-				WorkItemID:   "001",
-				Success:      true,
-				Operation:    "assign",
-			},
-			{
-				WorkItemPath: "/path/to/002",
-				WorkItemID:   "002",
-				Success:      true,
-				Operation:    "assign",
-			},
-		}
+	t.Run("splits pairs into work items and users", func(t *testing.T) {
+		workItems, identifiers, err := splitAssignPairs([]string{"001=alice", "002=bob@example.com", "003=5"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"001", "002", "003"}, workItems)
+		assert.Equal(t, []string{"alice", "bob@example.com", "5"}, identifiers)
+	})
 
-		displayBatchSummary(results)
+	t.Run("rejects a trailing bare user", func(t *testing.T) {
+		_, _, err := splitAssignPairs([]string{"001=alice", "002", "bob"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot mix id=user pairs with bare argument '002'")
+	})
 
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("rejects a work item given in two pairs", func(t *testing.T) {
+		_, _, err := splitAssignPairs([]string{"001=alice", "002=carol", "001=bob"})
+		require.EqualError(t, err, "work item 001 specified twice")
+	})
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+	t.Run("rejects a work item given as a bare argument and in a pair", func(t *testing.T) {
+		_, _, err := splitAssignPairs([]string{"001", "001=bob"})
+		require.EqualError(t, err, "work item 001 specified twice")
+		_, _, err = splitAssignPairs([]string{"001=bob", "001"})
+		require.EqualError(t, err, "work item 001 specified twice")
+	})
 
-		assert.Contains(t, output, "Operation Results:")
-		assert.Contains(t, output, "001")
-		assert.Contains(t, output, "002")
-		assert.Contains(t, output, "Summary: 2 succeeded, 0 failed")
-		assert.Contains(t, output, "✓")
+	t.Run("rejects incomplete pairs", func(t *testing.T) {
+		for _, token := range []string{"001=", "=alice"} {
+			_, _, err := splitAssignPairs([]string{token})
+			require.Error(t, err, token)
+			assert.Contains(t, err.Error(), "invalid pair")
+		}
 	})
 
-	t.Run("displays summary with failures", func(t *testing.T) {
-		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("assigns each work item to its own user", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
 
-		results := []WorkItemUpdateResult{
-			{
-				WorkItemPath: "/path/to/001",
-				WorkItemID:   "001",
-				Success:      true,
-				Operation:    "assign",
-			},
-			{
-				WorkItemPath: "/path/to/002",
-				WorkItemID:   "002",
-				Success:      false,
-				Error:        fmt.Errorf("test error"),
-				Operation:    "assign",
-			},
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		item := func(id string) string {
+			return "---\nid: \"" + id + "\"\ntitle: Item " + id + "\nstatus: todo\nkind: task\ncreated: 2024-01-01\n---\n# Item\n"
 		}
+		first := ".work/1_todo/001-first.task.md"
+		second := ".work/1_todo/002-second.task.md"
+		require.NoError(t, os.WriteFile(first, []byte(item("001")), 0o600))
+		require.NoError(t, os.WriteFile(second, []byte(item("002")), 0o600))
 
-		displayBatchSummary(results)
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users = config.UsersConfig{
+			UseGitHistory: &useGitHistory,
+			SavedUsers: []config.SavedUser{
+				{Email: "alice@example.com", Name: "Alice"},
+				{Email: "bob@example.com", Name: "Bob"},
+			},
+		}
 
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		err := runAssignPairs([]string{"001=alice", "002=2"}, AssignFlags{Field: "assigned"}, cfg)
 		_ = w.Close()
 		os.Stdout = oldStdout
-
 		var buf bytes.Buffer
 		_, _ = buf.ReadFrom(r)
-		output := buf.String()
 
-		assert.Contains(t, output, "Operation Results:")
-		assert.Contains(t, output, "Summary: 1 succeeded, 1 failed")
-		assert.Contains(t, output, "Failed work items:")
-		assert.Contains(t, output, "002")
-		assert.Contains(t, output, "test error")
-		assert.Contains(t, output, "✓")
-		assert.Contains(t, output, "✗")
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "Summary: 2 succeeded, 0 failed")
+
+		firstContent, err := os.ReadFile(first)
+		require.NoError(t, err)
+		assert.Contains(t, string(firstContent), "assigned: alice@example.com")
+		secondContent, err := os.ReadFile(second)
+		require.NoError(t, err)
+		assert.Contains(t, string(secondContent), "assigned: bob@example.com")
 	})
 
-	t.Run("displays summary when all operations fail", func(t *testing.T) {
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("an unknown user fails before any write", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
 
-		results := []WorkItemUpdateResult{
-			{
-				WorkItemPath: "/path/to/001",
-				WorkItemID:   "001",
-				Success:      false,
-				Error:        fmt.Errorf("failed to assign"),
-				Operation:    "assign",
-			},
-			{
-				WorkItemPath: "/path/to/002",
-				WorkItemID:   "002",
-				Success:      false,
-				Error:        fmt.Errorf("permission denied"),
-				Operation:    "assign",
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(testWorkItemContentWithAssigned), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users = config.UsersConfig{UseGitHistory: &useGitHistory}
+
+		err := runAssignPairs([]string{"001=nobody@example.com"}, AssignFlags{Field: "assigned"}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item 001")
+
+		content, err := os.ReadFile(testFilePathPhase5)
+		require.NoError(t, err)
+		assert.Equal(t, testWorkItemContentWithAssigned, string(content))
+	})
+
+	t.Run("one ambiguous or unknown user leaves every work item unchanged", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		paths := map[string]string{}
+		for _, id := range []string{"001", "002", "003"} {
+			path := ".work/1_todo/" + id + "-item.task.md"
+			content := "---\nid: \"" + id + "\"\ntitle: Item " + id + "\nstatus: todo\nkind: task\ncreated: 2024-01-01\n---\n# Item\n"
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			paths[path] = content
+		}
+
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users = config.UsersConfig{
+			UseGitHistory: &useGitHistory,
+			SavedUsers: []config.SavedUser{
+				{Email: "alice@example.com", Name: "Alice"},
+				{Email: "bob@example.com", Name: "Bob"},
 			},
 		}
 
-		displayBatchSummary(results)
+		err := runAssignPairs([]string{"001=alice", "002=example", "003=bob"}, AssignFlags{Field: "assigned"}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item 002")
+
+		err = runAssignPairs([]string{"001=alice", "002=example", "003=nobody"}, AssignFlags{Field: "assigned"}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2 users could not be resolved, no work items were changed")
+		assert.Contains(t, err.Error(), "work item 002")
+		assert.Contains(t, err.Error(), "work item 003: user 'nobody' not found")
+
+		for path, content := range paths {
+			assert.Equal(t, content, mustReadFile(t, path), path)
+		}
+	})
+
+	t.Run("rejects a work item given by id and by path before any write", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(testWorkItemContentWithAssigned), 0o600))
+
+		err := runAssignPairs([]string{"001=alice", testFilePathPhase5 + "=bob"}, AssignFlags{Field: "assigned"}, testCfgWithDir(tmpDir))
+		require.EqualError(t, err, "work item 001 specified twice")
+		assert.Equal(t, testWorkItemContentWithAssigned, mustReadFile(t, testFilePathPhase5))
+	})
+
+	t.Run("rejects unassign with pairs", func(t *testing.T) {
+		err := runAssignPairs([]string{"001=alice"}, AssignFlags{Field: "assigned", Unassign: true}, testCfgWithDir("."))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "id=user pairs")
+	})
+
+	t.Run("rejects --max-batch with pairs", func(t *testing.T) {
+		err := runAssignPairs([]string{"001=alice"}, AssignFlags{Field: "assigned", MaxBatch: 2}, testCfgWithDir("."))
+		require.EqualError(t, err, "invalid flag combination: --max-batch and --resume cannot be used with id=user pairs")
+	})
+}
+
+func TestAssignFromFileList(t *testing.T) {
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		for _, id := range []string{"001", "002"} {
+			content := "---\nid: \"" + id + "\"\ntitle: Item " + id + "\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n---\n# Item\n"
+			require.NoError(t, os.WriteFile(".work/1_todo/"+id+"-item.prd.md", []byte(content), 0o600))
+		}
+		require.NoError(t, os.WriteFile("outside.prd.md", []byte("---\nid: \"009\"\n---\n"), 0o600))
 
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users = config.UsersConfig{
+			UseGitHistory: &useGitHistory,
+			SavedUsers:    []config.SavedUser{{Email: "alice@example.com", Name: "Alice"}},
+		}
+		return cfg
+	}
+	run := func(t *testing.T, args []string, flags AssignFlags, cfg *config.Config, input string) (string, error) {
+		t.Helper()
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		err := runAssignFromFileList(args, flags, cfg, strings.NewReader(input))
 		_ = w.Close()
 		os.Stdout = oldStdout
-
 		var buf bytes.Buffer
 		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		return buf.String(), err
+	}
 
-		assert.Contains(t, output, "Operation Results:")
-		assert.Contains(t, output, "Summary: 0 succeeded, 2 failed")
-		assert.Contains(t, output, "Failed work items:")
-		assert.Contains(t, output, "001")
-		assert.Contains(t, output, "002")
-		assert.Contains(t, output, "failed to assign")
-		assert.Contains(t, output, "permission denied")
-		assert.Contains(t, output, "✗")
+	t.Run("assigns the user to every path read from stdin", func(t *testing.T) {
+		cfg := setup(t)
+		input := ".work/1_todo/001-item.prd.md\n\n.work/1_todo/002-item.prd.md\n"
+
+		output, err := run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "-"}, cfg, input)
+		require.NoError(t, err)
+		assert.Contains(t, output, "Summary: 2 succeeded, 0 failed")
+		for _, id := range []string{"001", "002"} {
+			assert.Contains(t, mustReadFile(t, ".work/1_todo/"+id+"-item.prd.md"), "assigned: alice@example.com")
+		}
 	})
 
-	t.Run("handles empty results", func(t *testing.T) {
-		// Capture output
+	t.Run("reports invalid lines without aborting", func(t *testing.T) {
+		cfg := setup(t)
+		input := "outside.prd.md\n.work/1_todo/001-item.prd.md\n.work/1_todo/404-missing.prd.md\n"
+
+		output, err := run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "-"}, cfg, input)
+		require.Error(t, err)
+		assert.Contains(t, output, "Summary: 1 succeeded, 2 failed")
+		assert.Contains(t, output, "line 1 (outside.prd.md): invalid work item path")
+		assert.Contains(t, output, "line 3 (.work/1_todo/404-missing.prd.md): work item file does not exist")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/001-item.prd.md"), "assigned: alice@example.com")
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "-", DryRun: true}, cfg, ".work/1_todo/001-item.prd.md\n")
+		require.NoError(t, err)
+		assert.Contains(t, output, "Would assign work item 001 to Alice <alice@example.com>")
+		assert.NotContains(t, mustReadFile(t, ".work/1_todo/001-item.prd.md"), "assigned:")
+	})
+
+	t.Run("reads paths from a file", func(t *testing.T) {
+		cfg := setup(t)
+		require.NoError(t, os.WriteFile("items.txt", []byte(".work/1_todo/002-item.prd.md\n"), 0o600))
+
+		_, err := run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "items.txt"}, cfg, "")
+		require.NoError(t, err)
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/002-item.prd.md"), "assigned: alice@example.com")
+	})
+
+	t.Run("processes the paths in --max-batch chunks", func(t *testing.T) {
+		cfg := setup(t)
+		input := ".work/1_todo/001-item.prd.md\n.work/1_todo/002-item.prd.md\n"
+
+		output, err := run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "-", MaxBatch: 1}, cfg, input)
+		require.NoError(t, err)
+		assert.Contains(t, output, "Checkpoint: 1/2 work items processed (1 succeeded, 0 failed)")
+		assert.Contains(t, output, "Checkpoint: 2/2 work items processed (2 succeeded, 0 failed)")
+		assert.NoFileExists(t, filepath.Join(".work", assignResumeFile))
+	})
+
+	t.Run("rejects work item arguments and empty input", func(t *testing.T) {
+		cfg := setup(t)
+
+		_, err := run(t, []string{"001", "alice"}, AssignFlags{Field: "assigned", FileList: "-"}, cfg, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pass only the user identifier")
+
+		_, err = run(t, []string{"alice"}, AssignFlags{Field: "assigned", FileList: "-"}, cfg, "\n")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no work item paths read from stdin")
+	})
+}
+
+func TestAssignBatches(t *testing.T) {
+	t.Run("splits work items into chunks of at most the batch size", func(t *testing.T) {
+		paths := []string{"a", "b", "c", "d", "e"}
+		assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, chunkWorkItems(paths, 2))
+		assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, chunkWorkItems(paths, 5))
+		assert.Equal(t, [][]string{{"a", "b", "c", "d", "e"}}, chunkWorkItems(paths, 10))
+		assert.Equal(t, [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}, chunkWorkItems(paths, 1))
+		assert.Empty(t, chunkWorkItems(nil, 2))
+	})
+
+	t.Run("rejects the batch flags in modes that do not run in chunks", func(t *testing.T) {
+		require.NoError(t, validateAssignBatchFlags(AssignFlags{MaxBatch: 2, FileList: "-"}))
+		require.EqualError(t, validateAssignBatchFlags(AssignFlags{Resume: true, FileList: "-"}),
+			"invalid flag combination: --resume cannot be used with --pick or --file-list")
+		require.EqualError(t, validateAssignBatchFlags(AssignFlags{MaxBatch: 2, FromCodeowners: true}),
+			"invalid flag combination: --max-batch and --resume cannot be used with --set-from-codeowners")
+		require.EqualError(t, runAssignToAuthors(nil, AssignFlags{MaxBatch: 2}, nil, testCfgWithDir(".")),
+			"invalid flag combination: --max-batch and --resume cannot be used with @author")
+	})
+
+	t.Run("parses the resume file, skipping comments and blank lines", func(t *testing.T) {
+		content := "# Remaining work items\n003\n\n  004  \n.work/1_todo/no-id.task.md\n"
+		assert.Equal(t, []string{"003", "004", ".work/1_todo/no-id.task.md"}, parseAssignResumeFile(content))
+		assert.Empty(t, parseAssignResumeFile("# nothing left\n"))
+	})
+
+	setup := func(t *testing.T) ([]string, *config.Config) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		var paths []string
+		for _, id := range []string{"001", "002", "003"} {
+			path := filepath.Join(tmpDir, ".work", "1_todo", id+"-item.task.md")
+			content := "---\nid: \"" + id + "\"\ntitle: Item " + id + "\nstatus: todo\nkind: task\ncreated: 2024-01-01\n---\n# Item\n"
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			paths = append(paths, path)
+		}
+		return paths, testCfgWithDir(tmpDir)
+	}
+	user := &UserInfo{Email: "alice@example.com", Name: "Alice"}
+
+	t.Run("prints a checkpoint per chunk and removes the resume file when done", func(t *testing.T) {
+		paths, cfg := setup(t)
+
 		oldStdout := os.Stdout
 		r, w, _ := os.Pipe()
 		os.Stdout = w
-
-		displayBatchSummary([]WorkItemUpdateResult{})
-
+		results, err := processAssignBatches(paths, user, AssignFlags{Field: "assigned", MaxBatch: 2}, nil, cfg)
 		_ = w.Close()
 		os.Stdout = oldStdout
-
 		var buf bytes.Buffer
 		_, _ = buf.ReadFrom(r)
-		output := buf.String()
 
-		// Should not output anything for empty results
-		assert.Empty(t, output)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Contains(t, buf.String(), "Checkpoint: 2/3 work items processed (2 succeeded, 0 failed)")
+		assert.Contains(t, buf.String(), "Checkpoint: 3/3 work items processed (3 succeeded, 0 failed)")
+		assert.NoFileExists(t, filepath.Join(".work", assignResumeFile))
+		for _, path := range paths {
+			assert.Contains(t, mustReadFile(t, path), "assigned: alice@example.com")
+		}
+	})
+
+	t.Run("resumes the work items left in the resume file", func(t *testing.T) {
+		_, cfg := setup(t)
+		require.NoError(t, writeAssignResumeFile(filepath.Join(".work", assignResumeFile), []string{"002", "003"}))
+
+		args, err := resumeWorkItemArgs([]string{"alice"}, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"002", "003", "alice"}, args)
+	})
+
+	t.Run("reports a missing resume file", func(t *testing.T) {
+		_, cfg := setup(t)
+
+		_, err := resumeWorkItemArgs([]string{"alice"}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no interrupted kira assign run to resume")
+	})
+
+	t.Run("keeps the resume file when a chunk cannot start", func(t *testing.T) {
+		paths, cfg := setup(t)
+		resumePath := filepath.Join(".work", assignResumeFile)
+		require.NoError(t, os.Mkdir(resumePath, 0o700))
+
+		_, err := processAssignBatches(paths, user, AssignFlags{Field: "assigned", MaxBatch: 2}, nil, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to write resume file")
+		assert.NotContains(t, mustReadFile(t, paths[0]), "assigned:")
 	})
 }
 
-// Phase 9: Interactive Mode Tests
+func TestProcessAssignWorkItemAlreadyAssigned(t *testing.T) {
+	testFilePath := testFilePathPhase5
+	content := testWorkItemContentWithAssigned // assigned: user@example.com
 
-func TestGetCurrentAssignment(t *testing.T) {
-	tmpDir := t.TempDir()
-	require.NoError(t, os.Chdir(tmpDir))
-	defer func() { _ = os.Chdir("/") }()
+	t.Run("when current assignment equals user skips write and returns already_assigned", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
 
-	// Initialize kira workspace
-	require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
 
-	t.Run("returns current assignment when field exists", func(t *testing.T) {
-		workItemContent := `---
-id: "001"
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		// User with same email as current assignment
+		user := &UserInfo{Email: "user@example.com", Name: "Current User", Number: 1}
+		result := processAssignWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.True(t, result.Success)
+		assert.Equal(t, "already_assigned", result.Operation)
+
+		// File must be unchanged (no timestamp update, same assigned value)
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		readStr := string(readBack)
+		assert.Contains(t, readStr, "assigned: user@example.com")
+		// updated timestamp should not be present in original content; if we had skipped write, it stays absent
+		assert.NotRegexp(t, `updated: \d{4}-\d{2}-\d{2}T`, readStr)
+	})
+
+	t.Run("when current assignment differs updates file and returns assign", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		user := &UserInfo{Email: "other@example.com", Name: "Other", Number: 2}
+		result := processAssignWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.True(t, result.Success)
+		assert.Equal(t, "assign", result.Operation)
+
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(readBack), "assigned: other@example.com")
+	})
+}
+
+func TestProcessAssignWorkItemWouldRemoveAssignees(t *testing.T) {
+	testFilePath := testFilePathPhase5
+	content := `---
+id: 001
 title: Test Feature
 status: todo
 kind: prd
-assigned: user@example.com
 created: 2024-01-01
+assigned: [alice@example.com, bob@example.com]
 ---
 
 # Test Feature
 `
-		filePath := ".work/1_todo/001-test.prd.md"
-		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
 
-		cfg := testCfgWithDir(tmpDir)
-		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+	t.Run("plain set over array with other assignees requires force", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		user := &UserInfo{Email: "carol@example.com", Name: "Carol", Number: 3}
+		result := processAssignWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.False(t, result.Success)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "would remove: alice@example.com, bob@example.com")
+		assert.Contains(t, result.Error.Error(), "--force")
+
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(readBack), "file must be unchanged")
+	})
+
+	t.Run("compares emails case-insensitively", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		single := strings.Replace(content, "[alice@example.com, bob@example.com]", "[Alice@Example.com]", 1)
+		require.NoError(t, os.WriteFile(testFilePath, []byte(single), 0o600))
+
+		removed, err := assigneesRemovedBySet(testFilePath, "assigned", "alice@example.com", testCfgWithDir(tmpDir))
+		require.NoError(t, err)
+		assert.Empty(t, removed)
+	})
+
+	t.Run("fails when the work item cannot be parsed", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		broken := "---\nid: 001\nassigned: [alice@example.com\n---\n"
+		require.NoError(t, os.WriteFile(testFilePath, []byte(broken), 0o600))
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		user := &UserInfo{Email: "carol@example.com", Name: "Carol", Number: 3}
+		result := processAssignWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.False(t, result.Success)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "failed to parse work item")
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Equal(t, broken, string(readBack), "file must be unchanged")
+	})
+
+	t.Run("force replaces the array", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		user := &UserInfo{Email: "carol@example.com", Name: "Carol", Number: 3}
+		result := processAssignWorkItem(absPath, "001", "assigned", user, true, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.True(t, result.Success)
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(readBack), "assigned: carol@example.com")
+		assert.NotContains(t, string(readBack), "alice@example.com")
+	})
+
+	t.Run("append mode is unaffected", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(content), 0o600))
+
+		absPath, err := filepath.Abs(testFilePath)
+		require.NoError(t, err)
+
+		user := &UserInfo{Email: "carol@example.com", Name: "Carol", Number: 3}
+		result := processAppendWorkItem(absPath, "001", "assigned", user, false, AssignFlags{}, false, testCfgWithDir(tmpDir))
+
+		require.True(t, result.Success)
+		readBack, err := os.ReadFile(testFilePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(readBack), "alice@example.com")
+		assert.Contains(t, string(readBack), "carol@example.com")
+	})
+}
+
+func TestKnownOnlyAssignment(t *testing.T) {
+	users := []UserInfo{
+		{Email: "alice@example.com", Name: "Alice", Number: 1},
+		{Email: "bob@example.com", Name: "Bob", Number: 2},
+	}
+
+	t.Run("accepts identifiers that match known users", func(t *testing.T) {
+		for _, identifier := range []string{"1", "alice@example.com", "ALICE@example.com", "Bob", "bob@"} {
+			user, err := resolveUserIdentifier(identifier, users)
+			require.NoError(t, err, identifier)
+			assert.Contains(t, []string{"alice@example.com", "bob@example.com"}, user.Email)
+		}
+	})
+
+	t.Run("rejects identifiers that match no known user", func(t *testing.T) {
+		for _, identifier := range []string{"alice@exmaple.com", "carol@example.com", "Mallory", "3"} {
+			_, err := resolveUserIdentifier(identifier, users)
+			require.Error(t, err, identifier)
+		}
+	})
+
+	t.Run("known-only leaves out users only found in the git history", func(t *testing.T) {
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		runGit(t, tmpDir, "init")
+		require.NoError(t, os.WriteFile("README.md", []byte("# Project\n"), 0o600))
+		runGit(t, tmpDir, "add", ".")
+		runGit(t, tmpDir, "-c", "user.name=Carol", "-c", "user.email=carol@example.com", "commit", "-m", "initial")
+
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := true
+		cfg.Users = config.UsersConfig{
+			UseGitHistory: &useGitHistory,
+			SavedUsers:    []config.SavedUser{{Email: "alice@example.com", Name: "Alice"}},
+		}
+
+		all, err := collectAssignUsers(AssignFlags{}, cfg)
+		require.NoError(t, err)
+		_, err = resolveUserIdentifier("carol@example.com", all)
+		require.NoError(t, err)
+
+		known, err := collectAssignUsers(AssignFlags{KnownOnly: true}, cfg)
+		require.NoError(t, err)
+		_, err = resolveUserIdentifier("carol@example.com", known)
+		require.Error(t, err)
+		user, err := resolveUserIdentifier("alice", known)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", user.Email)
+	})
+
+	t.Run("assignment.require_known_user enables known-only", func(t *testing.T) {
+		cfg := testCfgWithDir(".")
+		assert.False(t, requireKnownUser(cfg))
+
+		cfg.Assignment = &config.AssignmentConfig{RequireKnownUser: true}
+		assert.True(t, requireKnownUser(cfg))
+	})
+
+	t.Run("flag is registered", func(t *testing.T) {
+		flag := assignCmd.Flags().Lookup("known-only")
+		require.NotNil(t, flag)
+		assert.Equal(t, "false", flag.DefValue)
+	})
+}
+
+func TestResolveAuthorIdentifier(t *testing.T) {
+	content := `---
+id: 001
+title: Test Feature
+status: todo
+kind: prd
+created: 2024-01-01
+---
+
+# Test Feature
+`
+
+	setup := func(t *testing.T, commit bool) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		runGit(t, tmpDir, "init")
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(content), 0o600))
+		if commit {
+			runGit(t, tmpDir, "add", ".")
+			runGit(t, tmpDir, "-c", "user.name=Author Person", "-c", "user.email=author@example.com", "commit", "-m", "add work item")
+		}
+		return tmpDir
+	}
+
+	t.Run("matches last author to known user", func(t *testing.T) {
+		setup(t, true)
+		users := []UserInfo{
+			{Email: "other@example.com", Name: "Other", Number: 1},
+			{Email: "Author@Example.com", Name: "Saved Author", Source: "config", Number: 2},
+		}
+
+		user, err := resolveAuthorIdentifier(testFilePathPhase5, users, false, testCfgWithDir("."))
+		require.NoError(t, err)
+		assert.Equal(t, "Saved Author", user.Name)
+		assert.Equal(t, 2, user.Number)
+	})
+
+	t.Run("synthesizes user from git history when unknown", func(t *testing.T) {
+		tmpDir := setup(t, true)
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := true
+		cfg.Users.UseGitHistory = &useGitHistory
+
+		user, err := resolveAuthorIdentifier(testFilePathPhase5, nil, false, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "author@example.com", user.Email)
+		assert.Equal(t, "Author Person", user.Name)
+		assert.Equal(t, "git", user.Source)
+	})
+
+	t.Run("errors for unknown author when git history is disabled", func(t *testing.T) {
+		tmpDir := setup(t, true)
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users.UseGitHistory = &useGitHistory
+
+		_, err := resolveAuthorIdentifier(testFilePathPhase5, nil, false, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "author@example.com")
+		assert.Contains(t, err.Error(), "not a known user")
+	})
+
+	t.Run("known-only rejects author outside the known user list", func(t *testing.T) {
+		tmpDir := setup(t, true)
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := true
+		cfg.Users.UseGitHistory = &useGitHistory
+		users := []UserInfo{{Email: "other@example.com", Name: "Other", Number: 1}}
+
+		_, err := resolveAuthorIdentifier(testFilePathPhase5, users, true, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a known user")
+
+		users = append(users, UserInfo{Email: "author@example.com", Name: "Author Person", Number: 2})
+		user, err := resolveAuthorIdentifier(testFilePathPhase5, users, true, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 2, user.Number)
+	})
+
+	t.Run("errors with guidance when file is not committed", func(t *testing.T) {
+		tmpDir := setup(t, false)
+		_, err := resolveAuthorIdentifier(testFilePathPhase5, nil, false, testCfgWithDir("."))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no commits yet")
+
+		// Repository has history, but not for this file
+		require.NoError(t, os.WriteFile("README.md", []byte("readme"), 0o600))
+		runGit(t, tmpDir, "add", "README.md")
+		runGit(t, tmpDir, "-c", "user.name=Other", "-c", "user.email=other@example.com", "commit", "-m", "readme")
+		_, err = resolveAuthorIdentifier(testFilePathPhase5, nil, false, testCfgWithDir("."))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has no commits yet")
+	})
+
+	t.Run("assigns work item to its author", func(t *testing.T) {
+		setup(t, true)
+		users := []UserInfo{{Email: "author@example.com", Name: "Author Person", Number: 1}}
+		flags := AssignFlags{Field: "reviewer"}
+
+		require.NoError(t, runAssignToAuthors([]string{testFilePathPhase5}, flags, users, testCfgWithDir(".")))
+
+		data, err := os.ReadFile(testFilePathPhase5)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "reviewer: author@example.com")
+	})
+}
+
+func TestDisplayBatchSummary(t *testing.T) {
+	t.Run("displays summary for successful operations", func(t *testing.T) {
+		// Capture output
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		results := []WorkItemUpdateResult{
+			{
+				WorkItemPath: "/path/to/001",
+				WorkItemID:   "001",
+				Success:      true,
+				Operation:    "assign",
+			},
+			{
+				WorkItemPath: "/path/to/002",
+				WorkItemID:   "002",
+				Success:      true,
+				Operation:    "assign",
+			},
+		}
+
+		displayBatchSummary(results)
+
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		assert.Contains(t, output, "Operation Results:")
+		assert.Contains(t, output, "001")
+		assert.Contains(t, output, "002")
+		assert.Contains(t, output, "Summary: 2 succeeded, 0 failed")
+		assert.Contains(t, output, "✓")
+	})
+
+	t.Run("displays summary with failures", func(t *testing.T) {
+		// Capture output
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		results := []WorkItemUpdateResult{
+			{
+				WorkItemPath: "/path/to/001",
+				WorkItemID:   "001",
+				Success:      true,
+				Operation:    "assign",
+			},
+			{
+				WorkItemPath: "/path/to/002",
+				WorkItemID:   "002",
+				Success:      false,
+				Error:        fmt.Errorf("test error"),
+				Operation:    "assign",
+			},
+		}
+
+		displayBatchSummary(results)
+
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		assert.Contains(t, output, "Operation Results:")
+		assert.Contains(t, output, "Summary: 1 succeeded, 1 failed")
+		assert.Contains(t, output, "Failed work items:")
+		assert.Contains(t, output, "002")
+		assert.Contains(t, output, "test error")
+		assert.Contains(t, output, "✓")
+		assert.Contains(t, output, "✗")
+	})
+
+	t.Run("displays summary when all operations fail", func(t *testing.T) {
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		results := []WorkItemUpdateResult{
+			{
+				WorkItemPath: "/path/to/001",
+				WorkItemID:   "001",
+				Success:      false,
+				Error:        fmt.Errorf("failed to assign"),
+				Operation:    "assign",
+			},
+			{
+				WorkItemPath: "/path/to/002",
+				WorkItemID:   "002",
+				Success:      false,
+				Error:        fmt.Errorf("permission denied"),
+				Operation:    "assign",
+			},
+		}
+
+		displayBatchSummary(results)
+
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		assert.Contains(t, output, "Operation Results:")
+		assert.Contains(t, output, "Summary: 0 succeeded, 2 failed")
+		assert.Contains(t, output, "Failed work items:")
+		assert.Contains(t, output, "001")
+		assert.Contains(t, output, "002")
+		assert.Contains(t, output, "failed to assign")
+		assert.Contains(t, output, "permission denied")
+		assert.Contains(t, output, "✗")
+	})
+
+	t.Run("handles empty results", func(t *testing.T) {
+		// Capture output
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		displayBatchSummary([]WorkItemUpdateResult{})
+
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		// Should not output anything for empty results
+		assert.Empty(t, output)
+	})
+}
+
+// Phase 9: Interactive Mode Tests
+
+func TestGetCurrentAssignment(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir("/") }()
+
+	// Initialize kira workspace
+	require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+
+	t.Run("returns current assignment when field exists", func(t *testing.T) {
+		workItemContent := `---
+id: "001"
+title: Test Feature
+status: todo
+kind: prd
+assigned: user@example.com
+created: 2024-01-01
+---
+
+# Test Feature
+`
+		filePath := ".work/1_todo/001-test.prd.md"
+		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "user@example.com", assignment)
+	})
+
+	t.Run("returns empty string when field does not exist", func(t *testing.T) {
+		workItemContent := `---
+id: "002"
+title: Test Feature
+status: todo
+kind: prd
+created: 2024-01-01
+---
+
+# Test Feature
+`
+		filePath := ".work/1_todo/002-test.prd.md"
+		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Empty(t, assignment)
+	})
+
+	t.Run("returns empty string when field is empty", func(t *testing.T) {
+		workItemContent := `---
+id: "003"
+title: Test Feature
+status: todo
+kind: prd
+assigned: ""
+created: 2024-01-01
+---
+
+# Test Feature
+`
+		filePath := ".work/1_todo/003-test.prd.md"
+		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Empty(t, assignment)
+	})
+
+	t.Run("works with custom field", func(t *testing.T) {
+		workItemContent := `---
+id: 004
+title: Test Feature
+status: todo
+kind: prd
+reviewer: reviewer@example.com
+created: 2024-01-01
+---
+
+# Test Feature
+`
+		filePath := ".work/1_todo/004-test.prd.md"
+		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		assignment, err := getCurrentAssignment(filePath, "reviewer", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "reviewer@example.com", assignment)
+	})
+
+	t.Run("handles array field values", func(t *testing.T) {
+		workItemContent := `---
+id: 005
+title: Test Feature
+status: todo
+kind: prd
+assigned: [user1@example.com, user2@example.com]
+created: 2024-01-01
+---
+
+# Test Feature
+`
+		filePath := ".work/1_todo/005-test.prd.md"
+		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Contains(t, assignment, "user1@example.com")
+		assert.Contains(t, assignment, "user2@example.com")
+	})
+
+	t.Run("returns error for invalid file path", func(t *testing.T) {
+		_, err := getCurrentAssignment("nonexistent.md", "assigned", &config.DefaultConfig)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse work item")
+	})
+}
+
+func TestShowInteractiveSelection(t *testing.T) {
+	users := []UserInfo{
+		{Email: "user1@example.com", Name: "User One", Number: 1},
+		{Email: "user2@example.com", Name: "User Two", Number: 2},
+		{Email: "user3@example.com", Name: "", Number: 3},
+	}
+
+	t.Run("displays users in correct format", func(t *testing.T) {
+		// Capture output
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		// Create input with valid selection
+		input := strings.NewReader("1\n")
+
+		// Run function in goroutine to avoid blocking
+		done := make(chan struct{})
+		var selection []int
+		var err error
+		go func() {
+			selection, err = showInteractiveSelection(users, "", "assigned", input)
+			close(done)
+		}()
+
+		// Wait a bit for output
+		time.Sleep(100 * time.Millisecond)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		// Read output
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		// Wait for function to complete
+		<-done
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, selection)
+		assert.Contains(t, output, "Available users:")
+		assert.Contains(t, output, "1. User One <user1@example.com>")
+		assert.Contains(t, output, "2. User Two <user2@example.com>")
+		assert.Contains(t, output, "3. user3@example.com")
+		assert.Contains(t, output, "0. Unassign")
+	})
+
+	t.Run("shows current assignment when provided", func(t *testing.T) {
+		// Capture output
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		input := strings.NewReader("2\n")
+
+		done := make(chan struct{})
+		var selection []int
+		var err error
+		go func() {
+			selection, err = showInteractiveSelection(users, "current@example.com", "assigned", input)
+			close(done)
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		<-done
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{2}, selection)
+		assert.Contains(t, output, "Current assignment (assigned): current@example.com")
+	})
+
+	t.Run("returns unassign selection (0)", func(t *testing.T) {
+		oldStdout := os.Stdout
+		_, w, _ := os.Pipe()
+		os.Stdout = w
+
+		input := strings.NewReader("0\n")
+
+		done := make(chan struct{})
+		var selection []int
+		var err error
+		go func() {
+			selection, err = showInteractiveSelection(users, "", "assigned", input)
+			close(done)
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		<-done
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{0}, selection)
+	})
+
+	t.Run("handles invalid input and retries", func(t *testing.T) {
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		// First invalid input, then valid
+		input := strings.NewReader("invalid\n1\n")
+
+		done := make(chan struct{})
+		var selection []int
+		var err error
+		go func() {
+			selection, err = showInteractiveSelection(users, "", "assigned", input)
+			close(done)
+		}()
+
+		time.Sleep(200 * time.Millisecond)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		<-done
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, selection)
+		assert.Contains(t, output, "Invalid input")
+	})
+
+	t.Run("handles out of range selection", func(t *testing.T) {
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		// Out of range, then valid
+		input := strings.NewReader("99\n1\n")
+
+		done := make(chan struct{})
+		var selection []int
+		var err error
+		go func() {
+			selection, err = showInteractiveSelection(users, "", "assigned", input)
+			close(done)
+		}()
+
+		time.Sleep(200 * time.Millisecond)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		output := buf.String()
+
+		<-done
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, selection)
+		assert.Contains(t, output, "Invalid selection")
+	})
+
+	t.Run("returns error for empty users list", func(t *testing.T) {
+		_, err := showInteractiveSelection([]UserInfo{}, "", "assigned", strings.NewReader("1\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no users available")
+	})
+
+	t.Run("returns error after too many invalid attempts", func(t *testing.T) {
+		// Provide 3 invalid inputs
+		input := strings.NewReader("invalid1\ninvalid2\ninvalid3\n")
+
+		_, err := showInteractiveSelection(users, "", "assigned", input)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many invalid input attempts")
+	})
+
+	t.Run("parses comma-separated selections", func(t *testing.T) {
+		selection, problem := parseUserSelection("3, 1,3\n", 3)
+		assert.Empty(t, problem)
+		assert.Equal(t, []int{3, 1}, selection)
+
+		selection, problem = parseUserSelection("0\n", 3)
+		assert.Empty(t, problem)
+		assert.Equal(t, []int{0}, selection)
+	})
+
+	t.Run("rejects invalid comma-separated selections", func(t *testing.T) {
+		_, problem := parseUserSelection("1,x", 3)
+		assert.Contains(t, problem, "Invalid input")
+
+		_, problem = parseUserSelection("1,4", 3)
+		assert.Contains(t, problem, "Invalid selection")
+
+		_, problem = parseUserSelection("0,2", 3)
+		assert.Contains(t, problem, "cannot be combined")
+	})
+
+	t.Run("retries until every selected number is valid", func(t *testing.T) {
+		input := strings.NewReader("1,9\n1,2\n")
+
+		selection, err := showInteractiveSelection(users, "", "assigned", input)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, selection)
+	})
+}
+
+func TestAssignPick(t *testing.T) {
+	t.Run("parses comma and space separated selections", func(t *testing.T) {
+		selection, err := parseWorkItemSelection("3, 1 3\n", 4)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 1}, selection)
+
+		selection, err = parseWorkItemSelection("ALL", 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, selection)
+
+		for _, input := range []string{"", "0", "5", "1,x"} {
+			_, err := parseWorkItemSelection(input, 4)
+			assert.Error(t, err, input)
+		}
+	})
+
+	t.Run("picks work items and keeps the user identifier last", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-first.task.md":  listTestWorkItem("001", "First", "todo", ""),
+			"1_todo/002-second.task.md": listTestWorkItem("002", "Second", "todo", ""),
+			"2_doing/003-third.task.md": listTestWorkItem("003", "Third", "doing", ""),
+		})
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		args, pickErr := pickWorkItemArgs([]string{"5"}, AssignFlags{Pick: true, PickStatus: "todo"}, cfg, strings.NewReader("9\n2\n"))
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+
+		require.NoError(t, pickErr)
+		assert.Equal(t, []string{filepath.Join(".work", "1_todo", "002-second.task.md"), "5"}, args)
+		assert.NotContains(t, buf.String(), "Third", "only work items in --status are listed")
+		assert.Contains(t, buf.String(), "Invalid selection")
+	})
+
+	t.Run("stops after too many invalid attempts", func(t *testing.T) {
+		items := []listedWorkItem{{ID: "001", Title: "First", Status: "todo"}}
+		oldStdout := os.Stdout
+		_, w, _ := os.Pipe()
+		os.Stdout = w
+		_, err := showWorkItemSelection(items, strings.NewReader("x\ny\nz\n"))
+		_ = w.Close()
+		os.Stdout = oldStdout
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many invalid input attempts")
+	})
+
+	t.Run("requires a user or interactive selection", func(t *testing.T) {
+		_, err := pickWorkItemArgs(nil, AssignFlags{Pick: true}, testCfgWithDir("."), strings.NewReader(""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "user identifier is required with --pick")
+	})
+
+	t.Run("status requires pick", func(t *testing.T) {
+		err := validateAssignFlagCombinations("5", AssignFlags{PickStatus: "todo"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--status can only be used with --pick")
+	})
+}
+
+func TestAssignSummaryOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir(origDir) }()
+
+	require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+	first := ".work/1_todo/001-first.task.md"
+	second := ".work/1_todo/002-second.task.md"
+	broken := ".work/1_todo/003-broken.task.md"
+	require.NoError(t, os.WriteFile(first, []byte("---\nid: \"001\"\ntitle: First\nstatus: todo\nkind: task\n---\n# First\n"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("---\nid: \"002\"\ntitle: Second\nstatus: todo\nkind: task\nassigned: bob@example.com\n---\n# Second\n"), 0o600))
+	require.NoError(t, os.WriteFile(broken, []byte("---\nid: \"003\"\ntitle: [unclosed\n---\n# Broken\n"), 0o600))
+	cfg := testCfgWithDir(tmpDir)
+	user := &UserInfo{Email: "alice@example.com", Name: "Alice"}
+
+	run := func(t *testing.T, paths []string, flags AssignFlags) (string, error) {
+		t.Helper()
+		flags.Field = "assigned"
+		flags.SummaryOnly = true
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		results := processWorkItemUpdates(paths, user, flags, nil, cfg)
+		err := handleAssignResults(results, paths, flags, user)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		return buf.String(), err
+	}
+
+	t.Run("prints only the summary line for a batch with a failure", func(t *testing.T) {
+		output, err := run(t, []string{first, broken}, AssignFlags{})
+		require.Error(t, err)
+		assert.Equal(t, "1 succeeded, 1 failed\n", output)
+	})
+
+	t.Run("works for append and unassign", func(t *testing.T) {
+		output, err := run(t, []string{first, second}, AssignFlags{Append: true})
+		require.NoError(t, err)
+		assert.Equal(t, "2 succeeded, 0 failed\n", output)
+
+		output, err = run(t, []string{second}, AssignFlags{Unassign: true})
+		require.NoError(t, err)
+		assert.Equal(t, "1 succeeded, 0 failed\n", output)
+	})
+
+	t.Run("dry-run prints only the summary line", func(t *testing.T) {
+		output, err := run(t, []string{first, second}, AssignFlags{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, "2 succeeded, 0 failed\n", output)
+	})
+
+	t.Run("rejects --json", func(t *testing.T) {
+		err := validateAssignFlagCombinations("5", AssignFlags{JSON: true, SummaryOnly: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--json cannot be used together with --summary-only")
+	})
+}
+
+func TestAssignMove(t *testing.T) {
+	setup := func(t *testing.T) (*config.Config, string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		path := ".work/1_todo/001-claim.task.md"
+		require.NoError(t, os.WriteFile(path, []byte("---\nid: \"001\"\ntitle: Claim\nstatus: todo\nkind: task\n---\n# Claim\n"), 0o600))
+		return testCfgWithDir(tmpDir), path
+	}
+	user := &UserInfo{Email: "alice@example.com", Name: "Alice"}
+
+	run := func(t *testing.T, cfg *config.Config, paths []string, flags AssignFlags) (string, []WorkItemUpdateResult, error) {
+		t.Helper()
+		flags.Field = "assigned"
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		results := processWorkItemUpdates(paths, user, flags, nil, cfg)
+		err := handleAssignResults(results, paths, flags, user)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		return buf.String(), results, err
+	}
+
+	t.Run("assigns and moves in one write", func(t *testing.T) {
+		cfg, path := setup(t)
+
+		output, results, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Assigned work item 001 to Alice <alice@example.com>")
+		assert.Contains(t, output, "Moved work item 001 to doing")
+		assert.Equal(t, "doing", results[0].MovedTo)
+
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err), "original file is removed")
+		frontMatter, body, err := parseWorkItemFrontMatter(".work/2_doing/001-claim.task.md", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", frontMatter["assigned"])
+		assert.Equal(t, "doing", frontMatter["status"])
+		assert.NotEmpty(t, frontMatter["updated"])
+		assert.Contains(t, strings.Join(body, "\n"), "# Claim")
+	})
+
+	t.Run("leaves the work item unchanged when the move fails", func(t *testing.T) {
+		cfg, path := setup(t)
+		require.NoError(t, os.WriteFile(".work/2_doing/001-claim.task.md", []byte("existing"), 0o600))
+		before, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		_, results, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing"})
+		require.Error(t, err)
+		require.Len(t, results, 1)
+		assert.Contains(t, results[0].Error.Error(), "work item unchanged")
+
+		after, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(before), string(after), "not assigned without the move")
+		existing, err := os.ReadFile(".work/2_doing/001-claim.task.md")
+		require.NoError(t, err)
+		assert.Equal(t, "existing", string(existing))
+	})
+
+	t.Run("leaves the work item unchanged when the assignment fails", func(t *testing.T) {
+		cfg, path := setup(t)
+		require.NoError(t, os.WriteFile(path, []byte("---\nid: \"001\"\ntitle: Claim\nstatus: todo\nkind: task\nassigned: [bob@example.com]\n---\n"), 0o600))
+
+		_, _, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing"})
+		require.Error(t, err)
+		_, err = os.Stat(path)
+		require.NoError(t, err, "not moved without the assignment")
+		_, err = os.Stat(".work/2_doing/001-claim.task.md")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("dry-run shows both changes without writing", func(t *testing.T) {
+		cfg, path := setup(t)
+
+		output, results, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing", DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Would assign work item 001 to Alice <alice@example.com> (field: assigned)")
+		assert.Contains(t, output, "Would move work item 001 from todo to doing")
+		assert.Equal(t, "doing", results[0].Would.MoveTo)
+		_, err = os.Stat(path)
+		require.NoError(t, err)
+	})
+
+	t.Run("renames and renumbers in the same write with per-folder IDs", func(t *testing.T) {
+		cfg, path := setup(t)
+		cfg.Naming = &config.NamingConfig{PerFolderIDs: true}
+		require.NoError(t, os.WriteFile(".work/2_doing/001-other.task.md", []byte("---\nid: \"001\"\ntitle: Other\nstatus: doing\nkind: task\n---\n"), 0o600))
+
+		output, results, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Moved work item 001 to doing as 002")
+		assert.Equal(t, "002", results[0].NewID)
+
+		assert.NoFileExists(t, path)
+		frontMatter, _, err := parseWorkItemFrontMatter(".work/2_doing/002-claim.task.md", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "002", frontMatter["id"])
+		assert.Equal(t, "alice@example.com", frontMatter["assigned"])
+		assert.Equal(t, "doing", frontMatter["status"])
+	})
+
+	t.Run("dry-run shows the rename with per-folder IDs", func(t *testing.T) {
+		cfg, path := setup(t)
+		cfg.Naming = &config.NamingConfig{PerFolderIDs: true}
+		require.NoError(t, os.WriteFile(".work/2_doing/001-other.task.md", []byte("---\nid: \"001\"\ntitle: Other\nstatus: doing\nkind: task\n---\n"), 0o600))
+
+		output, _, err := run(t, cfg, []string{path}, AssignFlags{MoveTo: "doing", DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, output, filepath.Join(".work", "2_doing", "002-claim.task.md"))
+		assert.Contains(t, output, "[DRY RUN] Update id field: 001 -> 002")
+		assert.FileExists(t, path)
+	})
+
+	t.Run("rejects unknown statuses and --interactive", func(t *testing.T) {
+		cfg, _ := setup(t)
+
+		err := validateAssignMoveTarget(AssignFlags{MoveTo: "nowhere"}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--move: invalid status 'nowhere'")
+
+		err = validateAssignMoveTarget(AssignFlags{MoveTo: "doing", Interactive: true}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--move cannot be used together with --interactive")
+	})
+}
+
+func TestAssignUnassignMultipleFields(t *testing.T) {
+	setup := func(t *testing.T) (*config.Config, string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		path := ".work/1_todo/001-reset.task.md"
+		content := "---\nid: \"001\"\ntitle: Reset\nstatus: todo\nkind: task\nassigned: alice@example.com\nreviewer: bob@example.com\n---\n# Reset\n"
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return testCfgWithDir(tmpDir), path
+	}
+
+	run := func(t *testing.T, cfg *config.Config, path string, flags AssignFlags) (string, []WorkItemUpdateResult, error) {
+		t.Helper()
+		flags.Unassign = true
+		flags.Field = "assigned,reviewer,approved_by"
+		flags.FieldSet = true
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		results := processWorkItemUpdates([]string{path}, nil, flags, nil, cfg)
+		err := handleAssignResults(results, []string{path}, flags, nil)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		return buf.String(), results, err
+	}
+
+	t.Run("clears each field and reports which were set", func(t *testing.T) {
+		cfg, path := setup(t)
+
+		output, results, err := run(t, cfg, path, AssignFlags{})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Unassigned work item 001: cleared assigned, reviewer (not set: approved_by)")
+		assert.Equal(t, []string{"assigned", "reviewer"}, results[0].Cleared)
+
+		frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, frontMatter, "assigned")
+		assert.NotContains(t, frontMatter, "reviewer")
+		assert.Contains(t, frontMatter, "updated")
+	})
+
+	t.Run("dry run lists the fields that would be cleared", func(t *testing.T) {
+		cfg, path := setup(t)
+
+		output, _, err := run(t, cfg, path, AssignFlags{DryRun: true})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Would unassign work item 001: cleared assigned, reviewer (not set: approved_by)")
+
+		frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", frontMatter["assigned"])
+	})
+
+	t.Run("validates each field name", func(t *testing.T) {
+		require.NoError(t, validateAssignFieldName("assigned, reviewer"))
+
+		err := validateAssignFieldName("assigned,,reviewer")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field name cannot be empty")
+
+		err = validateAssignFieldName("assigned,../reviewer")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid field name '../reviewer'")
+	})
+
+	t.Run("only allows a field list with --unassign", func(t *testing.T) {
+		err := validateAssignFlagCombinations("alice", AssignFlags{Field: "assigned,reviewer"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "comma-separated --field list can only be used with --unassign")
+	})
+}
+
+func TestAssignChangelog(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("formats a line per change", func(t *testing.T) {
+		results := []WorkItemUpdateResult{
+			{WorkItemID: "001", Success: true, Operation: "assign", Field: "assigned", User: "alice@example.com"},
+			{WorkItemID: "002", Success: true, Operation: "append", Field: "reviewers", User: "carol@example.com"},
+			{WorkItemID: "003", Success: true, Operation: "unassign", Field: "assigned", Cleared: []string{"assigned"}},
+			{WorkItemID: "007", Success: true, Operation: "unassign", Field: "assigned"},
+			{WorkItemID: "004", Success: true, Operation: "assign", Field: "assigned", User: "alice@example.com", MovedTo: "doing"},
+			{WorkItemID: "005", Success: true, Operation: opAlreadyAssigned, Field: "assigned"},
+			{WorkItemID: "006", Success: false, Operation: "assign", Error: fmt.Errorf("boom")},
+		}
+
+		assert.Equal(t, []string{
+			"2024-01-01 assign 001 -> alice@example.com by bob@example.com",
+			"2024-01-01 append 002 -> carol@example.com by bob@example.com (field: reviewers)",
+			"2024-01-01 unassign 003 by bob@example.com",
+			"2024-01-01 assign 004 -> alice@example.com by bob@example.com",
+			"2024-01-01 move 004 -> doing by bob@example.com",
+		}, assignChangelogLines(results, "bob@example.com", now))
+	})
+
+	t.Run("appends to the file, creating it when absent", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), ".work", "CHANGELOG.md")
+		results := []WorkItemUpdateResult{{WorkItemID: "001", Success: true, Operation: "assign", Field: "assigned", User: "alice@example.com"}}
+
+		require.NoError(t, appendAssignChangelog(path, results, now))
+		require.NoError(t, os.WriteFile(path, []byte(strings.TrimSuffix(mustReadFile(t, path), "\n")), 0o600))
+		require.NoError(t, appendAssignChangelog(path, results, now))
+
+		lines := strings.Split(strings.TrimSuffix(mustReadFile(t, path), "\n"), "\n")
+		require.Len(t, lines, 2)
+		for _, line := range lines {
+			assert.Regexp(t, `^2024-01-01 assign 001 -> alice@example\.com by \S+$`, line)
+		}
+	})
+
+	t.Run("uses assignment.changelog and --changelog", func(t *testing.T) {
+		cfg := &config.Config{Assignment: &config.AssignmentConfig{Changelog: true}}
+		assert.Equal(t, filepath.Join(".work", "CHANGELOG.md"), assignChangelogPath("", cfg))
+		assert.Equal(t, "audit.md", assignChangelogPath("audit.md", cfg))
+		assert.Equal(t, "", assignChangelogPath("", &config.Config{}))
+	})
+
+	t.Run("skips the changelog in dry run", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		results := []WorkItemUpdateResult{{WorkItemID: "001", Success: true, Operation: "assign", User: "alice@example.com"}}
+
+		oldStdout := os.Stdout
+		_, w, _ := os.Pipe()
+		os.Stdout = w
+		err := handleAssignResults(results, []string{"001"}, AssignFlags{DryRun: true, Changelog: path}, nil)
+		_ = w.Close()
+		os.Stdout = oldStdout
+
+		require.NoError(t, err)
+		assert.NoFileExists(t, path)
+	})
+}
+
+func TestCheckAssignTargetStatus(t *testing.T) {
+	setup := func(t *testing.T) (*config.Config, []string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.MkdirAll(".work/4_done", 0o700))
+		doing := ".work/2_doing/001-open.task.md"
+		done := ".work/4_done/002-shipped.task.md"
+		require.NoError(t, os.WriteFile(doing, []byte("---\nid: \"001\"\ntitle: Open\nstatus: doing\nkind: task\n---\n"), 0o600))
+		require.NoError(t, os.WriteFile(done, []byte("---\nid: \"002\"\ntitle: Shipped\nstatus: done\nkind: task\n---\n"), 0o600))
+		return testCfgWithDir(tmpDir), []string{doing, done}
+	}
+
+	t.Run("warns about work items in a terminal status", func(t *testing.T) {
+		cfg, paths := setup(t)
+		var out bytes.Buffer
+
+		for _, path := range paths {
+			require.NoError(t, checkAssignTargetStatus(&out, path, AssignFlags{}, cfg))
+		}
+		assert.Equal(t, "Warning: work item 002 is in terminal status done; assigning it anyway. "+
+			"To reopen it, run 'kira move 002 <status>' first or pass --move <status>\n", out.String())
+	})
+
+	t.Run("fails with --strict", func(t *testing.T) {
+		cfg, paths := setup(t)
+		var out bytes.Buffer
+
+		require.NoError(t, checkAssignTargetStatus(&out, paths[0], AssignFlags{Strict: true}, cfg))
+		err := checkAssignTargetStatus(&out, paths[1], AssignFlags{Strict: true}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item 002 is in terminal status done (--strict)")
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("does not warn when unassigning or reopening with --move", func(t *testing.T) {
+		cfg, paths := setup(t)
+		var out bytes.Buffer
+
+		require.NoError(t, checkAssignTargetStatus(&out, paths[1], AssignFlags{Unassign: true, Strict: true}, cfg))
+		require.NoError(t, checkAssignTargetStatus(&out, paths[1], AssignFlags{MoveTo: "doing", Strict: true}, cfg))
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("uses terminal_statuses from kira.yml", func(t *testing.T) {
+		cfg, paths := setup(t)
+		cfg.TerminalStatuses = []string{"doing"}
+		var out bytes.Buffer
+
+		for _, path := range paths {
+			require.NoError(t, checkAssignTargetStatus(&out, path, AssignFlags{}, cfg))
+		}
+		assert.Contains(t, out.String(), "work item 001 is in terminal status doing")
+		assert.NotContains(t, out.String(), "002")
+	})
+
+	t.Run("--strict skips terminal work items in every assign mode", func(t *testing.T) {
+		cfg, paths := setup(t)
+		user := &UserInfo{Name: "Alice", Email: "alice@example.com"}
+
+		results := processWorkItemUpdates(paths, user, AssignFlags{Strict: true, Field: "assigned"}, []UserInfo{*user}, cfg)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].Success)
+		require.Error(t, results[1].Error)
+		assert.Contains(t, results[1].Error.Error(), "work item 002 is in terminal status done (--strict)")
+
+		open, err := os.ReadFile(paths[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(open), "assigned: alice@example.com")
+		done, err := os.ReadFile(paths[1])
+		require.NoError(t, err)
+		assert.NotContains(t, string(done), "assigned:")
+	})
+}
+
+func TestAssignDue(t *testing.T) {
+	setup := func(t *testing.T, extra string) (*config.Config, string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		path := ".work/1_todo/001-review.task.md"
+		require.NoError(t, os.WriteFile(path, []byte("---\nid: \"001\"\ntitle: Review\nstatus: todo\nkind: task\n"+extra+"---\n# Review\n"), 0o600))
+		return testCfgWithDir(tmpDir), path
+	}
+	user := &UserInfo{Email: "alice@example.com", Name: "Alice"}
+	run := func(t *testing.T, cfg *config.Config, path string, flags AssignFlags) []WorkItemUpdateResult {
+		t.Helper()
+		flags.Field = "assigned"
+		oldStdout := os.Stdout
+		_, w, _ := os.Pipe()
+		os.Stdout = w
+		results := processWorkItemUpdates([]string{path}, user, flags, nil, cfg)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		return results
+	}
+	frontMatterOf := func(t *testing.T, cfg *config.Config, path string) map[string]interface{} {
+		t.Helper()
+		frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+		require.NoError(t, err)
+		return frontMatter
+	}
+
+	t.Run("sets the due field alongside the assignee", func(t *testing.T) {
+		cfg, path := setup(t, "")
+
+		results := run(t, cfg, path, AssignFlags{Due: "2024-06-30", DueSet: true})
+		require.True(t, results[0].Success)
+		frontMatter := frontMatterOf(t, cfg, path)
+		assert.Equal(t, "alice@example.com", frontMatter["assigned"])
+		due, ok := parseWorkItemTimestamp(frontMatter["due"])
+		require.True(t, ok)
+		assert.Equal(t, "2024-06-30", due.Format(dueDateLayout))
+	})
+
+	t.Run("updates the due date of an already assigned work item", func(t *testing.T) {
+		cfg, path := setup(t, "assigned: alice@example.com\ndue: 2024-01-01\n")
+
+		results := run(t, cfg, path, AssignFlags{Due: "2024-02-01", DueSet: true})
+		require.True(t, results[0].Success)
+		assert.Equal(t, opAlreadyAssigned, results[0].Operation)
+		due, ok := parseWorkItemTimestamp(frontMatterOf(t, cfg, path)["due"])
+		require.True(t, ok)
+		assert.Equal(t, "2024-02-01", due.Format(dueDateLayout))
+	})
+
+	t.Run("writes due and the note in the assignment's own write", func(t *testing.T) {
+		cfg, path := setup(t, "")
+
+		followUps := AssignFlags{Due: "2024-06-30", DueSet: true, Message: "please review", MessageSet: true}
+		changed, err := updateWorkItemField(path, "assigned", "alice@example.com", followUps, cfg)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		frontMatter := frontMatterOf(t, cfg, path)
+		assert.Equal(t, "alice@example.com", frontMatter["assigned"])
+		assert.NotNil(t, frontMatter["due"])
+		assert.Equal(t, "please review", frontMatter[assignNoteField])
+	})
+
+	t.Run("writes due in the same write as --move", func(t *testing.T) {
+		cfg, path := setup(t, "")
+
+		results := run(t, cfg, path, AssignFlags{Due: "2024-06-30", DueSet: true, MoveTo: "doing"})
+		require.True(t, results[0].Success)
+		frontMatter := frontMatterOf(t, cfg, ".work/2_doing/001-review.task.md")
+		assert.Equal(t, "doing", frontMatter["status"])
+		assert.NotNil(t, frontMatter["due"])
+	})
+
+	t.Run("clears due with an empty --due", func(t *testing.T) {
+		cfg, path := setup(t, "assigned: alice@example.com\ndue: 2024-01-01\n")
+
+		results := run(t, cfg, path, AssignFlags{Due: "", DueSet: true})
+		require.True(t, results[0].Success)
+		assert.NotContains(t, frontMatterOf(t, cfg, path), "due")
+	})
+
+	t.Run("clears due when unassigning", func(t *testing.T) {
+		cfg, path := setup(t, "assigned: alice@example.com\ndue: 2024-01-01\n")
+
+		results := run(t, cfg, path, AssignFlags{Unassign: true})
+		require.True(t, results[0].Success)
+		assert.Equal(t, []string{"assigned", "due"}, results[0].Cleared)
+		frontMatter := frontMatterOf(t, cfg, path)
+		assert.NotContains(t, frontMatter, "due")
+		assert.NotContains(t, frontMatter, "assigned")
+	})
+
+	t.Run("validates the date", func(t *testing.T) {
+		assert.NoError(t, validateAssignDue(AssignFlags{Due: "2024-06-30", DueSet: true}))
+		assert.NoError(t, validateAssignDue(AssignFlags{Due: "", DueSet: true}))
+		err := validateAssignDue(AssignFlags{Due: "next friday", DueSet: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --due 'next friday'")
+		assert.Error(t, validateAssignDue(AssignFlags{Due: "2024-06-30", DueSet: true, Unassign: true}))
+	})
+}
+
+func TestAssignMessage(t *testing.T) {
+	setup := func(t *testing.T, extra string) (*config.Config, string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		path := ".work/1_todo/001-review.task.md"
+		require.NoError(t, os.WriteFile(path, []byte("---\nid: \"001\"\ntitle: Review\nstatus: todo\nkind: task\n"+extra+"---\n# Review\n"), 0o600))
+		return testCfgWithDir(tmpDir), path
+	}
+	user := &UserInfo{Email: "alice@example.com", Name: "Alice"}
+	run := func(t *testing.T, cfg *config.Config, path string, flags AssignFlags) []WorkItemUpdateResult {
+		t.Helper()
+		flags.Field = "assigned"
+		var updated []WorkItemUpdateResult
+		_, err := captureStdout(func() error {
+			updated = processWorkItemUpdates([]string{path}, user, flags, nil, cfg)
+			return nil
+		})
+		require.NoError(t, err)
+		return updated
+	}
+
+	t.Run("records the note alongside the assignee", func(t *testing.T) {
+		cfg, path := setup(t, "assigned: bob@example.com\n")
+
+		results := run(t, cfg, path, AssignFlags{Message: sanitizeAssignNote("Bob is on leave:\n  handing over"), MessageSet: true})
+		require.True(t, results[0].Success)
+		assert.Equal(t, "Bob is on leave: handing over", results[0].Note)
+		content := mustReadFile(t, path)
+		assert.Contains(t, content, "assigned: alice@example.com\n")
+		assert.Contains(t, content, "assign_note: \"Bob is on leave: handing over\"\n")
+		frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "Bob is on leave: handing over", frontMatter[assignNoteField])
+	})
+
+	t.Run("attaches the note to the changelog entry", func(t *testing.T) {
+		cfg, path := setup(t, "")
+		changelog := filepath.Join(t.TempDir(), "CHANGELOG.md")
+
+		results := run(t, cfg, path, AssignFlags{Message: "picking up the API part", MessageSet: true})
+		require.NoError(t, appendAssignChangelog(changelog, results, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Regexp(t, `^2024-01-01 assign 001 -> alice@example\.com by \S+ note: "picking up the API part"\n$`, mustReadFile(t, changelog))
+	})
+
+	t.Run("clears the note with an empty --message or --unassign", func(t *testing.T) {
+		cfg, path := setup(t, "assigned: alice@example.com\nassign_note: old reason\n")
+
+		results := run(t, cfg, path, AssignFlags{Message: "", MessageSet: true})
+		require.True(t, results[0].Success)
+		assert.NotContains(t, mustReadFile(t, path), assignNoteField)
+
+		cfg, path = setup(t, "assigned: alice@example.com\nassign_note: old reason\n")
+		results = run(t, cfg, path, AssignFlags{Unassign: true})
+		require.True(t, results[0].Success)
+		assert.Equal(t, []string{"assigned", assignNoteField}, results[0].Cleared)
+		assert.NotContains(t, mustReadFile(t, path), assignNoteField)
+	})
+
+	t.Run("sanitizes the note and rejects it with --unassign", func(t *testing.T) {
+		assert.Equal(t, "a b c", sanitizeAssignNote(" a\r\n\tb \x00 c\n"))
+		assert.Equal(t, "", sanitizeAssignNote("\n\n"))
+		assert.Error(t, validateAssignNote(AssignFlags{Message: "bye", MessageSet: true, Unassign: true}))
+		assert.NoError(t, validateAssignNote(AssignFlags{Message: "", MessageSet: true, Unassign: true}))
+	})
+}
+
+func mustReadFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path) // #nosec G304 - test file in a temp dir
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestAssignValueTransforms(t *testing.T) {
+	stripPlusTag := []config.ValueTransformConfig{{Pattern: `\+[^@]*@`, Replace: "@"}}
+
+	t.Run("regex transform rewrites the value", func(t *testing.T) {
+		cfg := &config.Config{Assignment: &config.AssignmentConfig{ValueTransforms: stripPlusTag}}
+
+		value, err := transformAssigneeValue("alice+review@gmail.com", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@gmail.com", value)
+
+		value, err = transformAssigneeValue("bob@gmail.com", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "bob@gmail.com", value)
+	})
+
+	t.Run("transforms apply in order and support capture groups", func(t *testing.T) {
+		cfg := &config.Config{Assignment: &config.AssignmentConfig{ValueTransforms: []config.ValueTransformConfig{
+			{Pattern: `\+[^@]*@`, Replace: "@"},
+			{Pattern: `^(.*)@googlemail\.com$`, Replace: "${1}@gmail.com"},
+		}}}
+
+		value, err := transformAssigneeValue("alice+x@googlemail.com", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@gmail.com", value)
+	})
+
+	t.Run("values are unchanged without transforms", func(t *testing.T) {
+		value, err := transformAssigneeValue("alice+review@gmail.com", &config.Config{})
+		require.NoError(t, err)
+		assert.Equal(t, "alice+review@gmail.com", value)
+	})
+
+	t.Run("a transform leaving an empty value is an error", func(t *testing.T) {
+		cfg := &config.Config{Assignment: &config.AssignmentConfig{ValueTransforms: []config.ValueTransformConfig{{Pattern: `.*`}}}}
+
+		_, err := transformAssigneeValue("alice@gmail.com", cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "turned 'alice@gmail.com' into an empty value")
+	})
+
+	t.Run("assign writes the transformed value and fails items it cannot transform", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(testWorkItemContentWithAssigned), 0o600))
+
+		cfg := testCfgWithDir(tmpDir)
+		cfg.Assignment = &config.AssignmentConfig{ValueTransforms: stripPlusTag}
+		user := &UserInfo{Email: "alice+review@gmail.com", Name: "Alice", Number: 1}
+
+		results := processWorkItemUpdates([]string{testFilePathPhase5}, user, AssignFlags{Field: "reviewer"}, nil, cfg)
+		require.Len(t, results, 1)
+		require.NoError(t, results[0].Error)
+		assert.True(t, results[0].Success)
+		content := mustReadFile(t, testFilePathPhase5)
+		assert.Contains(t, content, "reviewer: alice@gmail.com")
+		assert.NotContains(t, content, "alice+review")
+
+		cfg.Assignment.ValueTransforms = []config.ValueTransformConfig{{Pattern: `.*`}}
+		results = processWorkItemUpdates([]string{testFilePathPhase5}, user, AssignFlags{Field: "approver"}, nil, cfg)
+		require.Len(t, results, 1)
+		assert.False(t, results[0].Success)
+		require.Error(t, results[0].Error)
+		assert.Contains(t, results[0].Error.Error(), "work item 001: assignment.value_transforms turned")
+		assert.NotContains(t, mustReadFile(t, testFilePathPhase5), "approver:")
+	})
+}
+
+func TestAssignRoundRobin(t *testing.T) {
+	const roundRobinConfig = `version: "1.0"
+users:
+  use_git_history: false
+  saved_users:
+    - email: alice@example.com
+      name: Alice
+    - email: bob@example.com
+      name: Bob
+assignment:
+  teams:
+    backend:
+      members: [alice@example.com, bob@example.com]
+      cursor: 1 # next member
+`
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-a.task.md": listTestWorkItem("001", "A", "todo", ""),
+			"1_todo/002-b.task.md": listTestWorkItem("002", "B", "todo", ""),
+			"1_todo/003-c.task.md": listTestWorkItem("003", "C", "todo", ""),
+		})
+		require.NoError(t, os.WriteFile("kira.yml", []byte(roundRobinConfig), 0o600))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		return cfg
+	}
+	flags := AssignFlags{Field: "assigned", RoundRobin: "backend", AssigneeDisplay: config.AssigneeDisplayEmail}
+
+	t.Run("assigns in turn from the cursor and saves the next cursor", func(t *testing.T) {
+		cfg := setup(t)
+
+		out, err := captureStdout(func() error {
+			return runAssignRoundRobin([]string{"001", "002", "003"}, flags, cfg)
+		})
 		require.NoError(t, err)
-		assert.Equal(t, "user@example.com", assignment)
-	})
 
-	t.Run("returns empty string when field does not exist", func(t *testing.T) {
-		workItemContent := `---
-id: "002"
-title: Test Feature
-status: todo
-kind: prd
-created: 2024-01-01
----
+		assert.Contains(t, out, "Assigning round-robin for team backend:\n  001 -> bob@example.com\n  002 -> alice@example.com\n  003 -> bob@example.com\n")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/001-a.task.md"), "assigned: bob@example.com")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/002-b.task.md"), "assigned: alice@example.com")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/003-c.task.md"), "assigned: bob@example.com")
+		kiraYML := mustReadFile(t, "kira.yml")
+		assert.Contains(t, kiraYML, "cursor: 0 # next member")
 
-# Test Feature
-`
-		filePath := ".work/1_todo/002-test.prd.md"
-		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+		reloaded, err := config.LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, 0, reloaded.Assignment.Teams["backend"].Cursor)
+	})
 
-		cfg := testCfgWithDir(tmpDir)
-		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
+	t.Run("dry-run shows the planned distribution and keeps the cursor", func(t *testing.T) {
+		cfg := setup(t)
+		dryRunFlags := flags
+		dryRunFlags.DryRun = true
+
+		out, err := captureStdout(func() error {
+			return runAssignRoundRobin([]string{"001", "002"}, dryRunFlags, cfg)
+		})
 		require.NoError(t, err)
-		assert.Empty(t, assignment)
+
+		assert.Contains(t, out, "[DRY RUN] Would assign round-robin for team backend:\n  001 -> bob@example.com\n  002 -> alice@example.com\n")
+		assert.NotContains(t, mustReadFile(t, ".work/1_todo/001-a.task.md"), "assigned:")
+		assert.Contains(t, mustReadFile(t, "kira.yml"), "cursor: 1 # next member")
 	})
 
-	t.Run("returns empty string when field is empty", func(t *testing.T) {
-		workItemContent := `---
-id: "003"
-title: Test Feature
-status: todo
-kind: prd
-assigned: ""
-created: 2024-01-01
----
+	t.Run("unknown team lists the configured teams", func(t *testing.T) {
+		cfg := setup(t)
 
-# Test Feature
-`
-		filePath := ".work/1_todo/003-test.prd.md"
-		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+		err := runAssignRoundRobin([]string{"001"}, AssignFlags{Field: "assigned", RoundRobin: "frontend"}, cfg)
 
-		cfg := testCfgWithDir(tmpDir)
-		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
-		require.NoError(t, err)
-		assert.Empty(t, assignment)
+		require.EqualError(t, err, "team 'frontend' not found in assignment.teams (available: backend)")
 	})
 
-	t.Run("works with custom field", func(t *testing.T) {
-		workItemContent := `---
-id: 004
-title: Test Feature
-status: todo
-kind: prd
-reviewer: reviewer@example.com
-created: 2024-01-01
----
+	t.Run("rotation wraps around and tolerates a cursor past the end", func(t *testing.T) {
+		alice, bob := &UserInfo{Email: "alice@example.com"}, &UserInfo{Email: "bob@example.com"}
 
-# Test Feature
-`
-		filePath := ".work/1_todo/004-test.prd.md"
-		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+		assignees := roundRobinAssignees(3, []*UserInfo{alice, bob}, 5)
 
-		cfg := testCfgWithDir(tmpDir)
-		assignment, err := getCurrentAssignment(filePath, "reviewer", cfg)
-		require.NoError(t, err)
-		assert.Equal(t, "reviewer@example.com", assignment)
+		assert.Equal(t, []*UserInfo{bob, alice, bob}, assignees)
 	})
 
-	t.Run("handles array field values", func(t *testing.T) {
-		workItemContent := `---
-id: 005
-title: Test Feature
-status: todo
-kind: prd
-assigned: [user1@example.com, user2@example.com]
-created: 2024-01-01
----
+	t.Run("only assigned work items advance the cursor", func(t *testing.T) {
+		cfg := setup(t)
+		require.NoError(t, os.WriteFile(".work/1_todo/002-b.task.md", []byte("---\nid: 002\ntitle: [B\n---\n"), 0o600))
 
-# Test Feature
-`
-		filePath := ".work/1_todo/005-test.prd.md"
-		require.NoError(t, os.WriteFile(filePath, []byte(workItemContent), 0o600))
+		_, err := captureStdout(func() error {
+			return runAssignRoundRobin([]string{"001", "002", "003"}, flags, cfg)
+		})
+		require.Error(t, err)
 
-		cfg := testCfgWithDir(tmpDir)
-		assignment, err := getCurrentAssignment(filePath, "assigned", cfg)
-		require.NoError(t, err)
-		assert.Contains(t, assignment, "user1@example.com")
-		assert.Contains(t, assignment, "user2@example.com")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/001-a.task.md"), "assigned: bob@example.com")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/003-c.task.md"), "assigned: bob@example.com")
+		assert.Contains(t, mustReadFile(t, "kira.yml"), "cursor: 1 # next member")
 	})
 
-	t.Run("returns error for invalid file path", func(t *testing.T) {
-		_, err := getCurrentAssignment("nonexistent.md", "assigned", &config.DefaultConfig)
+	t.Run("rejects flags that choose users or work items differently", func(t *testing.T) {
+		err := validateRoundRobinFlags(AssignFlags{RoundRobin: "backend", Unassign: true})
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to parse work item")
+		assert.Contains(t, err.Error(), "--round-robin cannot be used with --unassign")
 	})
 }
 
-func TestShowInteractiveSelection(t *testing.T) {
-	users := []UserInfo{
-		{Email: "user1@example.com", Name: "User One", Number: 1},
-		{Email: "user2@example.com", Name: "User Two", Number: 2},
-		{Email: "user3@example.com", Name: "", Number: 3},
+func TestRichAssignee(t *testing.T) {
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(testFilePathPhase5, []byte(testWorkItemContentPhase5), 0o600))
+		cfg := testCfgWithDir(tmpDir)
+		cfg.Assignment = &config.AssignmentConfig{RichAssignee: true}
+		return cfg
 	}
 
-	t.Run("displays users in correct format", func(t *testing.T) {
-		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("writes an assignee object that reads back as the email", func(t *testing.T) {
+		cfg := setup(t)
 
-		// Create input with valid selection
-		input := strings.NewReader("1\n")
+		changed, err := updateWorkItemField(testFilePathPhase5, "assigned", "alice@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.True(t, changed)
 
-		// Run function in goroutine to avoid blocking
-		done := make(chan struct{})
-		var selection int
-		var err error
-		go func() {
-			selection, err = showInteractiveSelection(users, "", "assigned", input)
-			close(done)
-		}()
+		content := mustReadFile(t, testFilePathPhase5)
+		assert.Contains(t, content, "assigned:\n    email: alice@example.com\n    since: ")
+		current, err := getCurrentAssignment(testFilePathPhase5, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", current)
 
-		// Wait a bit for output
-		time.Sleep(100 * time.Millisecond)
-		_ = w.Close()
-		os.Stdout = oldStdout
+		changed, err = updateWorkItemField(testFilePathPhase5, "assigned", "alice@example.com", AssignFlags{}, cfg)
+		require.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, content, mustReadFile(t, testFilePathPhase5))
+	})
 
-		// Read output
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+	t.Run("appends assignee objects and dedups them by email", func(t *testing.T) {
+		cfg := setup(t)
 
-		// Wait for function to complete
-		<-done
+		for _, email := range []string{"alice@example.com", "bob@example.com", "alice@example.com"} {
+			_, err := updateWorkItemFieldAppend(testFilePathPhase5, "assigned", email, false, AssignFlags{}, cfg)
+			require.NoError(t, err)
+		}
 
+		frontMatter, _, err := parseWorkItemFrontMatter(testFilePathPhase5, cfg)
 		require.NoError(t, err)
-		assert.Equal(t, 1, selection)
-		assert.Contains(t, output, "Available users:")
-		assert.Contains(t, output, "1. User One <user1@example.com>")
-		assert.Contains(t, output, "2. User Two <user2@example.com>")
-		assert.Contains(t, output, "3. user3@example.com")
-		assert.Contains(t, output, "0. Unassign")
+		items, ok := frontMatter["assigned"].([]interface{})
+		require.True(t, ok, "assigned should be a list, got %T", frontMatter["assigned"])
+		require.Len(t, items, 2)
+		for i, email := range []string{"alice@example.com", "bob@example.com"} {
+			object, ok := items[i].(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, email, object["email"])
+			assert.NotEmpty(t, object["since"])
+		}
+		current, err := getCurrentAssignment(testFilePathPhase5, "assigned", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com, bob@example.com", current)
 	})
 
-	t.Run("shows current assignment when provided", func(t *testing.T) {
-		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("treats an object and a plain email of the same user as duplicates", func(t *testing.T) {
+		frontMatter := map[string]interface{}{
+			"assigned": []interface{}{map[string]interface{}{"email": "alice@example.com", "since": "2024-06-30T09:00:00Z"}},
+		}
 
-		input := strings.NewReader("2\n")
+		appendToField(frontMatter, "assigned", "alice@example.com")
 
-		done := make(chan struct{})
-		var selection int
-		var err error
-		go func() {
-			selection, err = showInteractiveSelection(users, "current@example.com", "assigned", input)
-			close(done)
-		}()
+		assert.Equal(t, []interface{}{map[string]interface{}{"email": "alice@example.com", "since": "2024-06-30T09:00:00Z"}}, frontMatter["assigned"])
+	})
 
-		time.Sleep(100 * time.Millisecond)
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("reads assignee objects without rich_assignee", func(t *testing.T) {
+		frontMatter := map[string]interface{}{"assigned": map[string]interface{}{"email": "alice@example.com", "since": "2024-06-30"}}
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		value, ok := getFieldValueAsString(frontMatter, "assigned")
+		assert.True(t, ok)
+		assert.Equal(t, "alice@example.com", value)
+	})
+}
 
-		<-done
+func TestAssignUnassignIfAssignee(t *testing.T) {
+	const ifAssigneeConfig = `version: "1.0"
+users:
+  use_git_history: false
+  saved_users:
+    - email: alice@example.com
+      name: Alice
+    - email: bob@example.com
+      name: Bob
+`
+	setup := func(t *testing.T) (*config.Config, []UserInfo) {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-a.task.md": listTestWorkItem("001", "A", "todo", "assigned: alice@example.com\ndue: 2024-06-30\n"),
+			"1_todo/002-b.task.md": listTestWorkItem("002", "B", "todo", "assigned: [bob@example.com, alice@example.com]\n"),
+			"1_todo/003-c.task.md": listTestWorkItem("003", "C", "todo", "assigned: bob@example.com\ndue: 2024-06-30\n"),
+		})
+		require.NoError(t, os.WriteFile("kira.yml", []byte(ifAssigneeConfig), 0o600))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		users, err := collectUsersForAssignment(cfg)
+		require.NoError(t, err)
+		return cfg, users
+	}
+	paths := []string{".work/1_todo/001-a.task.md", ".work/1_todo/002-b.task.md", ".work/1_todo/003-c.task.md"}
+	ifAssigneeFlags := func(t *testing.T, users []UserInfo) AssignFlags {
+		t.Helper()
+		flags := AssignFlags{Field: "assigned", Unassign: true, IfAssignee: "alice@example.com", AssigneeDisplay: config.AssigneeDisplayEmail}
+		_, err := resolveAssignUsers("", &flags, users)
+		require.NoError(t, err)
+		return flags
+	}
 
+	t.Run("removes only the person and skips other work items", func(t *testing.T) {
+		cfg, users := setup(t)
+		flags := ifAssigneeFlags(t, users)
+
+		out, err := captureStdout(func() error {
+			return handleAssignResults(processWorkItemUpdates(paths, nil, flags, users, cfg), paths, flags, nil)
+		})
 		require.NoError(t, err)
-		assert.Equal(t, 2, selection)
-		assert.Contains(t, output, "Current assignment (assigned): current@example.com")
+
+		first := mustReadFile(t, paths[0])
+		assert.NotContains(t, first, "assigned:")
+		assert.NotContains(t, first, "due:")
+		assert.Contains(t, mustReadFile(t, paths[1]), "assigned: [bob@example.com]")
+		third := mustReadFile(t, paths[2])
+		assert.Contains(t, third, "assigned: bob@example.com")
+		assert.Contains(t, third, "due: 2024-06-30")
+		assert.Contains(t, out, "Work item 003: skipped: not assigned to alice@example.com")
+		assert.Contains(t, out, "Summary: 2 cleared, 1 skipped, 0 failed")
 	})
 
-	t.Run("returns unassign selection (0)", func(t *testing.T) {
-		oldStdout := os.Stdout
-		_, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("dry-run reports what would be removed and skipped", func(t *testing.T) {
+		cfg, users := setup(t)
+		flags := ifAssigneeFlags(t, users)
+		flags.DryRun = true
 
-		input := strings.NewReader("0\n")
+		out, err := captureStdout(func() error {
+			return handleAssignResults(processWorkItemUpdates(paths, nil, flags, users, cfg), paths, flags, nil)
+		})
+		require.NoError(t, err)
 
-		done := make(chan struct{})
-		var selection int
-		var err error
-		go func() {
-			selection, err = showInteractiveSelection(users, "", "assigned", input)
-			close(done)
-		}()
+		assert.Contains(t, out, "Would remove alice@example.com from work item 001 (field: assigned)")
+		assert.Contains(t, out, "Would skip work item 003: not assigned to alice@example.com")
+		assert.Contains(t, out, "Summary: 2 cleared, 1 skipped, 0 failed")
+		assert.Contains(t, mustReadFile(t, paths[0]), "assigned: alice@example.com")
+	})
 
-		time.Sleep(100 * time.Millisecond)
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("requires --unassign", func(t *testing.T) {
+		err := validateIfAssigneeFlags(AssignFlags{Field: "assigned", IfAssignee: "alice@example.com"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--if-assignee requires --unassign")
+	})
+}
 
-		<-done
+func TestAssignSwap(t *testing.T) {
+	const swapConfig = `version: "1.0"
+users:
+  use_git_history: false
+  saved_users:
+    - email: alice@example.com
+      name: Alice
+    - email: bob@example.com
+      name: Bob
+    - email: carol@example.com
+      name: Carol
+`
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-a.task.md":  listTestWorkItem("001", "A", "todo", "assigned: alice@example.com\n"),
+			"1_todo/002-b.task.md":  listTestWorkItem("002", "B", "todo", "assigned: [bob@example.com, alice@example.com]\n"),
+			"1_todo/003-c.task.md":  listTestWorkItem("003", "C", "todo", "assigned: [alice@example.com, carol@example.com]\n"),
+			"2_doing/004-d.task.md": listTestWorkItem("004", "D", "doing", "assigned: alice@example.com\n"),
+			"2_doing/005-e.task.md": listTestWorkItem("005", "E", "doing", "assigned: carol@example.com\n"),
+			"4_done/006-f.task.md":  listTestWorkItem("006", "F", "done", "assigned: alice@example.com\n"),
+		})
+		require.NoError(t, os.WriteFile("kira.yml", []byte(swapConfig), 0o600))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		return cfg
+	}
+	swapFlags := AssignFlags{Field: "assigned", Swap: true, AssigneeDisplay: config.AssigneeDisplayEmail}
+
+	t.Run("replaces a single assignee and substitutes within lists without duplicates", func(t *testing.T) {
+		cfg := setup(t)
 
+		out, err := captureStdout(func() error {
+			return runAssignSwap([]string{"alice@example.com", "Bob"}, swapFlags, cfg)
+		})
 		require.NoError(t, err)
-		assert.Equal(t, 0, selection)
+
+		first := mustReadFile(t, ".work/1_todo/001-a.task.md")
+		assert.Contains(t, first, "assigned: bob@example.com")
+		assert.Contains(t, first, "updated:")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/002-b.task.md"), "assigned: [bob@example.com]")
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/003-c.task.md"), "assigned: [bob@example.com, carol@example.com]")
+		assert.Contains(t, mustReadFile(t, ".work/2_doing/004-d.task.md"), "assigned: bob@example.com")
+		fifth := mustReadFile(t, ".work/2_doing/005-e.task.md")
+		assert.Contains(t, fifth, "assigned: carol@example.com")
+		assert.NotContains(t, fifth, "updated:")
+		assert.Contains(t, out, "Swapped alice@example.com -> bob@example.com in 4 work item(s)")
 	})
 
-	t.Run("handles invalid input and retries", func(t *testing.T) {
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("only changes work items in --status", func(t *testing.T) {
+		cfg := setup(t)
+		flags := swapFlags
+		flags.PickStatus = "doing"
 
-		// First invalid input, then valid
-		input := strings.NewReader("invalid\n1\n")
+		out, err := captureStdout(func() error {
+			return runAssignSwap([]string{"alice@example.com", "bob@example.com"}, flags, cfg)
+		})
+		require.NoError(t, err)
 
-		done := make(chan struct{})
-		var selection int
-		var err error
-		go func() {
-			selection, err = showInteractiveSelection(users, "", "assigned", input)
-			close(done)
-		}()
+		assert.Contains(t, mustReadFile(t, ".work/1_todo/001-a.task.md"), "assigned: alice@example.com")
+		assert.Contains(t, mustReadFile(t, ".work/2_doing/004-d.task.md"), "assigned: bob@example.com")
+		assert.Contains(t, out, "in 1 work item(s)")
+	})
 
-		time.Sleep(200 * time.Millisecond)
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("leaves work items in a terminal status alone unless --status names it", func(t *testing.T) {
+		cfg := setup(t)
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		_, err := captureStdout(func() error {
+			return runAssignSwap([]string{"alice@example.com", "bob@example.com"}, swapFlags, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, mustReadFile(t, ".work/4_done/006-f.task.md"), "assigned: alice@example.com")
 
-		<-done
+		flags := swapFlags
+		flags.PickStatus = "done"
+		out, err := captureStdout(func() error {
+			return runAssignSwap([]string{"alice@example.com", "bob@example.com"}, flags, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, mustReadFile(t, ".work/4_done/006-f.task.md"), "assigned: bob@example.com")
+		assert.Contains(t, out, "in 1 work item(s)")
+	})
+
+	t.Run("dry-run lists the affected work items and writes nothing", func(t *testing.T) {
+		cfg := setup(t)
+		flags := swapFlags
+		flags.DryRun = true
+		before := mustReadFile(t, ".work/1_todo/003-c.task.md")
 
+		out, err := captureStdout(func() error {
+			return runAssignSwap([]string{"alice@example.com", "carol@example.com"}, flags, cfg)
+		})
 		require.NoError(t, err)
-		assert.Equal(t, 1, selection)
-		assert.Contains(t, output, "Invalid input")
+
+		assert.Equal(t, before, mustReadFile(t, ".work/1_todo/003-c.task.md"))
+		assert.Contains(t, out, "Would swap alice@example.com -> carol@example.com in work item 003 (field: assigned)")
+		assert.NotContains(t, out, "work item 005")
+		assert.Contains(t, out, "Would swap alice@example.com -> carol@example.com in 4 work item(s)")
 	})
 
-	t.Run("handles out of range selection", func(t *testing.T) {
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+	t.Run("resolves both users before writing anything", func(t *testing.T) {
+		cfg := setup(t)
+		before := mustReadFile(t, ".work/1_todo/001-a.task.md")
 
-		// Out of range, then valid
-		input := strings.NewReader("99\n1\n")
+		err := runAssignSwap([]string{"alice@example.com", "nobody"}, swapFlags, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--swap")
+		assert.Equal(t, before, mustReadFile(t, ".work/1_todo/001-a.task.md"))
 
-		done := make(chan struct{})
-		var selection int
-		var err error
-		go func() {
-			selection, err = showInteractiveSelection(users, "", "assigned", input)
-			close(done)
-		}()
+		err = runAssignSwap([]string{"alice@example.com", "Alice"}, swapFlags, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "are the same user")
+	})
 
-		time.Sleep(200 * time.Millisecond)
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("writes the changed work items as JSON with --output json", func(t *testing.T) {
+		cfg := setup(t)
+		flags := swapFlags
+		flags.JSON = true
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		out, err := captureStdout(func() error {
+			return runAssignSwap([]string{"carol@example.com", "bob@example.com"}, flags, cfg)
+		})
+		require.NoError(t, err)
 
-		<-done
+		var parsed []map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(out), &parsed), out)
+		require.Len(t, parsed, 2)
+		assert.Equal(t, "003", parsed[0]["work_item_id"])
+		assert.Equal(t, "swap", parsed[0]["operation"])
+		assert.Equal(t, true, parsed[0]["success"])
+		path, _ := parsed[0]["path"].(string)
+		assert.True(t, filepath.IsAbs(path), path)
+		assert.Nil(t, parsed[0]["error"])
+		assert.Equal(t, "005", parsed[1]["work_item_id"])
+	})
+
+	t.Run("rejects flags that choose work items or users another way", func(t *testing.T) {
+		flags := swapFlags
+		flags.Append = true
+		assert.EqualError(t, validateSwapFlags(flags), "invalid flag combination: --swap cannot be used with --append")
+
+		flags = swapFlags
+		flags.Field = "assigned,reviewer"
+		assert.EqualError(t, validateSwapFlags(flags), "--swap works on a single --field, got assigned,reviewer")
+	})
+}
 
+func TestAssignOutputFlag(t *testing.T) {
+	setOutput := func(t *testing.T, value string) {
+		t.Helper()
+		require.NoError(t, assignCmd.Flags().Set("output", value))
+		t.Cleanup(func() {
+			flag := assignCmd.Flags().Lookup("output")
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		})
+	}
+
+	t.Run("--output json selects JSON results", func(t *testing.T) {
+		setOutput(t, "json")
+
+		flags, err := parseAssignFlags(assignCmd)
 		require.NoError(t, err)
-		assert.Equal(t, 1, selection)
-		assert.Contains(t, output, "Invalid selection")
+		assert.True(t, flags.JSON)
 	})
 
-	t.Run("returns error for empty users list", func(t *testing.T) {
-		_, err := showInteractiveSelection([]UserInfo{}, "", "assigned", strings.NewReader("1\n"))
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "no users available")
+	t.Run("--output text keeps human-readable output", func(t *testing.T) {
+		setOutput(t, "text")
+
+		flags, err := parseAssignFlags(assignCmd)
+		require.NoError(t, err)
+		assert.False(t, flags.JSON)
 	})
 
-	t.Run("returns error after too many invalid attempts", func(t *testing.T) {
-		// Provide 3 invalid inputs
-		input := strings.NewReader("invalid1\ninvalid2\ninvalid3\n")
+	t.Run("rejects an unknown output format", func(t *testing.T) {
+		setOutput(t, "yaml")
 
-		_, err := showInteractiveSelection(users, "", "assigned", input)
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "too many invalid input attempts")
+		_, err := parseAssignFlags(assignCmd)
+		require.EqualError(t, err, `invalid output format "yaml": use text or json`)
 	})
 }
@@ -0,0 +1,63 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// transformAssignee returns a copy of user whose email has assignment.value_transforms applied,
+// since the email is the value written to the field. A nil user (unassign, interactive) is
+// returned as is.
+func transformAssignee(user *UserInfo, cfg *config.Config) (*UserInfo, error) {
+	if user == nil {
+		return nil, nil
+	}
+	email, err := transformAssigneeValue(user.Email, cfg)
+	if err != nil {
+		return nil, err
+	}
+	transformed := *user
+	transformed.Email = email
+	return &transformed, nil
+}
+
+// transformAssigneeValue applies each assignment.value_transforms regex replacement to value in
+// order, e.g. pattern '\+[^@]*@' with replace '@' turns alice+review@gmail.com into
+// alice@gmail.com. Without transforms value is returned unchanged. A transform that leaves
+// nothing is an error.
+func transformAssigneeValue(value string, cfg *config.Config) (string, error) {
+	if cfg == nil || cfg.Assignment == nil || len(cfg.Assignment.ValueTransforms) == 0 {
+		return value, nil
+	}
+	transformed := value
+	for i, transform := range cfg.Assignment.ValueTransforms {
+		re, err := regexp.Compile(transform.Pattern)
+		if err != nil {
+			return "", fmt.Errorf("assignment.value_transforms[%d]: invalid pattern '%s': %w", i, transform.Pattern, err)
+		}
+		transformed = re.ReplaceAllString(transformed, transform.Replace)
+	}
+	if strings.TrimSpace(transformed) == "" {
+		return "", fmt.Errorf("assignment.value_transforms turned '%s' into an empty value", value)
+	}
+	return transformed, nil
+}
+
+// transformFailedResult is the result of a work item whose assignee could not be transformed;
+// the work item is left unchanged.
+func transformFailedResult(workItemPath, displayID string, err error, showProgress bool) WorkItemUpdateResult {
+	result := WorkItemUpdateResult{
+		WorkItemPath: workItemPath,
+		WorkItemID:   displayID,
+		Operation:    "assign",
+		Error:        fmt.Errorf("work item %s: %w", displayID, err),
+	}
+	if showProgress {
+		displayWorkItemProgress(result)
+	}
+	return result
+}
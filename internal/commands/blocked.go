@@ -0,0 +1,168 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+// blockedByField is the front matter field listing the work items a work item waits for.
+const blockedByField = "blocked_by"
+
+var blockedCmd = &cobra.Command{
+	Use:   "blocked",
+	Short: "List work items waiting for other work items",
+	Long: `Lists the open work items whose blocked_by field names a work item that is not yet
+in a terminal status (terminal_statuses, default done, released and abandoned).
+
+blocked_by holds work item IDs, as a list (blocked_by: [003, 004]) or a single value.
+A reference to an ID no work item has is a dangling dependency: the work item is listed
+as blocked and the reference is reported, since nothing can ever unblock it.
+Work items in terminal or archived statuses are not checked. Read-only.
+
+Examples:
+  kira blocked                   # Blocked work items and what blocks them
+  kira blocked --show-blockers   # Also the title and status of each blocker
+  kira blocked --json`,
+	Args: cobra.NoArgs,
+	RunE: runBlocked,
+}
+
+func init() {
+	blockedCmd.Flags().Bool("json", false, "Output as JSON")
+	blockedCmd.Flags().Bool("show-blockers", false, "List each blocker with its title and status under the blocked work item")
+}
+
+// blockedWorkItem is an open work item with the blockers that keep it blocked.
+type blockedWorkItem struct {
+	ID       string            `json:"id"`
+	Title    string            `json:"title"`
+	Status   string            `json:"status"`
+	Path     string            `json:"path"`
+	Blockers []relatedWorkItem `json:"blocked_by"`
+}
+
+func runBlocked(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	showBlockers, _ := cmd.Flags().GetBool("show-blockers")
+
+	blocked, err := collectBlockedWorkItems(cfg)
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		return displayBlockedWorkItemsJSON(os.Stdout, blocked)
+	}
+	displayBlockedWorkItems(os.Stdout, blocked, showBlockers)
+	return nil
+}
+
+// collectBlockedWorkItems returns the open work items with at least one blocker that is open or
+// dangling, in kira list order.
+func collectBlockedWorkItems(cfg *config.Config) ([]blockedWorkItem, error) {
+	items, err := collectListedWorkItems(cfg, "", false)
+	if err != nil {
+		return nil, err
+	}
+	var blocked []blockedWorkItem
+	for _, item := range items {
+		if !isOpenStatus(item.Status, cfg) {
+			continue
+		}
+		ids := blockedByIDs(item.FrontMatter[blockedByField])
+		if len(ids) == 0 {
+			continue
+		}
+		var blockers []relatedWorkItem
+		for _, blocker := range resolveRelatedWorkItems(ids, cfg) {
+			if blocker.Error != "" || isOpenStatus(blocker.Status, cfg) {
+				blockers = append(blockers, blocker)
+			}
+		}
+		if len(blockers) > 0 {
+			blocked = append(blocked, blockedWorkItem{ID: item.ID, Title: item.Title, Status: item.Status, Path: item.Path, Blockers: blockers})
+		}
+	}
+	return blocked, nil
+}
+
+// blockedByIDs returns the IDs in a blocked_by value, a list or a single ID. Numeric IDs get
+// their zero padding back (blocked_by: [003] parses as the number 3).
+func blockedByIDs(value interface{}) []string {
+	var items []interface{}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		items = v
+	default:
+		items = []interface{}{v}
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	for _, item := range items {
+		id := strings.TrimSpace(frontMatterIDString(item))
+		if id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// displayBlockedWorkItems prints one line per blocked work item with the IDs blocking it;
+// with showBlockers, each blocker follows on its own line with its title and status.
+func displayBlockedWorkItems(out io.Writer, blocked []blockedWorkItem, showBlockers bool) {
+	if len(blocked) == 0 {
+		_, _ = fmt.Fprintln(out, "No blocked work items.")
+		return
+	}
+	if showBlockers {
+		for _, item := range blocked {
+			_, _ = fmt.Fprintf(out, "%s  %s (%s)\n", item.ID, item.Title, item.Status)
+			for _, blocker := range item.Blockers {
+				if blocker.Error != "" {
+					_, _ = fmt.Fprintf(out, "  blocked by %s: dangling dependency (%s)\n", blocker.ID, blocker.Error)
+					continue
+				}
+				_, _ = fmt.Fprintf(out, "  blocked by %s  %s (%s)\n", blocker.ID, blocker.Title, blocker.Status)
+			}
+		}
+		return
+	}
+	rows := [][]string{{"ID", "STATUS", "BLOCKED BY", "TITLE"}}
+	for _, item := range blocked {
+		ids := make([]string, len(item.Blockers))
+		for i, blocker := range item.Blockers {
+			ids[i] = blocker.ID
+			if blocker.Error != "" {
+				ids[i] += " (dangling)"
+			}
+		}
+		rows = append(rows, []string{item.ID, item.Status, strings.Join(ids, ", "), item.Title})
+	}
+	writeListRows(out, rows)
+}
+
+func displayBlockedWorkItemsJSON(out io.Writer, blocked []blockedWorkItem) error {
+	if blocked == nil {
+		blocked = []blockedWorkItem{}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(blocked)
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+func TestBlocked(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-login.task.md":    listTestWorkItem("001", "Login", "todo", "blocked_by: [002, 003, 099]\n"),
+		"2_doing/002-schema.task.md":  listTestWorkItem("002", "Schema", "doing", ""),
+		"4_done/003-auth.task.md":     listTestWorkItem("003", "Auth", "done", ""),
+		"1_todo/004-profile.task.md":  listTestWorkItem("004", "Profile", "todo", "blocked_by: 003\n"),
+		"4_done/005-shipped.task.md":  listTestWorkItem("005", "Shipped", "done", "blocked_by: [002]\n"),
+		"0_backlog/006-later.task.md": listTestWorkItem("006", "Later", "backlog", "blocked_by:\n  - \"002\"\n"),
+	}
+	collect := func(t *testing.T) []blockedWorkItem {
+		t.Helper()
+		setupListWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		blocked, err := collectBlockedWorkItems(cfg)
+		require.NoError(t, err)
+		return blocked
+	}
+
+	t.Run("lists open work items with open or dangling blockers", func(t *testing.T) {
+		blocked := collect(t)
+
+		require.Len(t, blocked, 2)
+		assert.Equal(t, "006", blocked[0].ID)
+		assert.Equal(t, "001", blocked[1].ID)
+		require.Len(t, blocked[1].Blockers, 2, "the done blocker 003 no longer blocks")
+		assert.Equal(t, "002", blocked[1].Blockers[0].ID)
+		assert.Equal(t, "doing", blocked[1].Blockers[0].Status)
+		assert.Equal(t, "099", blocked[1].Blockers[1].ID)
+		assert.Contains(t, blocked[1].Blockers[1].Error, "work item 099 not found")
+	})
+
+	t.Run("prints the blockers of each work item", func(t *testing.T) {
+		blocked := collect(t)
+
+		var out bytes.Buffer
+		displayBlockedWorkItems(&out, blocked, false)
+		assert.Contains(t, out.String(), "BLOCKED BY")
+		assert.Regexp(t, `001\s+todo\s+002, 099 \(dangling\)\s+Login`, out.String())
+
+		out.Reset()
+		displayBlockedWorkItems(&out, blocked, true)
+		assert.Contains(t, out.String(), "001  Login (todo)\n  blocked by 002  Schema (doing)\n  blocked by 099: dangling dependency (work item 099 not found)\n")
+	})
+
+	t.Run("json lists the blockers", func(t *testing.T) {
+		blocked := collect(t)
+
+		var out bytes.Buffer
+		require.NoError(t, displayBlockedWorkItemsJSON(&out, blocked))
+		var parsed []struct {
+			ID        string `json:"id"`
+			BlockedBy []struct {
+				ID    string `json:"id"`
+				Error string `json:"error"`
+			} `json:"blocked_by"`
+		}
+		require.NoError(t, json.Unmarshal(out.Bytes(), &parsed))
+		require.Len(t, parsed, 2)
+		assert.Equal(t, "001", parsed[1].ID)
+		assert.Equal(t, "099", parsed[1].BlockedBy[1].ID)
+		assert.NotEmpty(t, parsed[1].BlockedBy[1].Error)
+	})
+
+	t.Run("reports when nothing is blocked", func(t *testing.T) {
+		var out bytes.Buffer
+		displayBlockedWorkItems(&out, nil, false)
+		assert.Equal(t, "No blocked work items.\n", out.String())
+
+		out.Reset()
+		require.NoError(t, displayBlockedWorkItemsJSON(&out, nil))
+		assert.Equal(t, "[]\n", out.String())
+	})
+}
@@ -0,0 +1,179 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// codeownersLocations are the paths GitHub reads a CODEOWNERS file from, in order of precedence.
+var codeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// codeownersRule is one CODEOWNERS line: a path pattern and its owners.
+type codeownersRule struct {
+	Pattern string
+	Owners  []string
+	match   *regexp.Regexp
+}
+
+// loadCodeowners reads the repository's CODEOWNERS file from the first location that exists.
+func loadCodeowners(cfg *config.Config) ([]codeownersRule, string, error) {
+	root := cfg.ConfigDir
+	if root == "" {
+		root = "."
+	}
+	for _, location := range codeownersLocations {
+		path := filepath.Join(root, filepath.FromSlash(location))
+		// #nosec G304 - path is one of the fixed CODEOWNERS locations under the config directory
+		content, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read %s: %w", location, err)
+		}
+		rules, err := parseCodeowners(string(content))
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse %s: %w", location, err)
+		}
+		return rules, location, nil
+	}
+	return nil, "", fmt.Errorf("no CODEOWNERS file found (looked for %s)", strings.Join(codeownersLocations, ", "))
+}
+
+// parseCodeowners parses CODEOWNERS content. Blank lines and # comments are skipped; a pattern
+// without owners is kept, since it clears ownership for the paths it matches.
+func parseCodeowners(content string) ([]codeownersRule, error) {
+	var rules []codeownersRule
+	for i, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, " #"); idx >= 0 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		match, err := codeownersPatternRegexp(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid pattern '%s': %w", i+1, fields[0], err)
+		}
+		rules = append(rules, codeownersRule{Pattern: fields[0], Owners: fields[1:], match: match})
+	}
+	return rules, nil
+}
+
+// codeownersPatternRegexp converts a CODEOWNERS (gitignore-style) pattern to a regexp over
+// slash-separated paths relative to the repository root. A pattern matches a path or anything
+// below it; patterns without a slash before the end match at any depth.
+func codeownersPatternRegexp(pattern string) (*regexp.Regexp, error) {
+	trimmed := strings.TrimSuffix(pattern, "/")
+	anchored := strings.Contains(trimmed, "/")
+	trimmed = strings.TrimPrefix(trimmed, "/")
+
+	var sb strings.Builder
+	if anchored {
+		sb.WriteString("^")
+	} else {
+		sb.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(trimmed); i++ {
+		switch {
+		case strings.HasPrefix(trimmed[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(trimmed[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case trimmed[i] == '*':
+			sb.WriteString("[^/]*")
+		case trimmed[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(trimmed[i])))
+		}
+	}
+	if strings.HasSuffix(pattern, "/") {
+		sb.WriteString("/.*$")
+	} else {
+		sb.WriteString("(?:/.*)?$")
+	}
+	return regexp.Compile(sb.String())
+}
+
+// codeownersForPaths returns the owners of the given paths, in first-seen order without duplicates.
+// As on GitHub, the last matching rule for a path decides its owners.
+func codeownersForPaths(rules []codeownersRule, paths []string) []string {
+	var owners []string
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		path = strings.TrimPrefix(filepath.ToSlash(strings.TrimSpace(path)), "./")
+		path = strings.TrimPrefix(path, "/")
+		if path == "" {
+			continue
+		}
+		var pathOwners []string
+		for _, rule := range rules {
+			if rule.match.MatchString(path) {
+				pathOwners = rule.Owners
+			}
+		}
+		for _, owner := range pathOwners {
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+// resolveCodeowners resolves CODEOWNERS handles (@user or email) to known users. Team handles
+// (@org/team) and handles that do not resolve to exactly one user are returned as unresolved.
+func resolveCodeowners(owners []string, users []UserInfo) (resolved []*UserInfo, unresolved []string) {
+	seen := make(map[string]bool)
+	for _, owner := range owners {
+		handle := strings.TrimPrefix(owner, "@")
+		if strings.Contains(handle, "/") {
+			unresolved = append(unresolved, owner)
+			continue
+		}
+		user, err := resolveUserIdentifier(handle, users)
+		if err != nil {
+			unresolved = append(unresolved, owner)
+			continue
+		}
+		if !seen[user.Email] {
+			seen[user.Email] = true
+			resolved = append(resolved, user)
+		}
+	}
+	return resolved, unresolved
+}
+
+// workItemCodeownersPaths returns the paths a work item touches: --paths when given, else the
+// work item's paths front matter field (a list or a single string).
+func workItemCodeownersPaths(frontMatter map[string]interface{}, override []string) []string {
+	if len(override) > 0 {
+		return override
+	}
+	switch value := frontMatter["paths"].(type) {
+	case string:
+		if strings.TrimSpace(value) != "" {
+			return []string{value}
+		}
+	case []interface{}:
+		paths := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				paths = append(paths, s)
+			}
+		}
+		return paths
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+func TestCodeowners(t *testing.T) {
+	t.Run("last matching rule wins", func(t *testing.T) {
+		rules, err := parseCodeowners(`# Owners
+*              @alice
+/docs/         @bob docs@example.com
+*.go           @carol # Go files
+internal/api   @dave
+internal/api/legacy
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"@alice"}, codeownersForPaths(rules, []string{"README.md"}))
+		assert.Equal(t, []string{"@bob", "docs@example.com"}, codeownersForPaths(rules, []string{"docs/guide.md"}))
+		assert.Equal(t, []string{"@carol"}, codeownersForPaths(rules, []string{"cmd/main.go"}))
+		assert.Equal(t, []string{"@dave"}, codeownersForPaths(rules, []string{"./internal/api/handler.go"}))
+		assert.Empty(t, codeownersForPaths(rules, []string{"internal/api/legacy/old.go"}), "a pattern without owners clears ownership")
+		assert.Equal(t, []string{"@alice", "@dave"}, codeownersForPaths(rules, []string{"a.txt", "internal/api", "b.txt"}))
+	})
+
+	t.Run("patterns follow gitignore rules", func(t *testing.T) {
+		cases := []struct {
+			pattern string
+			path    string
+			match   bool
+		}{
+			{"docs", "src/docs/readme.md", true},
+			{"/docs", "src/docs/readme.md", false},
+			{"docs/", "docs", false},
+			{"src/**/test", "src/a/b/test/x.go", true},
+			{"src/*.go", "src/a/b.go", false},
+			{"**/logs", "deep/nested/logs/today.log", true},
+			{"file?.txt", "file1.txt", true},
+		}
+		for _, tc := range cases {
+			re, err := codeownersPatternRegexp(tc.pattern)
+			require.NoError(t, err, tc.pattern)
+			assert.Equal(t, tc.match, re.MatchString(tc.path), "%s vs %s", tc.pattern, tc.path)
+		}
+	})
+
+	t.Run("resolves handles and skips teams and unknown owners", func(t *testing.T) {
+		users := []UserInfo{
+			{Email: "alice@example.com", Name: "Alice", Number: 1},
+			{Email: "docs@example.com", Name: "Docs Bot", Number: 2},
+		}
+		resolved, unresolved := resolveCodeowners([]string{"@alice", "docs@example.com", "@org/team", "@zed", "@Alice"}, users)
+
+		require.Len(t, resolved, 2)
+		assert.Equal(t, "alice@example.com", resolved[0].Email)
+		assert.Equal(t, "docs@example.com", resolved[1].Email)
+		assert.Equal(t, []string{"@org/team", "@zed"}, unresolved)
+	})
+
+	t.Run("paths come from --paths or front matter", func(t *testing.T) {
+		frontMatter := map[string]interface{}{"paths": []interface{}{"docs/", "cmd/main.go"}}
+		assert.Equal(t, []string{"docs/", "cmd/main.go"}, workItemCodeownersPaths(frontMatter, nil))
+		assert.Equal(t, []string{"api"}, workItemCodeownersPaths(frontMatter, []string{"api"}))
+		assert.Equal(t, []string{"docs"}, workItemCodeownersPaths(map[string]interface{}{"paths": "docs"}, nil))
+		assert.Empty(t, workItemCodeownersPaths(map[string]interface{}{}, nil))
+	})
+}
+
+func TestAssignFromCodeowners(t *testing.T) {
+	setup := func(t *testing.T, codeowners string) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(".work/1_todo/001-api.task.md",
+			[]byte("---\nid: \"001\"\ntitle: API\nstatus: todo\nkind: task\npaths:\n  - internal/api/handler.go\n  - docs/api.md\n---\n# API\n"), 0o600))
+		require.NoError(t, os.WriteFile(".work/1_todo/002-no-paths.task.md",
+			[]byte("---\nid: \"002\"\ntitle: No paths\nstatus: todo\nkind: task\n---\n# No paths\n"), 0o600))
+		if codeowners != "" {
+			require.NoError(t, os.MkdirAll(".github", 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(".github", "CODEOWNERS"), []byte(codeowners), 0o600))
+		}
+
+		cfg := testCfgWithDir(tmpDir)
+		useGitHistory := false
+		cfg.Users = config.UsersConfig{
+			UseGitHistory: &useGitHistory,
+			SavedUsers: []config.SavedUser{
+				{Email: "alice@example.com", Name: "alice"},
+				{Email: "bob@example.com", Name: "bob"},
+			},
+		}
+		return cfg
+	}
+	capture := func(t *testing.T, fn func() error) (string, error) {
+		t.Helper()
+		oldStdout := os.Stdout
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		os.Stdout = w
+		runErr := fn()
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		return buf.String(), runErr
+	}
+	const codeowners = "internal/api/ @alice\ndocs/ @bob @org/writers\n"
+
+	t.Run("appends owners to reviewers", func(t *testing.T) {
+		cfg := setup(t, codeowners)
+		output, err := capture(t, func() error {
+			return runAssignFromCodeowners([]string{"001"}, AssignFlags{Field: "assigned", FromCodeowners: true}, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Summary: 2 succeeded, 0 failed")
+
+		content, err := os.ReadFile(".work/1_todo/001-api.task.md")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "reviewers: [alice@example.com, bob@example.com]")
+		assert.NotContains(t, string(content), "assigned:")
+	})
+
+	t.Run("adds all owners in the write that moves the work item", func(t *testing.T) {
+		cfg := setup(t, codeowners)
+		output, err := capture(t, func() error {
+			return runAssignFromCodeowners([]string{"001"}, AssignFlags{Field: "assigned", FromCodeowners: true, MoveTo: "doing"}, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Summary: 2 succeeded, 0 failed")
+
+		assert.NoFileExists(t, ".work/1_todo/001-api.task.md")
+		content, err := os.ReadFile(".work/2_doing/001-api.task.md")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "reviewers: [alice@example.com, bob@example.com]")
+		assert.Contains(t, string(content), "status: doing")
+	})
+
+	t.Run("dry-run previews without writing", func(t *testing.T) {
+		cfg := setup(t, codeowners)
+		output, err := capture(t, func() error {
+			return runAssignFromCodeowners([]string{"001"}, AssignFlags{Field: "assigned", FromCodeowners: true, DryRun: true}, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Would add alice <alice@example.com> to work item 001 (field: reviewers)")
+		assert.Contains(t, output, "Would add bob <bob@example.com> to work item 001 (field: reviewers)")
+
+		content, err := os.ReadFile(".work/1_todo/001-api.task.md")
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "reviewers")
+	})
+
+	t.Run("reports nothing to do without paths or matching owners", func(t *testing.T) {
+		cfg := setup(t, codeowners)
+		output, err := capture(t, func() error {
+			return runAssignFromCodeowners([]string{"002"}, AssignFlags{Field: "assigned", FromCodeowners: true}, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Nothing to do for work item 002: no paths")
+
+		output, err = capture(t, func() error {
+			return runAssignFromCodeowners([]string{"002"}, AssignFlags{Field: "assigned", FromCodeowners: true, Paths: []string{"cmd/main.go"}}, cfg)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Nothing to do for work item 002: no owners in .github/CODEOWNERS match its paths")
+	})
+
+	t.Run("errors without a CODEOWNERS file", func(t *testing.T) {
+		cfg := setup(t, "")
+		err := runAssignFromCodeowners([]string{"001"}, AssignFlags{Field: "assigned", FromCodeowners: true}, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no CODEOWNERS file found")
+	})
+
+	t.Run("rejects incompatible flags", func(t *testing.T) {
+		err := runAssignFromCodeowners([]string{"001"}, AssignFlags{Field: "assigned", FromCodeowners: true, Unassign: true}, testCfgWithDir(t.TempDir()))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--set-from-codeowners cannot be used with")
+		assert.Error(t, validateAssignFlagCombinations("5", AssignFlags{Paths: []string{"docs"}}))
+	})
+}
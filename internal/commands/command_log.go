@@ -0,0 +1,176 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"kira/internal/config"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// commandLogFile is set by the global --log-file flag.
+var commandLogFile string
+
+// activeCommandLog records the running invocation when a log file is configured; nil otherwise.
+var activeCommandLog *commandLog
+
+// commandLogEntry is the JSON line appended to the log file for one kira invocation.
+type commandLogEntry struct {
+	Time       string            `json:"time"`
+	Command    string            `json:"command"`
+	Args       []string          `json:"args"`
+	Flags      map[string]string `json:"flags,omitempty"`
+	Items      []string          `json:"items,omitempty"`
+	Commands   []string          `json:"commands,omitempty"`
+	Outcome    string            `json:"outcome"`
+	Error      string            `json:"error,omitempty"`
+	DurationMS int64             `json:"duration_ms"`
+}
+
+// commandLog collects the entry of the running invocation. Repositories are updated in parallel
+// by kira latest, so recording is guarded by a mutex.
+type commandLog struct {
+	mu     sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	start  time.Time
+	entry  commandLogEntry
+}
+
+// startCommandLog opens the log file given by --log-file, else logging.file in kira.yml, and
+// starts recording the invocation of cmd. Without a log file it does nothing.
+func startCommandLog(cmd *cobra.Command, args []string) error {
+	path := commandLogPath(commandLogFile)
+	if path == "" {
+		return nil
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("failed to create log file directory: %w", err)
+		}
+	}
+	// #nosec G304 - path is the log file chosen by the user (--log-file or logging.file)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	flags := make(map[string]string)
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		flags[flag.Name] = flag.Value.String()
+	})
+	start := time.Now()
+	activeCommandLog = &commandLog{
+		file:   file,
+		writer: bufio.NewWriter(file),
+		start:  start,
+		entry: commandLogEntry{
+			Time:    start.UTC().Format(time.RFC3339),
+			Command: cmd.CommandPath(),
+			Args:    append([]string{}, args...),
+			Flags:   flags,
+		},
+	}
+	return nil
+}
+
+// commandLogPath returns the log file to write: flagPath when given, else logging.file from
+// kira.yml (relative to the directory of kira.yml), else "". A kira.yml that cannot be loaded
+// disables the config fallback; the command itself reports that error.
+func commandLogPath(flagPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	cfg, err := config.LoadConfig()
+	if err != nil || cfg.Logging == nil || cfg.Logging.File == "" {
+		return ""
+	}
+	if filepath.IsAbs(cfg.Logging.File) {
+		return cfg.Logging.File
+	}
+	return filepath.Join(cfg.ConfigDir, cfg.Logging.File)
+}
+
+// recordResolvedWorkItem adds a work item path the command resolved to the log entry.
+func recordResolvedWorkItem(path string) {
+	if activeCommandLog == nil {
+		return
+	}
+	activeCommandLog.mu.Lock()
+	defer activeCommandLog.mu.Unlock()
+	if !containsString(activeCommandLog.entry.Items, path) {
+		activeCommandLog.entry.Items = append(activeCommandLog.entry.Items, path)
+	}
+}
+
+// recordExecutedCommand adds an external command kira ran to the log entry. Only its name is
+// recorded (see commandLogName), never its arguments.
+func recordExecutedCommand(argv []string) {
+	if activeCommandLog == nil || len(argv) == 0 {
+		return
+	}
+	activeCommandLog.mu.Lock()
+	defer activeCommandLog.mu.Unlock()
+	activeCommandLog.entry.Commands = append(activeCommandLog.entry.Commands, commandLogName(argv))
+}
+
+// commandLogName returns the redacted name of a command line: the program name, plus the
+// subcommand for git ("git rebase"). Arguments can hold messages, paths or credentials in URLs,
+// so they are left out.
+func commandLogName(argv []string) string {
+	name := filepath.Base(argv[0])
+	if name != "git" {
+		return name
+	}
+	for i := 1; i < len(argv); i++ {
+		switch {
+		case argv[i] == "-C" || argv[i] == "-c":
+			i++ // skip the option's value
+		case !strings.HasPrefix(argv[i], "-"):
+			return name + " " + argv[i]
+		}
+	}
+	return name
+}
+
+// finishCommandLog records the outcome of the invocation, appends its entry to the log file and
+// flushes and closes the file. The entry is written as one line so concurrent runs appending to
+// the same file do not interleave.
+func finishCommandLog(runErr error) error {
+	log := activeCommandLog
+	if log == nil {
+		return nil
+	}
+	activeCommandLog = nil
+
+	log.entry.Outcome = "success"
+	if runErr != nil {
+		log.entry.Outcome = "error"
+		log.entry.Error = runErr.Error()
+	}
+	log.entry.DurationMS = time.Since(log.start).Milliseconds()
+
+	line, err := json.Marshal(log.entry)
+	if err != nil {
+		_ = log.file.Close()
+		return err
+	}
+	if _, err := log.writer.Write(append(line, '\n')); err != nil {
+		_ = log.file.Close()
+		return err
+	}
+	if err := log.writer.Flush(); err != nil {
+		_ = log.file.Close()
+		return err
+	}
+	return log.file.Close()
+}
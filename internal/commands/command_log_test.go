@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandLog(t *testing.T) {
+	newLoggedCommand := func() *cobra.Command {
+		root := &cobra.Command{Use: "kira"}
+		cmd := &cobra.Command{Use: "move"}
+		cmd.Flags().Bool("dry-run", false, "")
+		root.AddCommand(cmd)
+		return cmd
+	}
+	readEntries := func(t *testing.T, path string) []commandLogEntry {
+		t.Helper()
+		var entries []commandLogEntry
+		for _, line := range strings.Split(strings.TrimSpace(mustReadFile(t, path)), "\n") {
+			var entry commandLogEntry
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	t.Run("appends one json line per invocation with items, commands and outcome", func(t *testing.T) {
+		logPath := filepath.Join(t.TempDir(), "logs", "kira.log")
+		commandLogFile = logPath
+		defer func() { commandLogFile = "" }()
+
+		cmd := newLoggedCommand()
+		require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+		require.NoError(t, startCommandLog(cmd, []string{"001", "doing"}))
+		recordResolvedWorkItem("/work/1_todo/001-a.prd.md")
+		recordResolvedWorkItem("/work/1_todo/001-a.prd.md")
+		recordExecutedCommand([]string{"git", "commit", "-m", "secret message"})
+		require.NoError(t, finishCommandLog(nil))
+
+		require.NoError(t, startCommandLog(newLoggedCommand(), []string{"002"}))
+		require.NoError(t, finishCommandLog(errors.New("work item 002 not found")))
+
+		entries := readEntries(t, logPath)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "kira move", entries[0].Command)
+		assert.Equal(t, []string{"001", "doing"}, entries[0].Args)
+		assert.Equal(t, map[string]string{"dry-run": "true"}, entries[0].Flags)
+		assert.Equal(t, []string{"/work/1_todo/001-a.prd.md"}, entries[0].Items)
+		assert.Equal(t, []string{"git commit"}, entries[0].Commands)
+		assert.Equal(t, "success", entries[0].Outcome)
+		assert.Equal(t, "error", entries[1].Outcome)
+		assert.Equal(t, "work item 002 not found", entries[1].Error)
+		assert.NotContains(t, mustReadFile(t, logPath), "secret message")
+	})
+
+	t.Run("uses logging.file from kira.yml relative to its directory", func(t *testing.T) {
+		dir := t.TempDir()
+		chdirForCommandLog(t, dir)
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\nlogging:\n  file: .work/kira.log\n"), 0o600))
+
+		assert.Equal(t, filepath.Join(dir, ".work", "kira.log"), commandLogPath(""))
+		assert.Equal(t, "other.log", commandLogPath("other.log"))
+	})
+
+	t.Run("does nothing without a log file", func(t *testing.T) {
+		chdirForCommandLog(t, t.TempDir())
+
+		require.NoError(t, startCommandLog(newLoggedCommand(), nil))
+		assert.Nil(t, activeCommandLog)
+		recordExecutedCommand([]string{"git", "status"})
+		assert.NoError(t, finishCommandLog(nil))
+	})
+}
+
+func chdirForCommandLog(t *testing.T, dir string) {
+	t.Helper()
+	origDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+}
+
+func TestCommandLogName(t *testing.T) {
+	tests := []struct {
+		argv []string
+		want string
+	}{
+		{[]string{"git", "rebase", "origin/main"}, "git rebase"},
+		{[]string{"/usr/bin/git", "-C", "/repo", "status", "--porcelain"}, "git status"},
+		{[]string{"git", "-c", "user.name=x", "commit", "-m", "msg"}, "git commit"},
+		{[]string{"git", "--version"}, "git"},
+		{[]string{"sh", "-c", "make deploy TOKEN=x"}, "sh"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.argv, " "), func(t *testing.T) {
+			assert.Equal(t, tt.want, commandLogName(tt.argv))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+// completeWorkItemIDs is the shell completion for work item ID arguments. It suggests the IDs of
+// the work items in the status folders as "id<TAB>title" (shells show the title as the
+// description), leaving out IDs already given. Outside a workspace it suggests nothing.
+func completeWorkItemIDs(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return workItemIDCompletions(cfg, args, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeFirstWorkItemID completes the work item ID of commands that take it as their first
+// argument only.
+func completeFirstWorkItemID(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeWorkItemIDs(cmd, args, toComplete)
+}
+
+// workItemIDCompletions returns "id<TAB>title" for each work item whose ID starts with
+// toComplete, in status folder order. IDs and titles come from the file names
+// (<id>-<slug>.<type>.md), so no work item file is read.
+func workItemIDCompletions(cfg *config.Config, args []string, toComplete string) []string {
+	var completions []string
+	for _, status := range orderedStatuses(cfg, "") {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			continue
+		}
+		for _, path := range paths {
+			id, title, ok := workItemFileNameParts(filepath.Base(path))
+			if !ok || !strings.HasPrefix(id, toComplete) || containsString(args, id) {
+				continue
+			}
+			completions = append(completions, id+"\t"+title)
+		}
+	}
+	return completions
+}
+
+// workItemFileNameParts splits a work item file name such as "001-fix-login.prd.md" into its ID
+// ("001") and a title made from the slug ("fix login").
+func workItemFileNameParts(name string) (id, title string, ok bool) {
+	id, rest, found := strings.Cut(name, "-")
+	if !found || id == "" {
+		return "", "", false
+	}
+	slug, _, _ := strings.Cut(rest, ".")
+	return id, strings.ReplaceAll(slug, "-", " "), true
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteWorkItemIDs(t *testing.T) {
+	files := map[string]string{
+		filepath.Join("1_todo", "001-fix-login.prd.md"):    "not parsed",
+		filepath.Join("2_doing", "002-add-search.task.md"): "not parsed",
+		filepath.Join("2_doing", "010-tune-cache.task.md"): "not parsed",
+		filepath.Join("2_doing", "notes.md"):               "no id prefix",
+	}
+
+	t.Run("suggests id and title from file names in status folder order", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		completions, directive := completeWorkItemIDs(assignCmd, nil, "")
+		assert.Equal(t, []string{"001\tfix login", "002\tadd search", "010\ttune cache"}, completions)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+
+	t.Run("filters by the typed prefix and leaves out given ids", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		completions, _ := completeWorkItemIDs(assignCmd, []string{"001"}, "0")
+		assert.Equal(t, []string{"002\tadd search", "010\ttune cache"}, completions)
+
+		completions, _ = completeWorkItemIDs(assignCmd, nil, "01")
+		assert.Equal(t, []string{"010\ttune cache"}, completions)
+	})
+
+	t.Run("completes only the first argument of single work item commands", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		completions, _ := completeFirstWorkItemID(showCmd, nil, "00")
+		assert.Len(t, completions, 2)
+		completions, _ = completeFirstWorkItemID(showCmd, []string{"001"}, "")
+		assert.Empty(t, completions)
+	})
+
+	t.Run("suggests nothing outside a workspace", func(t *testing.T) {
+		origDir, err := os.Getwd()
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(t.TempDir()))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		completions, directive := completeWorkItemIDs(assignCmd, nil, "")
+		assert.Empty(t, completions)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+}
@@ -2,6 +2,7 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -51,10 +52,42 @@ Polyrepo:
 	RunE: runConfigGet,
 }
 
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a configuration value in kira.yml",
+	Long: `Set a configuration value by dotted path in kira.yml.
+
+Examples:
+  kira config set git.trunk_branch develop
+  kira config set start.move_to doing
+  kira config set workspace.projects.0.remote upstream
+  kira config set ide.args '["--new-window"]'
+
+The value is parsed as YAML, so lists and maps can be given inline. Missing
+intermediate keys are created; unrelated keys and comments are preserved.
+Unknown keys are rejected, and the resulting configuration is validated
+before kira.yml is written.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runConfigSet,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Show the effective configuration",
+	Long:  `Print the effective configuration (kira.yml merged with defaults) as YAML, or as JSON with --output json.`,
+	Args:  cobra.NoArgs,
+	RunE:  runConfigList,
+}
+
 func init() {
 	configGetCmd.Flags().String("output", "text", "Output format: text or json")
 	configGetCmd.Flags().String("project", "", "Project name (for polyrepo). Use '*' or 'all' for all projects.")
 	configCmd.AddCommand(configGetCmd)
+
+	configCmd.AddCommand(configSetCmd)
+
+	configListCmd.Flags().String("output", "text", "Output format: text (YAML) or json")
+	configCmd.AddCommand(configListCmd)
 }
 
 // curatedKeys is a set of keys that should be treated as curated keys even if they contain dots
@@ -433,8 +466,8 @@ func getProjectNames(cfg *config.Config) []string {
 	return names
 }
 
-func getPathValue(cfg *config.Config, pathStr string) (interface{}, error) {
-	// Serialize config to map for easier path traversal
+// configAsMap serializes the effective config to a generic map (as written in kira.yml).
+func configAsMap(cfg *config.Config) (map[string]interface{}, error) {
 	configBytes, err := yaml.Marshal(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to serialize config: %w", err)
@@ -444,6 +477,15 @@ func getPathValue(cfg *config.Config, pathStr string) (interface{}, error) {
 	if err := yaml.Unmarshal(configBytes, &configMap); err != nil {
 		return nil, fmt.Errorf("failed to deserialize config: %w", err)
 	}
+	return configMap, nil
+}
+
+func getPathValue(cfg *config.Config, pathStr string) (interface{}, error) {
+	// Serialize config to map for easier path traversal
+	configMap, err := configAsMap(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	// Parse path segments
 	segments := strings.Split(pathStr, ".")
@@ -546,3 +588,204 @@ func outputText(value interface{}, w *os.File) error {
 	}
 	return nil
 }
+
+func runConfigList(cmd *cobra.Command, _ []string) error {
+	outputFormat, _ := cmd.Flags().GetString("output")
+	if outputFormat != outputFormatText && outputFormat != outputFormatJSON {
+		return fmt.Errorf("invalid output format '%s': use 'text' or 'json'", outputFormat)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if outputFormat == outputFormatJSON {
+		configMap, err := configAsMap(cfg)
+		if err != nil {
+			return err
+		}
+		return outputJSON(configMap, os.Stdout)
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func runConfigSet(_ *cobra.Command, args []string) error {
+	key := args[0]
+	segments := strings.Split(key, ".")
+	for _, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			return fmt.Errorf("invalid key '%s': empty path segment", key)
+		}
+	}
+
+	valueNode, err := parseConfigValueNode(args[1])
+	if err != nil {
+		return err
+	}
+	if err := checkKnownConfigKey(key, segments, valueNode); err != nil {
+		return err
+	}
+
+	configPath := config.FilePath(".")
+	if err := writeConfigValue(configPath, key, segments, valueNode); err != nil {
+		return err
+	}
+	fmt.Printf("Set %s in %s\n", key, configPath)
+	return nil
+}
+
+// writeConfigValue sets the value at the dotted key (split into segments) in the config file at
+// configPath, keeping comments and unrelated keys. The file is only written when the resulting
+// configuration is valid.
+func writeConfigValue(configPath, key string, segments []string, valueNode *yaml.Node) error {
+	doc, err := readConfigDocument(configPath)
+	if err != nil {
+		return err
+	}
+	if err := setConfigNodePath(doc.Content[0], segments, valueNode); err != nil {
+		return fmt.Errorf("cannot set '%s': %w", key, err)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	// Validate the whole resulting config before touching the file
+	if _, err := config.ParseConfig(buf.Bytes()); err != nil {
+		return fmt.Errorf("not writing %s: resulting config is invalid: %w", configPath, err)
+	}
+
+	if err := os.WriteFile(configPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// readConfigDocument reads kira.yml as a YAML node tree (keeping comments and key order).
+// A missing or empty file yields a document with an empty mapping.
+func readConfigDocument(configPath string) (*yaml.Node, error) {
+	doc := &yaml.Node{}
+	cleanPath := filepath.Clean(configPath)
+	if strings.Contains(cleanPath, "..") {
+		return nil, fmt.Errorf("invalid config path: %s", configPath)
+	}
+	data, err := os.ReadFile(cleanPath) // #nosec G304 -- path is kira.yml or .work/kira.yml in the current directory
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	if doc.Kind == 0 || len(doc.Content) == 0 {
+		return &yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}, nil
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("failed to parse config file: top level must be a map")
+	}
+	return doc, nil
+}
+
+// parseConfigValueNode parses a command-line value as YAML so lists and maps can be set inline.
+func parseConfigValueNode(raw string) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil, fmt.Errorf("invalid value '%s': %w", raw, err)
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: raw}, nil
+	}
+	return doc.Content[0], nil
+}
+
+// checkKnownConfigKey rejects keys that do not exist in the config schema (typos) and values
+// of the wrong type, by strictly decoding a document that contains only key: value.
+func checkKnownConfigKey(key string, segments []string, value *yaml.Node) error {
+	node := value
+	for i := len(segments) - 1; i >= 0; i-- {
+		if _, err := strconv.Atoi(segments[i]); err == nil && i > 0 {
+			node = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: []*yaml.Node{node}}
+			continue
+		}
+		node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: segments[i]},
+			node,
+		}}
+	}
+
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to serialize value for '%s': %w", key, err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var probe config.Config
+	if err := decoder.Decode(&probe); err != nil {
+		return fmt.Errorf("unknown key or invalid value for '%s': %w", key, err)
+	}
+	return nil
+}
+
+// setConfigNodePath sets value at the dotted path below root, creating missing map keys.
+// Comments attached to a replaced value are kept.
+func setConfigNodePath(root *yaml.Node, segments []string, value *yaml.Node) error {
+	node := root
+	for _, segment := range segments {
+		child, err := configChildNode(node, segment)
+		if err != nil {
+			return err
+		}
+		node = child
+	}
+
+	headComment, lineComment, footComment := node.HeadComment, node.LineComment, node.FootComment
+	*node = *value
+	node.HeadComment, node.LineComment, node.FootComment = headComment, lineComment, footComment
+	return nil
+}
+
+// configChildNode returns the child of node for segment: a map value (added as an empty map
+// when missing) or an existing array element. A null node is turned into a map first.
+func configChildNode(node *yaml.Node, segment string) (*yaml.Node, error) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		node.Kind, node.Tag, node.Value = yaml.MappingNode, "!!map", ""
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i+1], nil
+			}
+		}
+		child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: segment}, child)
+		return child, nil
+	case yaml.SequenceNode:
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(node.Content) {
+			return nil, fmt.Errorf("invalid index '%s' (array has %d elements)", segment, len(node.Content))
+		}
+		return node.Content[idx], nil
+	default:
+		return nil, fmt.Errorf("'%s' is inside a value that is not a map or array", segment)
+	}
+}
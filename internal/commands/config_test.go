@@ -1135,3 +1135,168 @@ func TestConfigGetPathIgnoresProject(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigSet(t *testing.T) {
+	setup := func(t *testing.T, content string) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		originalDir, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.Chdir(originalDir) })
+		require.NoError(t, os.Chdir(tmpDir))
+		if content != "" {
+			require.NoError(t, os.WriteFile("kira.yml", []byte(content), 0o600))
+		}
+	}
+
+	t.Run("updates value and preserves comments and unrelated keys", func(t *testing.T) {
+		setup(t, `# project config
+version: "1.0"
+git:
+  # main line of development
+  trunk_branch: main
+  remote: origin
+`)
+		require.NoError(t, runConfigSet(configSetCmd, []string{"git.trunk_branch", "develop"}))
+
+		data, err := os.ReadFile("kira.yml")
+		require.NoError(t, err)
+		content := string(data)
+		assert.Contains(t, content, "# project config")
+		assert.Contains(t, content, "# main line of development")
+		assert.Contains(t, content, "trunk_branch: develop")
+		assert.Contains(t, content, "remote: origin")
+		assert.NotContains(t, content, "move_to", "defaults must not be written to kira.yml")
+
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "develop", cfg.Git.TrunkBranch)
+	})
+
+	t.Run("creates missing sections", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\n")
+		require.NoError(t, runConfigSet(configSetCmd, []string{"start.move_to", "review"}))
+		require.NoError(t, runConfigSet(configSetCmd, []string{"ide.args", `["--new-window"]`}))
+
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Start)
+		assert.Equal(t, "review", cfg.Start.MoveTo)
+		require.NotNil(t, cfg.IDE)
+		assert.Equal(t, []string{"--new-window"}, cfg.IDE.Args)
+	})
+
+	t.Run("sets array element by index", func(t *testing.T) {
+		setup(t, `version: "1.0"
+workspace:
+  projects:
+    - name: frontend
+      path: ../frontend
+`)
+		require.NoError(t, runConfigSet(configSetCmd, []string{"workspace.projects.0.remote", "upstream"}))
+
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		require.Len(t, cfg.Workspace.Projects, 1)
+		assert.Equal(t, "upstream", cfg.Workspace.Projects[0].Remote)
+		assert.Equal(t, "frontend", cfg.Workspace.Projects[0].Name)
+
+		err = runConfigSet(configSetCmd, []string{"workspace.projects.3.remote", "upstream"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid index '3'")
+	})
+
+	t.Run("rejects unknown key", func(t *testing.T) {
+		original := "version: \"1.0\"\n"
+		setup(t, original)
+		err := runConfigSet(configSetCmd, []string{"git.trunk_brnch", "develop"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown key or invalid value for 'git.trunk_brnch'")
+
+		data, readErr := os.ReadFile("kira.yml")
+		require.NoError(t, readErr)
+		assert.Equal(t, original, string(data))
+	})
+
+	t.Run("rejects value of wrong type", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\n")
+		err := runConfigSet(configSetCmd, []string{"start.max_title_length", "long"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown key or invalid value")
+	})
+
+	t.Run("does not write config that fails validation", func(t *testing.T) {
+		original := "version: \"1.0\"\n"
+		setup(t, original)
+		err := runConfigSet(configSetCmd, []string{"start.status_action", "bogus"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resulting config is invalid")
+
+		data, readErr := os.ReadFile("kira.yml")
+		require.NoError(t, readErr)
+		assert.Equal(t, original, string(data))
+	})
+
+	t.Run("creates kira.yml when missing", func(t *testing.T) {
+		setup(t, "")
+		require.NoError(t, runConfigSet(configSetCmd, []string{"git.trunk_branch", "develop"}))
+
+		data, err := os.ReadFile("kira.yml")
+		require.NoError(t, err)
+		assert.Equal(t, "git:\n  trunk_branch: develop\n", string(data))
+	})
+}
+
+func TestConfigList(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalDir, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		_ = os.Chdir(originalDir)
+	}()
+	require.NoError(t, os.Chdir(tmpDir))
+	require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\ngit:\n  trunk_branch: develop\n"), 0o600))
+
+	capture := func(t *testing.T, format string) string {
+		t.Helper()
+		require.NoError(t, configListCmd.Flags().Set("output", format))
+		t.Cleanup(func() { _ = configListCmd.Flags().Set("output", "text") })
+
+		oldStdout := os.Stdout
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		os.Stdout = w
+		runErr := runConfigList(configListCmd, nil)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		require.NoError(t, runErr)
+
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(r)
+		require.NoError(t, err)
+		return buf.String()
+	}
+
+	t.Run("text output includes configured values and defaults", func(t *testing.T) {
+		out := capture(t, "text")
+		assert.Contains(t, out, "trunk_branch: develop")
+		assert.Contains(t, out, "move_to: doing")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		out := capture(t, "json")
+		var parsed map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(out), &parsed))
+		git, ok := parsed["git"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "develop", git["trunk_branch"])
+	})
+
+	t.Run("invalid output format", func(t *testing.T) {
+		require.NoError(t, configListCmd.Flags().Set("output", "xml"))
+		defer func() { _ = configListCmd.Flags().Set("output", "text") }()
+		err := runConfigList(configListCmd, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid output format")
+	})
+}
@@ -0,0 +1,37 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// assumeYes is set by the global --yes (-y) flag: every confirmation prompt is answered yes.
+var assumeYes bool
+
+// confirm asks a y/N question on stdin and reports whether the user answered yes. Every
+// confirmation goes through it so automated runs behave predictably: with --yes it returns true
+// without asking, and without a terminal to ask on it fails instead of blocking or guessing.
+func confirm(prompt string) (bool, error) {
+	return confirmWith(prompt, assumeYes, stdinIsTerminal(), os.Stdin, os.Stdout)
+}
+
+// confirmWith is confirm with the flag, terminal check and streams passed in.
+func confirmWith(prompt string, yes, terminal bool, in io.Reader, out io.Writer) (bool, error) {
+	if yes {
+		return true, nil
+	}
+	if !terminal {
+		return false, fmt.Errorf("confirmation required (%s) but stdin is not a terminal; pass --yes to confirm", prompt)
+	}
+	_, _ = fmt.Fprintf(out, "%s [y/N]: ", prompt)
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && line == "" {
+		return false, nil
+	}
+	answer := strings.ToLower(strings.TrimSpace(line))
+	return answer == "y" || answer == "yes", nil
+}
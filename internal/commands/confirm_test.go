@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmWith(t *testing.T) {
+	t.Run("--yes confirms without asking on a terminal", func(t *testing.T) {
+		var out bytes.Buffer
+		confirmed, err := confirmWith("Remove task T001?", true, true, strings.NewReader("n\n"), &out)
+		require.NoError(t, err)
+		assert.True(t, confirmed)
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("--yes confirms without a terminal", func(t *testing.T) {
+		confirmed, err := confirmWith("Remove task T001?", true, false, strings.NewReader(""), &bytes.Buffer{})
+		require.NoError(t, err)
+		assert.True(t, confirmed)
+	})
+
+	t.Run("fails without a terminal and without --yes", func(t *testing.T) {
+		confirmed, err := confirmWith("Remove task T001?", false, false, strings.NewReader("y\n"), &bytes.Buffer{})
+		require.Error(t, err)
+		assert.False(t, confirmed)
+		assert.Contains(t, err.Error(), "Remove task T001?")
+		assert.Contains(t, err.Error(), "pass --yes")
+	})
+
+	t.Run("asks on a terminal and accepts y or yes", func(t *testing.T) {
+		for _, answer := range []string{"y\n", "Y\n", " yes \n", "y"} {
+			var out bytes.Buffer
+			confirmed, err := confirmWith("Remove task T001?", false, true, strings.NewReader(answer), &out)
+			require.NoError(t, err)
+			assert.True(t, confirmed, answer)
+			assert.Equal(t, "Remove task T001? [y/N]: ", out.String())
+		}
+	})
+
+	t.Run("anything else on a terminal declines", func(t *testing.T) {
+		for _, answer := range []string{"n\n", "\n", "maybe\n", ""} {
+			confirmed, err := confirmWith("Remove task T001?", false, true, strings.NewReader(answer), &bytes.Buffer{})
+			require.NoError(t, err)
+			assert.False(t, confirmed, answer)
+		}
+	})
+}
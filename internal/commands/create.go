@@ -0,0 +1,176 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+	"kira/internal/validation"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a work item from flags and print its path",
+	Long: `Creates a work item without prompts or template inputs, for scripts and agents.
+
+The work item gets the next free ID (one above the highest ID in .work/, or in the
+status folder with naming.per_folder_ids), and is written to the folder of --status
+(status_folders; default default_status) as {id}-{kebab-title}.{kind}.md, the name
+kira new would give it. The front matter has id, title, status, kind and created. created
+is the date (2006-01-02) rather than a full timestamp, since kira lint only accepts dates
+there; the new work item passes kira lint as written.
+
+The body is "# <title>" unless --template names a markdown file in the project, whose
+content (without any front matter of its own) becomes the body.
+
+Only the path of the new file is printed, so it can be piped:
+  $EDITOR "$(kira create --title "Feature X" --kind prd)"
+
+Examples:
+  kira create --title "Feature X" --kind prd --status todo
+  kira create --title "Fix login" --template docs/bug-body.md`,
+	Args: cobra.NoArgs,
+	RunE: runCreate,
+}
+
+func init() {
+	createCmd.Flags().String("title", "", "Title of the work item (required)")
+	createCmd.Flags().String("kind", "task", "Kind of work item, one of the configured templates (e.g. prd, task)")
+	createCmd.Flags().String("status", "", "Status to create the work item in (default: default_status)")
+	createCmd.Flags().String("template", "", "Markdown file whose content becomes the body")
+}
+
+// createOptions are the flags of kira create.
+type createOptions struct {
+	Title    string
+	Kind     string
+	Status   string
+	Template string
+}
+
+func runCreate(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	var opts createOptions
+	opts.Title, _ = cmd.Flags().GetString("title")
+	opts.Kind, _ = cmd.Flags().GetString("kind")
+	opts.Status, _ = cmd.Flags().GetString("status")
+	opts.Template, _ = cmd.Flags().GetString("template")
+
+	path, err := createWorkItemFromOptions(cfg, opts, time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
+
+// createWorkItemFromOptions writes the work item kira create describes and returns its path.
+func createWorkItemFromOptions(cfg *config.Config, opts createOptions, now time.Time) (string, error) {
+	title := strings.TrimSpace(opts.Title)
+	if title == "" {
+		return "", fmt.Errorf("--title is required")
+	}
+	slug := strings.Trim(kebabCase(title), "-")
+	if slug == "" || strings.ContainsAny(slug, `/\`) {
+		return "", fmt.Errorf("invalid title '%s': it must make a file name (letters or digits, no slashes)", title)
+	}
+	if err := validateCreateKind(opts.Kind, cfg); err != nil {
+		return "", err
+	}
+	status, err := resolveStatus(cfg, opts.Status)
+	if err != nil {
+		return "", err
+	}
+	bodyLines := []string{"", "# " + title}
+	if opts.Template != "" {
+		if bodyLines, err = readCreateTemplate(opts.Template); err != nil {
+			return "", err
+		}
+	}
+
+	folder := filepath.Join(config.GetWorkFolderPath(cfg), cfg.StatusFolders[status])
+	id, err := nextCreateID(cfg, folder)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(folder, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create status folder: %w", err)
+	}
+	path := filepath.Join(folder, fmt.Sprintf("%s-%s.%s.md", id, slug, opts.Kind))
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("cannot create work item: %s already exists", path)
+	}
+
+	frontMatter := map[string]interface{}{
+		"id":      id,
+		"title":   title,
+		"status":  status,
+		"kind":    opts.Kind,
+		"created": now.Format("2006-01-02"),
+	}
+	if err := writeWorkItemFrontMatter(path, frontMatter, bodyLines); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// validateCreateKind checks that kind names a configured template, as kira new requires.
+func validateCreateKind(kind string, cfg *config.Config) error {
+	if _, ok := cfg.Templates[kind]; ok {
+		return nil
+	}
+	kinds := make([]string, 0, len(cfg.Templates))
+	for name := range cfg.Templates {
+		kinds = append(kinds, name)
+	}
+	sort.Strings(kinds)
+	return fmt.Errorf("invalid kind '%s' (valid: %s)", kind, strings.Join(kinds, ", "))
+}
+
+// nextCreateID returns the ID for a new work item in folder: the next global ID, or the next ID
+// in folder with naming.per_folder_ids.
+func nextCreateID(cfg *config.Config, folder string) (string, error) {
+	var id string
+	var err error
+	if config.PerFolderIDs(cfg) {
+		id, err = validation.GetNextIDInFolder(cfg, folder)
+	} else {
+		id, err = validation.GetNextID(cfg)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get next ID: %w", err)
+	}
+	return id, nil
+}
+
+// readCreateTemplate reads the body for --template, a project file. Front matter at the top of
+// the file is dropped, since kira create writes its own.
+func readCreateTemplate(path string) ([]string, error) {
+	content, err := safeReadProjectFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == yamlSeparator {
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == yamlSeparator {
+				return lines[i+1:], nil
+			}
+		}
+	}
+	return lines, nil
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+	"kira/internal/validation"
+)
+
+func TestCreateWorkItem(t *testing.T) {
+	now := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+	files := map[string]string{
+		"1_todo/004-existing.task.md": listTestWorkItem("004", "Existing", "todo", "created: 2024-01-01\n"),
+		"4_done/007-shipped.task.md":  listTestWorkItem("007", "Shipped", "done", "created: 2024-01-01\n"),
+	}
+	load := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		return cfg
+	}
+
+	t.Run("writes the next id into the status folder", func(t *testing.T) {
+		cfg := load(t)
+
+		path, err := createWorkItemFromOptions(cfg, createOptions{Title: "Feature X", Kind: "prd", Status: "todo"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(".work", "1_todo", "008-feature-x.prd.md"), path)
+		assert.Equal(t, "---\nid: 008\ntitle: Feature X\nstatus: todo\nkind: prd\ncreated: 2024-06-01\n---\n\n# Feature X\n", mustReadFile(t, path))
+
+		result, err := validation.ValidateWorkItems(cfg)
+		require.NoError(t, err)
+		for _, validationErr := range result.Errors {
+			assert.NotContains(t, validationErr.File, "008-feature-x", validationErr.Message)
+		}
+	})
+
+	t.Run("prints only the path", func(t *testing.T) {
+		load(t)
+		require.NoError(t, createCmd.Flags().Set("title", "Piped"))
+		t.Cleanup(func() { _ = createCmd.Flags().Set("title", "") })
+
+		output, err := captureStdout(func() error { return runCreate(createCmd, nil) })
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(".work", "0_backlog", "008-piped.task.md")+"\n", output)
+	})
+
+	t.Run("defaults to default_status", func(t *testing.T) {
+		cfg := load(t)
+
+		path, err := createWorkItemFromOptions(cfg, createOptions{Title: "Later", Kind: "task"}, now)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(".work", "0_backlog", "008-later.task.md"), path)
+	})
+
+	t.Run("uses a template file as the body", func(t *testing.T) {
+		cfg := load(t)
+		require.NoError(t, os.WriteFile("body.md", []byte("---\nkind: ignored\n---\n## Steps\n- one\n"), 0o600))
+
+		path, err := createWorkItemFromOptions(cfg, createOptions{Title: "From template", Kind: "task", Template: "body.md"}, now)
+		require.NoError(t, err)
+		content := mustReadFile(t, path)
+		assert.True(t, strings.HasSuffix(content, "---\n## Steps\n- one\n"), content)
+		assert.NotContains(t, content, "ignored")
+	})
+
+	t.Run("rejects bad input before writing", func(t *testing.T) {
+		cfg := load(t)
+
+		_, err := createWorkItemFromOptions(cfg, createOptions{Kind: "task"}, now)
+		require.EqualError(t, err, "--title is required")
+		_, err = createWorkItemFromOptions(cfg, createOptions{Title: "a/b", Kind: "task"}, now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid title")
+		_, err = createWorkItemFromOptions(cfg, createOptions{Title: "X", Kind: "epic"}, now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid kind 'epic'")
+		_, err = createWorkItemFromOptions(cfg, createOptions{Title: "X", Kind: "task", Status: "nope"}, now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid status 'nope'")
+		_, err = createWorkItemFromOptions(cfg, createOptions{Title: "X", Kind: "task", Template: "../outside.md"}, now)
+		require.Error(t, err)
+
+		entries, err := os.ReadDir(filepath.Join(".work", "0_backlog"))
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
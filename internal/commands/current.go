@@ -14,6 +14,7 @@ import (
 
 	"kira/internal/config"
 	"kira/internal/git"
+	"kira/internal/validation"
 )
 
 var currentCmd = &cobra.Command{
@@ -196,7 +197,7 @@ func findWorkItemFileInAllStatusFolders(workItemID string, cfg *config.Config) (
 		}
 
 		// Search for work item in this status folder
-		foundPath, err := searchWorkItemInFolder(statusPath, workItemID, cfg)
+		foundPath, err := searchWorkItemInFolder(workFolder, statusPath, workItemID, cfg)
 		if err != nil {
 			return "", fmt.Errorf("failed to search for work item: %w", err)
 		}
@@ -225,39 +226,25 @@ func getStatusFolders(cfg *config.Config) []string {
 	return statusFolders
 }
 
-// searchWorkItemInFolder searches for a work item file by ID in a specific folder.
-func searchWorkItemInFolder(folderPath, workItemID string, cfg *config.Config) (string, error) {
-	var foundPath string
-	err := filepath.Walk(folderPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if info.IsDir() {
-			return nil
-		}
-
-		// Check if this is a work item file with the matching ID
-		if !isWorkItemFile(path) {
-			return nil
-		}
-
+// searchWorkItemInFolder searches for a work item file by ID in a specific status folder.
+func searchWorkItemInFolder(workFolder, folderPath, workItemID string, cfg *config.Config) (string, error) {
+	files, err := validation.StatusWorkItemFiles(cfg, workFolder, folderPath)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range files {
 		// Read the file to check the ID
 		content, err := safeReadFile(path, cfg)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		// Simple check for ID in front matter (unquoted, double-quoted, or single-quoted)
 		if hasWorkItemID(content, workItemID) {
-			foundPath = path
-			return filepath.SkipDir
+			return path, nil
 		}
-
-		return nil
-	})
-
-	return foundPath, err
+	}
+	return "", nil
 }
 
 // isWorkItemFile checks if a path is a work item file (not template or IDEAS.md).
@@ -1,7 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,9 +24,41 @@ const (
 )
 
 var doctorCmd = &cobra.Command{
-	Use:   "doctor",
-	Short: "Check for and fix duplicate work item IDs and field issues",
-	Long:  `Checks for and fixes duplicate work item IDs and field validation issues.`,
+	Use:     "doctor",
+	Aliases: []string{"validate"},
+	Short:   "Check for and fix duplicate work item IDs and field issues",
+	Long: `Checks for and fixes duplicate work item IDs and field validation issues.
+
+Also checks that status folder directories on disk match the case configured in
+status_folders. On case-insensitive filesystems (macOS, Windows) a mismatch such as
+2_Doing vs 2_doing works locally but breaks on case-sensitive ones (Linux CI).
+Use --fix to rename such directories to the configured name.
+
+With --fix, work items missing an id also get one inferred from the numeric prefix of
+their filename (001-title.prd.md gets id "001", zero-padded to validation.id_width).
+Files without a numeric prefix are reported as unfixable.
+
+Front matter indented with tabs, which YAML does not allow, is reported with the line
+number of the first tab. With --fix, the tabs in the indentation are replaced with two
+spaces each.
+
+Also checks the workspace itself: missing status folders, a missing kira.yml, a
+repository git refuses to use because of dubious ownership, and stale git worktrees.
+With --fix each is repaired after confirmation (--yes confirms all): the folders are
+created, the default kira.yml is written, the repository is added to git's
+safe.directory and git worktree prune is run. A missing git is only reported.
+
+With --branches, only lists the local branches named like kira start branches
+({id}-{kebab-title}) whose work item was deleted or is in a terminal status, with the
+id inferred from each branch name, and suggests kira prune. This check changes nothing
+and exits non-zero only with --strict.
+
+With --only-changed, only the work items changed since --base are validated: those in
+git diff --name-only <base>...HEAD, plus staged, unstaged and untracked ones. Nothing is
+fixed and the command fails when a changed work item has issues, for CI gates on large
+workspaces. Duplicate IDs involving a changed work item and workflow rules are still
+checked. Without --base or outside a git repository, every work item is validated
+after a warning.`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -38,17 +74,48 @@ var doctorCmd = &cobra.Command{
 			cfg.Validation.Strict = true
 		}
 
-		return runDoctor(cfg)
+		fix, _ := cmd.Flags().GetBool("fix")
+		if onlyChanged, _ := cmd.Flags().GetBool("only-changed"); onlyChanged {
+			if fix {
+				return fmt.Errorf("invalid flag combination: --only-changed cannot be used with --fix")
+			}
+			base, _ := cmd.Flags().GetString("base")
+			return runOnlyChangedCheck(cfg, base)
+		}
+		if branches, _ := cmd.Flags().GetBool("branches"); branches {
+			if fix {
+				return fmt.Errorf("invalid flag combination: --branches cannot be used with --fix")
+			}
+			return runBranchCheck(os.Stdout, cfg, strictFlag)
+		}
+		return runDoctor(cfg, fix)
 	},
 }
 
 func init() {
 	doctorCmd.Flags().Bool("strict", false, "Enable strict mode: flag fields not defined in configuration")
+	doctorCmd.Flags().Bool("fix", false, "Also repair the workspace (status folders, kira.yml, git safe.directory, stale worktrees), rename status folders whose case differs, replace tab indentation in front matter and infer missing ids")
+	doctorCmd.Flags().Bool("branches", false, "Only list branches whose work item was deleted or is done (read-only; fails with --strict)")
+	doctorCmd.Flags().Bool("only-changed", false, "Only validate the work items changed since --base, without fixing (fails on issues)")
+	doctorCmd.Flags().String("base", "", "Ref to compare against with --only-changed (e.g. origin/main)")
 }
 
 // runDoctor validates work items, applies automatic fixes, then reports what
 // was fixed and what still needs manual attention.
-func runDoctor(cfg *config.Config) error {
+func runDoctor(cfg *config.Config, fix bool) error {
+	// git is needed by most kira commands; report it up front but keep validating work items
+	gitErr := checkGitAvailable()
+	if gitErr != nil {
+		fmt.Printf("✗ %v\n\n", gitErr)
+	}
+
+	if err := checkStatusFolderCase(cfg, fix); err != nil {
+		return err
+	}
+	if err := checkWorkspaceEnvironment(cfg, fix, gitErr == nil); err != nil {
+		return err
+	}
+
 	validationResult, err := validateWorkItems(cfg)
 	if err != nil {
 		return err
@@ -62,7 +129,7 @@ func runDoctor(cfg *config.Config) error {
 	fmt.Println()
 	printCategorizedErrors(validationResult.Errors)
 
-	fixedCount := runAutoFixes(cfg)
+	fixedCount := runAutoFixes(cfg, fix)
 
 	// Re-validate after applying automatic fixes so that any issues which were
 	// successfully fixed (for example by applying default field values) are no
@@ -78,6 +145,131 @@ func runDoctor(cfg *config.Config) error {
 	return nil
 }
 
+// statusFolderCaseMismatch is a configured status folder whose directory exists on disk
+// only under a different case.
+type statusFolderCaseMismatch struct {
+	Status     string
+	Configured string // path relative to the work folder, as in status_folders
+	OnDisk     string // path relative to the work folder, as found on disk
+}
+
+// findStatusFolderCaseMismatches compares the configured status folders to the directory names
+// on disk case-sensitively. A folder is reported when no directory matches exactly but one
+// matches ignoring case.
+func findStatusFolderCaseMismatches(cfg *config.Config) ([]statusFolderCaseMismatch, error) {
+	workFolder, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []statusFolderCaseMismatch
+
+	for status, folder := range cfg.StatusFolders {
+		if folder == "" {
+			continue
+		}
+		parent := filepath.Dir(filepath.Clean(folder))
+		name := filepath.Base(folder)
+		entries, err := os.ReadDir(filepath.Join(workFolder, parent))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filepath.Join(workFolder, parent), err)
+		}
+
+		onDisk := ""
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			if entry.Name() == name {
+				onDisk = ""
+				break
+			}
+			if strings.EqualFold(entry.Name(), name) {
+				onDisk = entry.Name()
+			}
+		}
+		if onDisk != "" {
+			mismatches = append(mismatches, statusFolderCaseMismatch{
+				Status:     status,
+				Configured: filepath.Clean(folder),
+				OnDisk:     filepath.Join(parent, onDisk),
+			})
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].Configured < mismatches[j].Configured
+	})
+	return mismatches, nil
+}
+
+// checkStatusFolderCase reports status folders whose on-disk case differs from the configuration
+// and, with fix, renames them to the configured name.
+func checkStatusFolderCase(cfg *config.Config, fix bool) error {
+	mismatches, err := findStatusFolderCaseMismatches(cfg)
+	if err != nil {
+		return err
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	workFolder, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return err
+	}
+	for _, m := range mismatches {
+		if !fix {
+			fmt.Printf("⚠️  Status folder for '%s' is configured as '%s' but the directory on disk is '%s'; this breaks on case-sensitive filesystems (run 'kira doctor --fix' to rename it)\n",
+				m.Status, m.Configured, m.OnDisk)
+			continue
+		}
+		if err := renameDirCase(filepath.Join(workFolder, m.OnDisk), filepath.Join(workFolder, m.Configured)); err != nil {
+			return fmt.Errorf("failed to rename status folder %s to %s: %w", m.OnDisk, m.Configured, err)
+		}
+		fmt.Printf("✅ Renamed status folder '%s' to '%s'\n", m.OnDisk, m.Configured)
+	}
+	fmt.Println()
+	return nil
+}
+
+// renameDirCase renames a directory to a name differing only in case. It goes through a
+// temporary name because a direct rename is a no-op on some case-insensitive filesystems. A
+// directory with files tracked by git is renamed with git mv, so the index follows the new case.
+func renameDirCase(from, to string) error {
+	rename := os.Rename
+	if isGitTrackedDir(from) {
+		rename = gitMove
+	}
+	tmp := from + ".kira-rename"
+	if err := rename(from, tmp); err != nil {
+		return err
+	}
+	if err := rename(tmp, to); err != nil {
+		_ = rename(tmp, from)
+		return err
+	}
+	return nil
+}
+
+// isGitTrackedDir reports whether git tracks any file in dir. Outside a git repository it is false.
+func isGitTrackedDir(dir string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	output, err := executeCommand(ctx, "git", []string{"ls-files", "--", filepath.Base(dir)}, filepath.Dir(dir), false)
+	return err == nil && strings.TrimSpace(output) != ""
+}
+
+// gitMove renames from to to with git mv, staging the rename.
+func gitMove(from, to string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	_, err := executeCommand(ctx, "git", []string{"mv", filepath.Base(from), filepath.Base(to)}, filepath.Dir(from), false)
+	return err
+}
+
 // validateWorkItems validates all work items and returns the result.
 func validateWorkItems(cfg *config.Config) (*validation.ValidationResult, error) {
 	fmt.Println("Validating work items...")
@@ -88,11 +280,18 @@ func validateWorkItems(cfg *config.Config) (*validation.ValidationResult, error)
 	return validationResult, nil
 }
 
-// runAutoFixes runs all automatic fixes and returns the count of fixed issues.
-func runAutoFixes(cfg *config.Config) int {
+// runAutoFixes runs all automatic fixes and returns the count of fixed issues. Tab-indented front
+// matter is only rewritten and missing IDs only inferred from filenames with fix, before duplicate
+// IDs are resolved.
+func runAutoFixes(cfg *config.Config, fix bool) int {
 	fmt.Println("\nAttempting to fix issues...")
 	fixedCount := 0
 
+	if fix {
+		fixedCount += fixFrontMatterTabs(cfg)
+		fixedCount += fixMissingIDs(cfg)
+	}
+
 	if count := fixDuplicateIDs(cfg); count > 0 {
 		fixedCount += count
 	}
@@ -124,6 +323,74 @@ func fixDuplicateIDs(cfg *config.Config) int {
 	return 0
 }
 
+func fixFrontMatterTabs(cfg *config.Config) int {
+	tabResult, err := validation.FixFrontMatterTabs(cfg)
+	if err != nil {
+		return 0
+	}
+	successCount := 0
+	var failed []validation.ValidationError
+	for _, err := range tabResult.Errors {
+		if strings.HasPrefix(err.Message, "fixed tab indentation") {
+			successCount++
+		} else {
+			failed = append(failed, err)
+		}
+	}
+
+	if successCount > 0 {
+		fmt.Println("\n✅ Fixed tab indentation:")
+		for _, err := range tabResult.Errors {
+			if strings.HasPrefix(err.Message, "fixed tab indentation") {
+				fmt.Printf("  %s: %s\n", err.File, err.Message)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		fmt.Println("\n⚠️  Could not fix some tab indentation:")
+		for _, err := range failed {
+			fmt.Printf("  %s: %s\n", err.File, err.Message)
+		}
+	}
+
+	return successCount
+}
+
+func fixMissingIDs(cfg *config.Config) int {
+	idResult, err := validation.FixMissingIDs(cfg)
+	if err != nil {
+		return 0
+	}
+	successCount := 0
+	var failed []validation.ValidationError
+	for _, err := range idResult.Errors {
+		if strings.HasPrefix(err.Message, "fixed missing id") {
+			successCount++
+		} else {
+			failed = append(failed, err)
+		}
+	}
+
+	if successCount > 0 {
+		fmt.Println("\n✅ Fixed missing IDs:")
+		for _, err := range idResult.Errors {
+			if strings.HasPrefix(err.Message, "fixed missing id") {
+				fmt.Printf("  %s: %s\n", err.File, err.Message)
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		fmt.Println("\n⚠️  Could not fix some missing IDs:")
+		for _, err := range failed {
+			fmt.Printf("  %s: %s\n", err.File, err.Message)
+		}
+	}
+
+	return successCount
+}
+
 func fixHardcodedDateFormats(cfg *config.Config) int {
 	dateResult, err := validation.FixHardcodedDateFormats(cfg)
 	if err != nil {
@@ -0,0 +1,124 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// orphanedBranch is a local branch named like a kira start branch ({id}-{kebab-title}) whose
+// work item was deleted or is finished.
+type orphanedBranch struct {
+	Branch     string
+	WorkItemID string // inferred from the branch name
+	Status     string // "" when the work item no longer exists
+}
+
+// runBranchCheck lists the orphaned branches of the current repository (kira validate
+// --branches). It only reads: cleaning up is left to kira prune. With strict, finding any
+// orphaned branch is an error.
+func runBranchCheck(out io.Writer, cfg *config.Config, strict bool) error {
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		return fmt.Errorf("failed to get repository root: %w", err)
+	}
+	trunkBranch, err := determineTrunkBranch(cfg, "", repoRoot, false)
+	if err != nil {
+		return err
+	}
+	orphans, err := findOrphanedBranches(cfg, repoRoot, trunkBranch)
+	if err != nil {
+		return err
+	}
+	reportOrphanedBranches(out, orphans)
+	if strict && len(orphans) > 0 {
+		return fmt.Errorf("found %d orphaned branch(es)", len(orphans))
+	}
+	return nil
+}
+
+// findOrphanedBranches maps each local branch back to a work item id, the inverse of the branch
+// name kira start builds, and returns the branches whose work item no longer exists or is in a
+// terminal status. Trunk and branches that do not follow the naming are ignored.
+func findOrphanedBranches(cfg *config.Config, repoPath, trunkBranch string) ([]orphanedBranch, error) {
+	branches, err := localBranches(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := workItemStatusesByID(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var orphans []orphanedBranch
+	for _, branch := range branches {
+		if branch == trunkBranch {
+			continue
+		}
+		id, err := parseWorkItemIDFromBranch(branch, cfg)
+		if err != nil {
+			continue
+		}
+		status, exists := statuses[id]
+		if exists && !config.IsTerminalStatus(cfg, status) {
+			continue
+		}
+		orphans = append(orphans, orphanedBranch{Branch: branch, WorkItemID: id, Status: status})
+	}
+	return orphans, nil
+}
+
+// localBranches returns the names of the local branches of the repository at repoPath.
+func localBranches(repoPath string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	output, err := executeCommand(ctx, "git", []string{"for-each-ref", "--format=%(refname:short)", "refs/heads"}, repoPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+	var branches []string
+	for _, line := range strings.Split(output, "\n") {
+		if branch := strings.TrimSpace(line); branch != "" {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+// workItemStatusesByID maps the id of every work item in a status folder to its status.
+func workItemStatusesByID(cfg *config.Config) (map[string]string, error) {
+	statuses := make(map[string]string)
+	for _, status := range orderedStatuses(cfg, "") {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			_, id, _, _, _, err := extractWorkItemMetadata(path, cfg)
+			if err == nil && id != "" {
+				statuses[id] = status
+			}
+		}
+	}
+	return statuses, nil
+}
+
+// reportOrphanedBranches writes one line per orphaned branch with the inferred work item id.
+func reportOrphanedBranches(out io.Writer, orphans []orphanedBranch) {
+	if len(orphans) == 0 {
+		_, _ = fmt.Fprintln(out, "No orphaned branches found.")
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Orphaned branches (%d):\n", len(orphans))
+	for _, orphan := range orphans {
+		reason := "no longer exists"
+		if orphan.Status != "" {
+			reason = "is " + orphan.Status
+		}
+		_, _ = fmt.Fprintf(out, "  ✗ %s: work item %s %s\n", orphan.Branch, orphan.WorkItemID, reason)
+	}
+	_, _ = fmt.Fprintln(out, "\nRun 'kira prune' to remove the branches of finished work items; delete the others with 'git branch -d <branch>'.")
+}
@@ -0,0 +1,115 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+	"kira/internal/validation"
+)
+
+// runOnlyChangedCheck validates the work items changed against base (kira doctor --only-changed)
+// and fails when any of them has issues. Nothing is fixed. When the changed files cannot be
+// determined (no --base, not a git repository, an unknown base) every work item is validated
+// instead, after a warning on stderr.
+func runOnlyChangedCheck(cfg *config.Config, base string) error {
+	include, count, err := changedWorkItemFilter(cfg, base)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v; validating all work items\n", err)
+		return lintWorkItems(cfg)
+	}
+	if count == 0 {
+		fmt.Printf("No work items changed since %s.\n", base)
+		return nil
+	}
+
+	fmt.Printf("Validating %d work item(s) changed since %s...\n", count, base)
+	result, err := validation.ValidateWorkItemsMatching(cfg, include)
+	if err != nil {
+		return fmt.Errorf("failed to validate work items: %w", err)
+	}
+	if result.HasErrors() {
+		printCategorizedErrors(result.Errors)
+		return fmt.Errorf("validation failed")
+	}
+	fmt.Println("No issues found. All changed work items are valid.")
+	return nil
+}
+
+// changedWorkItemFilter returns a filter accepting the work item files changed against base: those
+// in git diff --name-only <base>...HEAD, those with staged or unstaged changes, and untracked ones.
+// It also returns how many work items changed.
+func changedWorkItemFilter(cfg *config.Config, base string) (func(file string) bool, int, error) {
+	if base == "" {
+		return nil, 0, fmt.Errorf("no --base given")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	dir := workspaceDir(cfg)
+	root, err := executeCommand(ctx, "git", []string{"rev-parse", "--show-toplevel"}, dir, false)
+	if err != nil {
+		return nil, 0, fmt.Errorf("not a git repository")
+	}
+	root = strings.TrimSpace(root)
+
+	changed := make(map[string]bool)
+	for _, args := range [][]string{
+		{"diff", "--name-only", base + "...HEAD"},
+		{"diff", "--name-only", "HEAD"},
+		{"ls-files", "--others", "--exclude-standard"},
+	} {
+		output, err := executeCommand(ctx, "git", args, root, false)
+		if err != nil {
+			return nil, 0, fmt.Errorf("cannot list changes against %s: %w", base, err)
+		}
+		for _, name := range strings.Split(strings.TrimSpace(output), "\n") {
+			if name != "" {
+				changed[comparablePath(filepath.Join(root, name))] = true
+			}
+		}
+	}
+
+	workFolder, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+	workFolder = comparablePath(workFolder) + string(filepath.Separator)
+	count := 0
+	for path := range changed {
+		if isChangedWorkItemFile(path, workFolder) {
+			count++
+		}
+	}
+	return func(file string) bool { return changed[comparablePath(file)] }, count, nil
+}
+
+// isChangedWorkItemFile reports whether a changed path is a work item file kira validates: a
+// markdown file under the work folder that still exists and is not a template or IDEAS.md.
+func isChangedWorkItemFile(path, workFolder string) bool {
+	if !strings.HasPrefix(path, workFolder) || !strings.HasSuffix(path, ".md") {
+		return false
+	}
+	if name := strings.TrimPrefix(path, workFolder); strings.Contains(name, "template") || strings.HasSuffix(name, "IDEAS.md") {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// comparablePath returns the absolute path of path with symlinks resolved where it exists, so
+// paths from git and from the work folder walk compare equal.
+func comparablePath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		return resolved
+	}
+	return abs
+}
@@ -0,0 +1,211 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// environmentIssue is a workspace problem kira doctor detects outside the work items, with the
+// remediation --fix offers for it.
+type environmentIssue struct {
+	Problem string       // what is wrong, e.g. "Status folder for 'todo' (1_todo) is missing"
+	Fix     string       // the remediation, phrased to follow "run 'kira doctor --fix' to"
+	Apply   func() error // applies the remediation
+}
+
+// checkWorkspaceEnvironment reports missing status folders, a missing kira.yml, a repository
+// git refuses to use because of dubious ownership, and stale git worktrees. Without fix each
+// issue is only reported; with fix each remediation is applied after confirmation (--yes answers
+// for you). Declined or failed remediations are reported and do not stop kira doctor. The git
+// checks run only when git is available.
+func checkWorkspaceEnvironment(cfg *config.Config, fix, gitAvailable bool) error {
+	issues, err := missingStatusFolderIssues(cfg)
+	if err != nil {
+		return err
+	}
+	issues = append(issues, missingConfigIssues(cfg)...)
+	if gitAvailable {
+		issues = append(issues, gitEnvironmentIssues(workspaceDir(cfg))...)
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	for _, issue := range issues {
+		if !fix {
+			fmt.Printf("⚠️  %s (run 'kira doctor --fix' to %s)\n", issue.Problem, issue.Fix)
+			continue
+		}
+		applyEnvironmentFix(issue)
+	}
+	fmt.Println()
+	return nil
+}
+
+// applyEnvironmentFix asks to apply the remediation of issue and reports the outcome.
+func applyEnvironmentFix(issue environmentIssue) {
+	fmt.Printf("⚠️  %s\n", issue.Problem)
+	ok, err := confirm(capitalizeFirst(issue.Fix) + "?")
+	switch {
+	case err != nil:
+		fmt.Printf("   Skipped: %v\n", err)
+	case !ok:
+		fmt.Println("   Skipped.")
+	default:
+		if err := issue.Apply(); err != nil {
+			fmt.Printf("✗ Failed to %s: %v\n", issue.Fix, err)
+			return
+		}
+		fmt.Printf("✅ Done: %s\n", issue.Fix)
+	}
+}
+
+// capitalizeFirst upper-cases the first letter of s.
+func capitalizeFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// workspaceDir is the directory kira.yml lives in (or would), falling back to the current one.
+func workspaceDir(cfg *config.Config) string {
+	if cfg.ConfigDir != "" {
+		return cfg.ConfigDir
+	}
+	return "."
+}
+
+// missingStatusFolderIssues reports the status_folders directories that do not exist under the
+// work folder. Folders that exist with different case are left to checkStatusFolderCase.
+func missingStatusFolderIssues(cfg *config.Config) ([]environmentIssue, error) {
+	workFolder, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return nil, err
+	}
+	mismatches, err := findStatusFolderCaseMismatches(cfg)
+	if err != nil {
+		return nil, err
+	}
+	caseMismatch := make(map[string]bool, len(mismatches))
+	for _, m := range mismatches {
+		caseMismatch[m.Status] = true
+	}
+
+	statuses := make([]string, 0, len(cfg.StatusFolders))
+	for status := range cfg.StatusFolders {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	var issues []environmentIssue
+	for _, status := range statuses {
+		folder := cfg.StatusFolders[status]
+		if folder == "" || caseMismatch[status] {
+			continue
+		}
+		folderPath := filepath.Join(workFolder, folder)
+		if _, err := os.Stat(folderPath); !os.IsNotExist(err) {
+			continue
+		}
+		issues = append(issues, environmentIssue{
+			Problem: fmt.Sprintf("Status folder for '%s' (%s) is missing", status, folder),
+			Fix:     fmt.Sprintf("create status folder %s", folder),
+			Apply:   func() error { return createStatusFolder(folderPath) },
+		})
+	}
+	return issues, nil
+}
+
+// createStatusFolder creates a status folder with a .gitkeep, as kira init does.
+func createStatusFolder(folderPath string) error {
+	if err := os.MkdirAll(folderPath, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(folderPath, ".gitkeep"), []byte(""), 0o600)
+}
+
+// missingConfigIssues reports a workspace without kira.yml (nor the legacy .work/kira.yml). The
+// fix writes the default configuration, as kira init does.
+func missingConfigIssues(cfg *config.Config) []environmentIssue {
+	dir := workspaceDir(cfg)
+	if _, err := os.Stat(config.FilePath(dir)); !os.IsNotExist(err) {
+		return nil
+	}
+	return []environmentIssue{{
+		Problem: "No kira.yml found; kira is running with the default configuration",
+		Fix:     "write the default configuration to kira.yml",
+		Apply: func() error {
+			// Load afresh so flags such as --strict are not written to kira.yml
+			defaults, err := config.LoadConfigFromDir(dir)
+			if err != nil {
+				return err
+			}
+			return config.SaveConfigToDir(defaults, dir)
+		},
+	}}
+}
+
+// gitEnvironmentIssues reports a repository at dir that git refuses to use because it is owned
+// by another user (dubious ownership), and worktrees whose directories no longer exist. A dir
+// that is not a git repository has neither.
+func gitEnvironmentIssues(dir string) []environmentIssue {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	_, err := executeCommand(ctx, "git", []string{"rev-parse", "--git-dir"}, dir, false)
+	if err != nil {
+		if repo, ok := dubiousOwnershipRepository(err.Error(), dir); ok {
+			return []environmentIssue{{
+				Problem: fmt.Sprintf("git refuses to use %s because it is owned by another user (dubious ownership)", repo),
+				Fix:     fmt.Sprintf("add %s to git's safe.directory", repo),
+				Apply:   func() error { return runDoctorGit(dir, "config", "--global", "--add", "safe.directory", repo) },
+			}}
+		}
+		return nil
+	}
+
+	output, err := executeCommand(ctx, "git", []string{"worktree", "prune", "--dry-run", "-v"}, dir, false)
+	if err != nil || strings.TrimSpace(output) == "" {
+		return nil
+	}
+	stale := len(strings.Split(strings.TrimSpace(output), "\n"))
+	return []environmentIssue{{
+		Problem: fmt.Sprintf("git lists stale worktrees whose directories no longer exist (%d)", stale),
+		Fix:     "run git worktree prune",
+		Apply:   func() error { return runDoctorGit(dir, "worktree", "prune") },
+	}}
+}
+
+// dubiousOwnershipRepository reports whether a git error is git's dubious ownership refusal and
+// returns the repository it names, or the absolute path of dir when it names none.
+func dubiousOwnershipRepository(message, dir string) (string, bool) {
+	if !strings.Contains(message, "dubious ownership") {
+		return "", false
+	}
+	if _, rest, ok := strings.Cut(message, "repository at '"); ok {
+		if repo, _, ok := strings.Cut(rest, "'"); ok && repo != "" {
+			return repo, true
+		}
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir, true
+	}
+	return abs, true
+}
+
+// runDoctorGit runs a git command for a kira doctor --fix remediation.
+func runDoctorGit(dir string, args ...string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	_, err := executeCommand(ctx, "git", args, dir, false)
+	return err
+}
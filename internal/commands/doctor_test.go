@@ -3,6 +3,8 @@ package commands
 import (
 	"bytes"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -255,3 +257,334 @@ func TestFixFieldIssuesOutputFormat(t *testing.T) {
 		assert.Equal(t, 1, failureCount, "should have 1 failure message")
 	})
 }
+
+func TestStatusFolderCase(t *testing.T) {
+	setup := func(t *testing.T, dirs ...string) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		for _, dir := range dirs {
+			require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, ".work", dir), 0o700))
+		}
+		cfg := testCfgWithDir(tmpDir)
+		cfg.StatusFolders = map[string]string{"todo": "1_todo", "doing": "2_doing", "done": "4_done"}
+		return cfg
+	}
+
+	t.Run("reports directories that differ only in case", func(t *testing.T) {
+		cfg := setup(t, "1_todo", "2_Doing")
+
+		mismatches, err := findStatusFolderCaseMismatches(cfg)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		assert.Equal(t, statusFolderCaseMismatch{Status: "doing", Configured: "2_doing", OnDisk: "2_Doing"}, mismatches[0])
+	})
+
+	t.Run("ignores exact matches and missing folders", func(t *testing.T) {
+		cfg := setup(t, "1_todo", "2_doing")
+
+		mismatches, err := findStatusFolderCaseMismatches(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, mismatches)
+	})
+
+	t.Run("warns without fix and renames with fix", func(t *testing.T) {
+		cfg := setup(t, "2_Doing")
+		workFolder, err := config.GetWorkFolderAbsPath(cfg)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(workFolder, "2_Doing", "001-item.task.md"), []byte("x"), 0o600))
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		warnErr := checkStatusFolderCase(cfg, false)
+		fixErr := checkStatusFolderCase(cfg, true)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+
+		require.NoError(t, warnErr)
+		require.NoError(t, fixErr)
+		assert.Contains(t, buf.String(), "configured as '2_doing' but the directory on disk is '2_Doing'")
+		assert.Contains(t, buf.String(), "Renamed status folder '2_Doing' to '2_doing'")
+
+		_, err = os.Stat(filepath.Join(workFolder, "2_doing", "001-item.task.md"))
+		require.NoError(t, err, "work items move with the renamed folder")
+		mismatches, err := findStatusFolderCaseMismatches(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, mismatches)
+	})
+	t.Run("renames a tracked folder with git mv", func(t *testing.T) {
+		cfg := setup(t, "2_Doing")
+		initGitRepo(t, cfg.ConfigDir)
+		workFolder, err := config.GetWorkFolderAbsPath(cfg)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(workFolder, "2_Doing", "001-item.task.md"), []byte("x"), 0o600))
+		runGit(t, cfg.ConfigDir, "add", ".work")
+		runGit(t, cfg.ConfigDir, "commit", "-m", "add work item")
+
+		_, err = captureStdout(func() error { return checkStatusFolderCase(cfg, true) })
+		require.NoError(t, err)
+
+		assert.FileExists(t, filepath.Join(workFolder, "2_doing", "001-item.task.md"))
+		// #nosec G204 -- test reads the index of its temp repository
+		cmd := exec.Command("git", "ls-files", ".work")
+		cmd.Dir = cfg.ConfigDir
+		output, err := cmd.Output()
+		require.NoError(t, err)
+		assert.Equal(t, ".work/2_doing/001-item.task.md\n", string(output))
+	})
+}
+
+func TestDoctorFixMissingIDs(t *testing.T) {
+	runDoctorCapture := func(t *testing.T, cfg *config.Config, fix bool) string {
+		t.Helper()
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		err := runDoctor(cfg, fix)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		require.NoError(t, err)
+		return buf.String()
+	}
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir("/") }()
+	cfg := testCfgWithDir(tmpDir)
+	cfg.Validation = config.ValidationConfig{
+		RequiredFields: []string{"id", "title", "status", "kind", "created"},
+		IDFormat:       "^\\d{3}$",
+		IDWidth:        3,
+		StatusValues:   []string{"todo"},
+	}
+	todoDir := filepath.Join(tmpDir, ".work", "1_todo")
+	require.NoError(t, os.MkdirAll(todoDir, 0o700))
+	noID := "---\ntitle: Truncated\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n---\n"
+	fixable := filepath.Join(todoDir, "004-truncated.prd.md")
+	unfixable := filepath.Join(todoDir, "truncated.prd.md")
+	require.NoError(t, os.WriteFile(fixable, []byte(noID), 0o600))
+	require.NoError(t, os.WriteFile(unfixable, []byte(noID), 0o600))
+
+	t.Run("leaves missing ids alone without fix", func(t *testing.T) {
+		output := runDoctorCapture(t, cfg, false)
+		assert.NotContains(t, output, "Fixed missing IDs")
+
+		content, err := os.ReadFile(fixable)
+		require.NoError(t, err)
+		assert.Equal(t, noID, string(content))
+	})
+
+	t.Run("infers ids from filenames with fix", func(t *testing.T) {
+		output := runDoctorCapture(t, cfg, true)
+		assert.Contains(t, output, "Fixed missing IDs")
+		assert.Contains(t, output, "fixed missing id: set to 004 from the filename")
+		assert.Contains(t, output, "truncated.prd.md: failed to fix missing id: filename has no numeric prefix")
+
+		content, err := os.ReadFile(fixable)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(content), "---\nid: \"004\"\n"))
+		content, err = os.ReadFile(unfixable)
+		require.NoError(t, err)
+		assert.Equal(t, noID, string(content))
+	})
+}
+
+func TestDoctorBranches(t *testing.T) {
+	// setup creates a repository with done work item 001, 002 in doing, and branches for both,
+	// for the deleted work item 009, and one that does not follow the kira naming.
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		setupListWorkspace(t, map[string]string{
+			"4_done/001-login.task.md": listTestWorkItem("001", "Login", "done", ""),
+			"2_doing/002-api.task.md":  listTestWorkItem("002", "API", "doing", ""),
+		})
+		dir, err := os.Getwd()
+		require.NoError(t, err)
+		repoDir, err := filepath.EvalSymlinks(dir)
+		require.NoError(t, err)
+		runGit(t, repoDir, "init", "-b", "main")
+		runGit(t, repoDir, "config", "user.email", "test@example.com")
+		runGit(t, repoDir, "config", "user.name", "Test")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "initial")
+		for _, branch := range []string{"001-login", "002-api", "009-old-idea", "spike-cache"} {
+			runGit(t, repoDir, "branch", branch)
+		}
+		cfg := testCfgWithDir(repoDir)
+		cfg.Validation.IDFormat = "^\\d{3}$"
+		return cfg
+	}
+
+	t.Run("reports branches of deleted and done work items with the inferred id", func(t *testing.T) {
+		cfg := setup(t)
+
+		var out bytes.Buffer
+		require.NoError(t, runBranchCheck(&out, cfg, false))
+
+		output := out.String()
+		assert.Contains(t, output, "Orphaned branches (2):")
+		assert.Contains(t, output, "✗ 001-login: work item 001 is done")
+		assert.Contains(t, output, "✗ 009-old-idea: work item 009 no longer exists")
+		assert.Contains(t, output, "kira prune")
+		assert.NotContains(t, output, "002-api")
+		assert.NotContains(t, output, "spike-cache")
+	})
+
+	t.Run("fails only with strict", func(t *testing.T) {
+		cfg := setup(t)
+
+		var out bytes.Buffer
+		err := runBranchCheck(&out, cfg, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "found 2 orphaned branch(es)")
+	})
+
+	t.Run("reports nothing when every branch has an active work item", func(t *testing.T) {
+		cfg := setup(t)
+		for _, branch := range []string{"001-login", "009-old-idea"} {
+			runGit(t, cfg.ConfigDir, "branch", "-D", branch)
+		}
+
+		var out bytes.Buffer
+		require.NoError(t, runBranchCheck(&out, cfg, true))
+		assert.Contains(t, out.String(), "No orphaned branches found.")
+	})
+}
+
+func TestDoctorWorkspaceEnvironment(t *testing.T) {
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, ".work", "1_todo"), 0o700))
+		cfg := testCfgWithDir(tmpDir)
+		cfg.StatusFolders = map[string]string{"todo": "1_todo", "doing": "2_doing"}
+		return cfg
+	}
+	withYes := func(t *testing.T) {
+		t.Helper()
+		oldYes := assumeYes
+		assumeYes = true
+		t.Cleanup(func() { assumeYes = oldYes })
+	}
+
+	t.Run("reports missing status folders and kira.yml without fix", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := captureStdout(func() error { return checkWorkspaceEnvironment(cfg, false, false) })
+		require.NoError(t, err)
+		assert.Contains(t, output, "Status folder for 'doing' (2_doing) is missing (run 'kira doctor --fix' to create status folder 2_doing)")
+		assert.Contains(t, output, "No kira.yml found")
+		assert.NotContains(t, output, "'todo'")
+		assert.NoDirExists(t, filepath.Join(cfg.ConfigDir, ".work", "2_doing"))
+		assert.NoFileExists(t, filepath.Join(cfg.ConfigDir, "kira.yml"))
+	})
+
+	t.Run("creates missing status folders with fix", func(t *testing.T) {
+		cfg := setup(t)
+		withYes(t)
+
+		output, err := captureStdout(func() error { return checkWorkspaceEnvironment(cfg, true, false) })
+		require.NoError(t, err)
+		assert.Contains(t, output, "✅ Done: create status folder 2_doing")
+		assert.FileExists(t, filepath.Join(cfg.ConfigDir, ".work", "2_doing", ".gitkeep"))
+
+		issues, err := missingStatusFolderIssues(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+	})
+
+	t.Run("writes the default kira.yml with fix", func(t *testing.T) {
+		cfg := setup(t)
+		cfg.Validation.Strict = true
+		withYes(t)
+
+		output, err := captureStdout(func() error { return checkWorkspaceEnvironment(cfg, true, false) })
+		require.NoError(t, err)
+		assert.Contains(t, output, "✅ Done: write the default configuration to kira.yml")
+
+		written, err := config.LoadConfigFromDir(cfg.ConfigDir)
+		require.NoError(t, err)
+		assert.Equal(t, config.DefaultConfig.StatusFolders, written.StatusFolders)
+		assert.False(t, written.Validation.Strict, "flags of this run are not persisted")
+		assert.Empty(t, missingConfigIssues(cfg))
+	})
+
+	t.Run("skips fixes that are not confirmed", func(t *testing.T) {
+		cfg := setup(t)
+		oldYes := assumeYes
+		assumeYes = false
+		t.Cleanup(func() { assumeYes = oldYes })
+
+		output, err := captureStdout(func() error { return checkWorkspaceEnvironment(cfg, true, false) })
+		require.NoError(t, err)
+		assert.Contains(t, output, "Skipped")
+		assert.NoDirExists(t, filepath.Join(cfg.ConfigDir, ".work", "2_doing"))
+	})
+
+	t.Run("recognizes dubious ownership errors", func(t *testing.T) {
+		message := "exit status 128: fatal: detected dubious ownership in repository at '/srv/repo'\nTo add an exception for this directory, call:\n\n\tgit config --global --add safe.directory /srv/repo"
+		repo, ok := dubiousOwnershipRepository(message, ".")
+		assert.True(t, ok)
+		assert.Equal(t, "/srv/repo", repo)
+
+		_, ok = dubiousOwnershipRepository("exit status 128: fatal: not a git repository", ".")
+		assert.False(t, ok)
+	})
+}
+
+func TestDoctorOnlyChanged(t *testing.T) {
+	// setup commits work item 001 with an invalid status and a valid 002 on main.
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-login.task.md": listTestWorkItem("001", "Login", "bogus", "created: 2024-01-01\n"),
+			"1_todo/002-api.task.md":   listTestWorkItem("002", "API", "todo", "created: 2024-01-01\n"),
+		})
+		dir, err := os.Getwd()
+		require.NoError(t, err)
+		repoDir, err := filepath.EvalSymlinks(dir)
+		require.NoError(t, err)
+		runGit(t, repoDir, "init", "-b", "main")
+		runGit(t, repoDir, "config", "user.email", "test@example.com")
+		runGit(t, repoDir, "config", "user.name", "Test")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "initial")
+		return testCfgWithDir(repoDir)
+	}
+
+	t.Run("validates only the work item with a staged change", func(t *testing.T) {
+		cfg := setup(t)
+		path := filepath.Join(cfg.ConfigDir, ".work", "1_todo", "002-api.task.md")
+		require.NoError(t, os.WriteFile(path, []byte(listTestWorkItem("002", "API", "nope", "created: 2024-01-01\n")), 0o600))
+		runGit(t, cfg.ConfigDir, "add", path)
+
+		output, err := captureStdout(func() error { return runOnlyChangedCheck(cfg, "main") })
+		require.Error(t, err)
+		assert.Equal(t, "validation failed", err.Error())
+		assert.Contains(t, output, "Validating 1 work item(s) changed since main...")
+		assert.Contains(t, output, "002-api.task.md")
+		assert.NotContains(t, output, "001-login.task.md", "unchanged work items are not checked")
+	})
+
+	t.Run("passes when nothing changed", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := captureStdout(func() error { return runOnlyChangedCheck(cfg, "main") })
+		require.NoError(t, err)
+		assert.Contains(t, output, "No work items changed since main.")
+	})
+
+	t.Run("falls back to validating every work item without a base", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := captureStdout(func() error { return runOnlyChangedCheck(cfg, "") })
+		require.Error(t, err)
+		assert.Contains(t, output, "001-login.task.md")
+	})
+}
@@ -284,6 +284,8 @@ func maybeCleanupWorktree(cfg *config.Config, ctx *doneContext, noCleanup bool,
 // findWorktreePathForWorkItem finds the worktree path for a work item by reconstructing the path
 // that would have been created by kira start. It resolves the work item file by ID by searching
 // across all status folders (e.g. review, done) so the current path is used after the file may have been moved.
+// The branch is the one kira start created, found among the local branches whatever title length
+// it was started with, so it does not depend on the current --max-title-length settings.
 func findWorktreePathForWorkItem(cfg *config.Config, workItemID, _ string) (string, error) {
 	// Look across all status folders so we find the file in its current location (e.g. 4_done after move)
 	currentPath, err := findWorkItemFileInAllStatusFolders(workItemID, cfg)
@@ -296,34 +298,32 @@ func findWorktreePathForWorkItem(cfg *config.Config, workItemID, _ string) (stri
 		return "", err
 	}
 
-	// Sanitize title (same logic as kira start)
-	sanitizedTitle, err := sanitizeTitle(title, workItemID)
+	// Check if git recognizes this as a worktree
+	repoRoot, err := getRepoRoot()
 	if err != nil {
-		return "", err
+		return "", nil // Can't verify, skip cleanup
 	}
 
-	// Build branch name (same as kira start)
-	branchName := fmt.Sprintf("%s-%s", workItemID, sanitizedTitle)
+	// Find the branch kira start created
+	branchName, err := findStartBranch(repoRoot, workItemID, title)
+	if err != nil {
+		return "", err
+	}
+	if branchName == "" {
+		return "", nil // No branch, so no worktree with it checked out (idempotent)
+	}
+	sanitizedTitle := strings.TrimPrefix(branchName, workItemID+"-")
 
-	// Derive worktree root (same logic as kira start)
+	// Derive worktree path (same logic as kira start)
 	behavior := inferWorkspaceBehavior(cfg)
-	worktreeRoot, err := deriveWorktreeRoot(cfg, behavior)
+	_, worktreePath, err := resolveWorkItemWorktree(cfg, behavior, workItemID, sanitizedTitle, branchName)
 	if err != nil {
 		return "", err
 	}
-
-	// Build worktree path
-	worktreePath := filepath.Join(worktreeRoot, branchName)
 	if behavior == WorkspaceBehaviorPolyrepo {
 		// For polyrepo, the main worktree is in a "main" subdirectory
 		worktreePath = filepath.Join(worktreePath, "main")
 	}
-
-	// Check if git recognizes this as a worktree
-	repoRoot, err := getRepoRoot()
-	if err != nil {
-		return "", nil // Can't verify, skip cleanup
-	}
 	checkCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	// List all worktrees and check if our path is in the list
@@ -0,0 +1,102 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export all work items as JSON",
+	Long: `Exports every work item in the status folders as a single JSON array, for backups
+and external tooling. Each item has its path, status (from its status folder), front matter
+and body.
+
+Files that cannot be parsed are included with an "error" field instead of being left out.
+
+Examples:
+  kira export > backup.json
+  kira export --frontmatter-only       # Omit bodies for smaller output`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+func init() {
+	exportCmd.Flags().Bool("frontmatter-only", false, "Exclude work item bodies")
+}
+
+// exportedWorkItem is one work item in the kira export output.
+type exportedWorkItem struct {
+	Path        string                 `json:"path"`
+	Status      string                 `json:"status"`
+	FrontMatter map[string]interface{} `json:"front_matter,omitempty"`
+	Body        *string                `json:"body,omitempty"`
+	Error       string                 `json:"error,omitempty"`
+}
+
+func runExport(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	frontMatterOnly := false
+	if cmd != nil {
+		frontMatterOnly, _ = cmd.Flags().GetBool("frontmatter-only")
+	}
+
+	items, err := collectExportedWorkItems(cfg, frontMatterOnly)
+	if err != nil {
+		return err
+	}
+	return writeExportedWorkItems(os.Stdout, items)
+}
+
+// collectExportedWorkItems parses every work item in status folder order. Malformed files are
+// kept with their parse error.
+func collectExportedWorkItems(cfg *config.Config, frontMatterOnly bool) ([]exportedWorkItem, error) {
+	items := []exportedWorkItem{}
+	for _, status := range orderedStatuses(cfg, "") {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			items = append(items, exportWorkItem(path, status, cfg, frontMatterOnly))
+		}
+	}
+	return items, nil
+}
+
+func exportWorkItem(path, status string, cfg *config.Config, frontMatterOnly bool) exportedWorkItem {
+	item := exportedWorkItem{Path: path, Status: status}
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	item.FrontMatter = frontMatter
+	if !frontMatterOnly {
+		body := strings.Trim(strings.Join(bodyLines, "\n"), "\n")
+		item.Body = &body
+	}
+	return item
+}
+
+func writeExportedWorkItems(w io.Writer, items []exportedWorkItem) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(items)
+}
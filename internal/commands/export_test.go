@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runExportCapture runs kira export with the given flags and returns stdout.
+func runExportCapture(t *testing.T, flags map[string]string) string {
+	t.Helper()
+	for name, value := range flags {
+		require.NoError(t, exportCmd.Flags().Set(name, value))
+	}
+	t.Cleanup(func() {
+		for name := range flags {
+			flag := exportCmd.Flags().Lookup(name)
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+	})
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+	runErr := runExport(exportCmd, nil)
+	_ = w.Close()
+	os.Stdout = oldStdout
+	require.NoError(t, runErr)
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	require.NoError(t, err)
+	return buf.String()
+}
+
+func TestRunExport(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-first.task.md":   listTestWorkItem("001", "First", "todo", "tags:\n  - a\n"),
+		"0_backlog/002-second.md":    listTestWorkItem("002", "Second", "backlog", ""),
+		"2_doing/003-broken.task.md": "---\nid: 003\ntitle: [unclosed\n---\n\n# Broken\n",
+		"1_todo/template.task.md":    listTestWorkItem("999", "Template", "todo", ""),
+	}
+
+	type exported struct {
+		Path        string                 `json:"path"`
+		Status      string                 `json:"status"`
+		FrontMatter map[string]interface{} `json:"front_matter"`
+		Body        *string                `json:"body"`
+		Error       string                 `json:"error"`
+	}
+
+	t.Run("exports all items with front matter and body", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		var items []exported
+		require.NoError(t, json.Unmarshal([]byte(runExportCapture(t, nil)), &items))
+
+		require.Len(t, items, 3)
+		assert.Equal(t, "backlog", items[0].Status)
+		assert.Equal(t, filepath.Join(".work", "0_backlog", "002-second.md"), items[0].Path)
+
+		first := items[1]
+		assert.Equal(t, "todo", first.Status)
+		assert.Equal(t, "001", first.FrontMatter["id"])
+		assert.Equal(t, []interface{}{"a"}, first.FrontMatter["tags"])
+		require.NotNil(t, first.Body)
+		assert.Equal(t, "# First", *first.Body)
+		assert.Empty(t, first.Error)
+	})
+
+	t.Run("includes malformed files with an error", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		var items []exported
+		require.NoError(t, json.Unmarshal([]byte(runExportCapture(t, nil)), &items))
+
+		broken := items[2]
+		assert.Equal(t, "doing", broken.Status)
+		assert.Equal(t, filepath.Join(".work", "2_doing", "003-broken.task.md"), broken.Path)
+		assert.NotEmpty(t, broken.Error)
+		assert.Nil(t, broken.FrontMatter)
+	})
+
+	t.Run("frontmatter-only omits bodies", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runExportCapture(t, map[string]string{"frontmatter-only": "true"})
+
+		assert.NotContains(t, output, `"body"`)
+		assert.Contains(t, output, `"front_matter"`)
+	})
+
+	t.Run("empty workspace exports an empty array", func(t *testing.T) {
+		setupListWorkspace(t, nil)
+		assert.Equal(t, "[]\n", runExportCapture(t, nil))
+	})
+}
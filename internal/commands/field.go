@@ -0,0 +1,181 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+var fieldCmd = &cobra.Command{
+	Use:   "field",
+	Short: "Inspect work item front matter fields",
+	Long:  `Reports on the values of a front matter field across work items.`,
+}
+
+var fieldValuesCmd = &cobra.Command{
+	Use:   "values <field>",
+	Short: "List the distinct values of a field across work items",
+	Long: `Scans the work items in every status (or only --status) and lists the distinct
+values of a front matter field, sorted, with the number of work items that have each one.
+List fields are flattened, so a work item with reviewer: [a, b] counts for a and for b.
+Emails are shown with the user's name when it is known (kira users), which makes
+kira field values assigned or kira field values reviewer list the people involved.
+Read-only.
+
+Examples:
+  kira field values reviewer
+  kira field values assigned --status doing
+  kira field values tags --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFieldValues,
+}
+
+func init() {
+	fieldCmd.AddCommand(fieldValuesCmd)
+	fieldValuesCmd.Flags().String("status", "", "Only scan work items in this status (e.g. todo)")
+	fieldValuesCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+// fieldValueCount is one distinct value of a field and the number of work items that have it.
+type fieldValueCount struct {
+	Value string `json:"value"`
+	Name  string `json:"name,omitempty"`
+	Count int    `json:"count"`
+}
+
+func runFieldValues(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	field := strings.TrimSpace(args[0])
+	if err := validateAssignFieldName(field); err != nil {
+		return err
+	}
+	status, _ := cmd.Flags().GetString("status")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	if err := validateListStatus(status, cfg); err != nil {
+		return err
+	}
+
+	counts, err := collectFieldValues(cfg, orderedStatuses(cfg, status), field, assigneeNames(cfg))
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		return displayFieldValuesJSON(os.Stdout, counts)
+	}
+	displayFieldValues(os.Stdout, field, counts)
+	return nil
+}
+
+// collectFieldValues counts the work items in statuses per distinct value of field, sorted by
+// value. A work item counts once per value even if a list repeats it. Emails are compared
+// case-insensitively and get their user's name from names.
+func collectFieldValues(cfg *config.Config, statuses []string, field string, names map[string]string) ([]fieldValueCount, error) {
+	counts := make(map[string]*fieldValueCount)
+	for _, status := range statuses {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", path, err)
+				continue
+			}
+			seen := make(map[string]bool)
+			for _, value := range fieldValues(frontMatter[field]) {
+				key := fieldValueKey(value)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if counts[key] == nil {
+					counts[key] = &fieldValueCount{Value: value, Name: names[key]}
+				}
+				counts[key].Count++
+			}
+		}
+	}
+
+	rows := make([]fieldValueCount, 0, len(counts))
+	for _, count := range counts {
+		rows = append(rows, *count)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return strings.ToLower(rows[i].Value) < strings.ToLower(rows[j].Value)
+	})
+	return rows, nil
+}
+
+// fieldValues returns the non-empty values of a front matter field, flattening lists. Dates
+// are written as kira list --format writes them, and assignee objects count by their email.
+func fieldValues(value interface{}) []string {
+	var items []interface{}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		items = v
+	default:
+		items = []interface{}{v}
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		if text := strings.TrimSpace(listFormatValue(item)); text != "" {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+// fieldValueKey is the key values are counted under: emails in lowercase, anything else as is.
+func fieldValueKey(value string) string {
+	if strings.Contains(value, "@") {
+		return strings.ToLower(value)
+	}
+	return value
+}
+
+// displayFieldValues prints one row per value with its count, showing known users as
+// "Name <email>".
+func displayFieldValues(out io.Writer, field string, counts []fieldValueCount) {
+	if len(counts) == 0 {
+		_, _ = fmt.Fprintf(out, "No work items have a value for %s.\n", field)
+		return
+	}
+	labels := make([]string, len(counts))
+	width := len("VALUE")
+	for i, count := range counts {
+		labels[i] = count.Value
+		if count.Name != "" {
+			labels[i] = fmt.Sprintf("%s <%s>", count.Name, count.Value)
+		}
+		width = max(width, len(labels[i]))
+	}
+	_, _ = fmt.Fprintf(out, "%-*s  %s\n", width, "VALUE", "COUNT")
+	for i, count := range counts {
+		_, _ = fmt.Fprintf(out, "%-*s  %5d\n", width, labels[i], count.Count)
+	}
+}
+
+func displayFieldValuesJSON(out io.Writer, counts []fieldValueCount) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(counts)
+}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runFieldValuesCapture runs kira field values with the given field and flags and returns stdout.
+func runFieldValuesCapture(t *testing.T, field string, flags map[string]string) (string, error) {
+	t.Helper()
+	for name, value := range flags {
+		require.NoError(t, fieldValuesCmd.Flags().Set(name, value))
+	}
+	t.Cleanup(func() {
+		for name := range flags {
+			flag := fieldValuesCmd.Flags().Lookup(name)
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+	})
+	return captureStdout(func() error { return runFieldValues(fieldValuesCmd, []string{field}) })
+}
+
+func TestFieldValues(t *testing.T) {
+	files := map[string]string{
+		filepath.Join("1_todo", "001-a.task.md"):    statsWorkItem("001", "todo", "reviewer: [alice@example.com, bob@example.com, Alice@example.com]\n"),
+		filepath.Join("2_doing", "002-b.task.md"):   statsWorkItem("002", "doing", "reviewer: Alice@example.com\n"),
+		filepath.Join("2_doing", "003-c.task.md"):   statsWorkItem("003", "doing", "reviewer: []\n"),
+		filepath.Join("4_done", "004-d.task.md"):    statsWorkItem("004", "done", "reviewer:\n  - email: carol@example.com\n    since: 2024-06-01\n"),
+		filepath.Join("z_archive", "005-e.task.md"): statsWorkItem("005", "archived", "reviewer: bob@example.com\n"),
+	}
+
+	t.Run("lists the distinct values across all statuses with counts", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output, err := runFieldValuesCapture(t, "reviewer", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "VALUE                      COUNT\n"+
+			"Alice <alice@example.com>      2\n"+
+			"bob@example.com                2\n"+
+			"carol@example.com              1\n", output)
+	})
+
+	t.Run("only scans the given status with --status", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output, err := runFieldValuesCapture(t, "reviewer", map[string]string{"status": "doing"})
+		require.NoError(t, err)
+		assert.Equal(t, "VALUE                      COUNT\n"+
+			"Alice <Alice@example.com>      1\n", output)
+	})
+
+	t.Run("outputs JSON with --json", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output, err := runFieldValuesCapture(t, "kind", map[string]string{"json": "true"})
+		require.NoError(t, err)
+		var counts []fieldValueCount
+		require.NoError(t, json.Unmarshal([]byte(output), &counts))
+		assert.Equal(t, []fieldValueCount{{Value: "task", Count: 5}}, counts)
+	})
+
+	t.Run("reports a field no work item has", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output, err := runFieldValuesCapture(t, "estimate", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "No work items have a value for estimate.\n", output)
+
+		output, err = runFieldValuesCapture(t, "estimate", map[string]string{"json": "true"})
+		require.NoError(t, err)
+		assert.Equal(t, "[]\n", output)
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		_, err := runFieldValuesCapture(t, "reviewer", map[string]string{"status": "nope"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid status 'nope'")
+	})
+}
@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -22,8 +21,13 @@ var docsSubdirs = []string{
 var initCmd = &cobra.Command{
 	Use:   "init [folder]",
 	Short: "Initialize a kira workspace",
-	Long:  `Creates the files and folders used by kira in the specified directory.`,
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Creates the files and folders used by kira in the specified directory.
+
+With --git, git.remote and git.trunk_branch in the generated kira.yml are taken from the
+repository: the first remote and its default branch, so kira latest and kira start work
+without editing the config. When the repository has no remote they are left as they are,
+with a comment saying so.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		targetDir := "."
 		if len(args) > 0 {
@@ -43,13 +47,31 @@ var initCmd = &cobra.Command{
 			return err
 		}
 
-		return initializeWorkspace(targetDir, cfg)
+		gitDefaults, _ := cmd.Flags().GetBool("git")
+		var missing []string
+		if gitDefaults {
+			missing = applyInitGitDefaults(cfg, targetDir)
+		}
+		if err := initializeWorkspace(targetDir, cfg); err != nil {
+			return err
+		}
+		if !gitDefaults {
+			return nil
+		}
+		if len(missing) > 0 {
+			undetected := strings.Join(missing, " and ")
+			fmt.Printf("Warning: could not detect %s; set %s in kira.yml\n", undetected, undetected)
+			return annotateGitDefaults(targetDir, missing)
+		}
+		fmt.Printf("Detected git remote %s with trunk branch %s\n", cfg.Git.Remote, cfg.Git.TrunkBranch)
+		return nil
 	},
 }
 
 func init() {
 	initCmd.Flags().Bool("force", false, "Overwrite existing work folder if present")
 	initCmd.Flags().Bool("fill-missing", false, "Create any missing files/folders without overwriting existing ones")
+	initCmd.Flags().Bool("git", false, "Set git.remote and git.trunk_branch in kira.yml from the repository's first remote and its default branch")
 }
 
 func initializeWorkspace(targetDir string, cfg *config.Config) error {
@@ -231,23 +253,16 @@ func ensureWorkspaceDecision(workPath, docsPath string, force, fillMissing bool)
 		return nil
 	}
 
-	fmt.Printf("Workspace (.work and docs) already exists. Choose an option: [c]ancel, [o]verwrite, [f]ill-missing\n")
-	fmt.Print("Enter choice (c/o/f): ")
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
+	confirmed, err := confirm("Workspace (.work and docs) already exists. Overwrite it?")
 	if err != nil {
 		return err
 	}
-	choice := strings.ToLower(strings.TrimSpace(input))
-	if choice == "f" || choice == "fill-missing" {
-		return nil
-	}
-	if choice == "o" || choice == choiceOverwrite {
-		_ = removePathIfExists(workPath, "work folder")
-		_ = removePathIfExists(docsPath, "docs folder")
-		return nil
+	if !confirmed {
+		return fmt.Errorf("init cancelled; use --fill-missing to keep the workspace and add what is missing")
 	}
-	return fmt.Errorf("init cancelled")
+	_ = removePathIfExists(workPath, "work folder")
+	_ = removePathIfExists(docsPath, "docs folder")
+	return nil
 }
 
 func pathExists(path string) bool {
@@ -0,0 +1,112 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"kira/internal/config"
+)
+
+// initGitUndetectedComment is written above the git section of kira.yml when kira init --git
+// cannot detect some of its settings (see applyInitGitDefaults) from the repository.
+func initGitUndetectedComment(missing []string) string {
+	if len(missing) > 1 {
+		return fmt.Sprintf("kira init --git could not detect %s (no git remote?): set them here for kira latest and kira start",
+			strings.Join(missing, " and "))
+	}
+	return fmt.Sprintf("kira init --git could not detect %s: set it here for kira latest and kira start", strings.Join(missing, ""))
+}
+
+// detectGitDefaults returns the first remote of the repository at dir and that remote's default
+// branch, or "" for what cannot be detected. The default branch comes from the remote's HEAD as
+// recorded by git clone (refs/remotes/<remote>/HEAD), or else from asking the remote.
+func detectGitDefaults(dir string) (remote, trunkBranch string) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"remote"}, dir, false)
+	if err != nil {
+		return "", ""
+	}
+	remotes := strings.Fields(output)
+	if len(remotes) == 0 {
+		return "", ""
+	}
+	remote = remotes[0]
+
+	output, err = executeCommand(ctx, "git", []string{"symbolic-ref", "--short", "refs/remotes/" + remote + "/HEAD"}, dir, false)
+	if err == nil {
+		if branch, ok := strings.CutPrefix(strings.TrimSpace(output), remote+"/"); ok && branch != "" {
+			return remote, branch
+		}
+	}
+	output, err = executeCommand(ctx, "git", []string{"ls-remote", "--symref", remote, "HEAD"}, dir, false)
+	if err != nil {
+		return remote, ""
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if ref, ok := strings.CutPrefix(line, "ref: refs/heads/"); ok {
+			if fields := strings.Fields(ref); len(fields) > 0 {
+				return remote, fields[0]
+			}
+		}
+	}
+	return remote, ""
+}
+
+// applyInitGitDefaults sets git.remote and git.trunk_branch from the repository at targetDir
+// (kira init --git) and returns the settings that could not be detected, e.g. only
+// git.trunk_branch when the remote's default branch is unknown. Those are left as they are.
+func applyInitGitDefaults(cfg *config.Config, targetDir string) (missing []string) {
+	remote, trunkBranch := detectGitDefaults(targetDir)
+	if cfg.Git == nil {
+		cfg.Git = &config.GitConfig{}
+	}
+	if remote != "" {
+		cfg.Git.Remote = remote
+	} else {
+		missing = append(missing, "git.remote")
+	}
+	if trunkBranch != "" {
+		cfg.Git.TrunkBranch = trunkBranch
+	} else {
+		missing = append(missing, "git.trunk_branch")
+	}
+	return missing
+}
+
+// annotateGitDefaults writes initGitUndetectedComment for the missing settings above the git
+// section of the kira.yml in targetDir, keeping the rest of the file as kira init wrote it.
+func annotateGitDefaults(targetDir string, missing []string) error {
+	configPath := config.FilePath(targetDir)
+	doc, err := readConfigDocument(configPath)
+	if err != nil {
+		return err
+	}
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "git" {
+			root.Content[i].HeadComment = initGitUndetectedComment(missing)
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := os.WriteFile(configPath, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
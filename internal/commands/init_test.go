@@ -370,6 +370,22 @@ func TestEnsureWorkspaceDecision(t *testing.T) {
 		assert.DirExists(t, docsPath)
 	})
 
+	t.Run("--yes overwrites without a terminal", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		workPath := filepath.Join(tmpDir, "work")
+		docsPath := filepath.Join(tmpDir, "docs")
+		require.NoError(t, os.MkdirAll(workPath, 0o700))
+		require.NoError(t, os.MkdirAll(docsPath, 0o700))
+		assumeYes = true
+		t.Cleanup(func() { assumeYes = false })
+
+		err := ensureWorkspaceDecision(workPath, docsPath, false, false)
+		require.NoError(t, err)
+
+		assert.NoDirExists(t, workPath)
+		assert.NoDirExists(t, docsPath)
+	})
+
 	t.Run("no prompt when neither exists", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		workPath := filepath.Join(tmpDir, "work")
@@ -379,3 +395,87 @@ func TestEnsureWorkspaceDecision(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestInitGitDefaults(t *testing.T) {
+	// runInit runs kira init --git in dir and returns the git section of the written config.
+	runInit := func(t *testing.T, dir string) *config.GitConfig {
+		t.Helper()
+		require.NoError(t, initCmd.Flags().Set("git", "true"))
+		t.Cleanup(func() { _ = initCmd.Flags().Set("git", "false") })
+		_, err := captureStdout(func() error { return initCmd.RunE(initCmd, []string{dir}) })
+		require.NoError(t, err)
+		cfg, err := config.LoadConfigFromDir(dir)
+		require.NoError(t, err)
+		return cfg.Git
+	}
+	// setupRemote creates a bare remote whose default branch is develop, with one commit.
+	setupRemote := func(t *testing.T) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		sourceDir := t.TempDir()
+		runGit(t, sourceDir, "init", "-b", "develop")
+		runGit(t, sourceDir, "config", "user.email", "test@example.com")
+		runGit(t, sourceDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "README.md"), []byte("readme"), 0o600))
+		runGit(t, sourceDir, "add", "README.md")
+		runGit(t, sourceDir, "commit", "-m", "initial")
+		remoteDir := t.TempDir()
+		runGit(t, sourceDir, "init", "--bare", "-b", "develop", remoteDir)
+		runGit(t, sourceDir, "push", remoteDir, "develop")
+		return remoteDir
+	}
+
+	t.Run("takes the remote and trunk branch from a clone", func(t *testing.T) {
+		remoteDir := setupRemote(t)
+		cloneDir := filepath.Join(t.TempDir(), "clone")
+		runGit(t, remoteDir, "clone", "-o", "upstream", remoteDir, cloneDir)
+
+		git := runInit(t, cloneDir)
+		assert.Equal(t, "upstream", git.Remote)
+		assert.Equal(t, "develop", git.TrunkBranch)
+		content, err := safeReadTestFile(filepath.Join(cloneDir, "kira.yml"), cloneDir)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "could not detect")
+	})
+
+	t.Run("asks the remote when its HEAD was not recorded", func(t *testing.T) {
+		remoteDir := setupRemote(t)
+		repoDir := t.TempDir()
+		runGit(t, repoDir, "init", "-b", "main")
+		runGit(t, repoDir, "remote", "add", "origin", remoteDir)
+
+		git := runInit(t, repoDir)
+		assert.Equal(t, "origin", git.Remote)
+		assert.Equal(t, "develop", git.TrunkBranch)
+	})
+
+	t.Run("leaves the values and adds a comment without a remote", func(t *testing.T) {
+		setupGitConfigForCISerial(t)
+		repoDir := t.TempDir()
+		runGit(t, repoDir, "init", "-b", "main")
+
+		git := runInit(t, repoDir)
+		assert.Equal(t, "", git.TrunkBranch)
+		content, err := safeReadTestFile(filepath.Join(repoDir, "kira.yml"), repoDir)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# "+initGitUndetectedComment([]string{"git.remote", "git.trunk_branch"})+"\ngit:\n")
+		assert.Contains(t, string(content), "    trunk_branch: \"\"\n")
+	})
+
+	t.Run("names only the trunk branch when the remote is detected", func(t *testing.T) {
+		setupGitConfigForCISerial(t)
+		repoDir := t.TempDir()
+		runGit(t, repoDir, "init", "-b", "main")
+		runGit(t, repoDir, "remote", "add", "origin", filepath.Join(t.TempDir(), "missing.git"))
+		require.NoError(t, initCmd.Flags().Set("git", "true"))
+		t.Cleanup(func() { _ = initCmd.Flags().Set("git", "false") })
+
+		out, err := captureStdout(func() error { return initCmd.RunE(initCmd, []string{repoDir}) })
+		require.NoError(t, err)
+		assert.Contains(t, out, "Warning: could not detect git.trunk_branch; set git.trunk_branch in kira.yml")
+		content, err := safeReadTestFile(filepath.Join(repoDir, "kira.yml"), repoDir)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "# kira init --git could not detect git.trunk_branch: set it here")
+		assert.Contains(t, string(content), "    remote: origin\n")
+	})
+}
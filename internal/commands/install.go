@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,8 +14,6 @@ import (
 	yaml "gopkg.in/yaml.v3"
 )
 
-const choiceOverwrite = "overwrite"
-
 var installCmd = &cobra.Command{
 	Use:   "install",
 	Short: "Install Cursor skills and commands",
@@ -260,18 +257,15 @@ func ensureCommandsOverwriteDecision(commandsPath string, force bool) error {
 	for _, f := range kiraFiles {
 		fmt.Printf("  • %s\n", itemNameStyle(f))
 	}
-	fmt.Printf("\nChoose: [o]verwrite, [c]ancel\n")
-	fmt.Print(promptStyle("Enter choice (o/c): "))
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
+	fmt.Println()
+	confirmed, err := confirm("Overwrite them?")
 	if err != nil {
 		return err
 	}
-	choice := strings.ToLower(strings.TrimSpace(input))
-	if choice == "o" || choice == choiceOverwrite {
-		return removeKiraCommandFiles(commandsPath, kiraFiles)
+	if !confirmed {
+		return fmt.Errorf("install cancelled")
 	}
-	return fmt.Errorf("install cancelled")
+	return removeKiraCommandFiles(commandsPath, kiraFiles)
 }
 
 func listExistingKiraCommands(commandsPath string) ([]string, error) {
@@ -375,18 +369,15 @@ func promptAndRemoveSkills(skillsPath string, kiraDirs []string) error {
 	for _, d := range kiraDirs {
 		fmt.Printf("  • %s\n", itemNameStyle(d))
 	}
-	fmt.Printf("\nChoose: [o]verwrite, [c]ancel\n")
-	fmt.Print(promptStyle("Enter choice (o/c): "))
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
+	fmt.Println()
+	confirmed, err := confirm("Overwrite them?")
 	if err != nil {
 		return err
 	}
-	choice := strings.ToLower(strings.TrimSpace(input))
-	if choice == "o" || choice == choiceOverwrite {
-		return removeKiraSkills(skillsPath, kiraDirs)
+	if !confirmed {
+		return fmt.Errorf("install cancelled")
 	}
-	return fmt.Errorf("install cancelled")
+	return removeKiraSkills(skillsPath, kiraDirs)
 }
 
 type skillFrontmatter struct {
@@ -0,0 +1,132 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// workItemConfigOverride holds per-work-item settings that take precedence over kira.yml for that
+// work item's start and latest. Empty fields keep the workspace setting.
+type workItemConfigOverride struct {
+	TrunkBranch  string
+	Remote       string
+	WorktreeRoot string
+}
+
+// workItemOverrideKeys maps the supported override keys to their fields.
+var workItemOverrideKeys = map[string]func(o *workItemConfigOverride) *string{
+	"trunk_branch":  func(o *workItemConfigOverride) *string { return &o.TrunkBranch },
+	"remote":        func(o *workItemConfigOverride) *string { return &o.Remote },
+	"worktree_root": func(o *workItemConfigOverride) *string { return &o.WorktreeRoot },
+}
+
+// loadWorkItemConfigOverride reads the kira: block of a work item's front matter. Keeping the
+// override in the work item means it moves between status folders with it. Unknown keys are
+// ignored with a warning.
+func loadWorkItemConfigOverride(workItemPath string, cfg *config.Config) (workItemConfigOverride, error) {
+	var override workItemConfigOverride
+	if _, err := os.Stat(workItemPath); os.IsNotExist(err) {
+		// Dry runs (e.g. move --start --dry-run) pass the not yet moved path
+		return override, nil
+	}
+
+	frontMatter, _, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return override, err
+	}
+	block, ok := frontMatter[config.WorkItemOverrideField]
+	if !ok || block == nil {
+		return override, nil
+	}
+	values, ok := block.(map[string]interface{})
+	if !ok {
+		return override, fmt.Errorf("%s: the %s front matter field must be a map of settings", workItemPath, config.WorkItemOverrideField)
+	}
+	err = applyWorkItemOverrideValues(&override, values, workItemPath)
+	return override, err
+}
+
+// applyWorkItemOverrideValues sets the supported keys from values, warning about unknown keys.
+func applyWorkItemOverrideValues(override *workItemConfigOverride, values map[string]interface{}, source string) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field, ok := workItemOverrideKeys[key]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Warning: %s: unknown work item config key '%s' ignored (supported: trunk_branch, remote, worktree_root)\n", source, key)
+			continue
+		}
+		value, ok := values[key].(string)
+		if !ok {
+			return fmt.Errorf("%s: work item config key '%s' must be a string", source, key)
+		}
+		*field(override) = strings.TrimSpace(value)
+	}
+	return nil
+}
+
+// withWorkItemConfigOverride returns a copy of cfg with the work item's overrides applied to
+// git.trunk_branch, git.remote and workspace.worktree_root. Returns cfg unchanged when there are none.
+func withWorkItemConfigOverride(cfg *config.Config, override workItemConfigOverride) *config.Config {
+	if override == (workItemConfigOverride{}) {
+		return cfg
+	}
+	cfgCopy := *cfg
+	gitCopy := config.GitConfig{}
+	if cfg.Git != nil {
+		gitCopy = *cfg.Git
+	}
+	if override.TrunkBranch != "" {
+		gitCopy.TrunkBranch = override.TrunkBranch
+	}
+	if override.Remote != "" {
+		gitCopy.Remote = override.Remote
+	}
+	cfgCopy.Git = &gitCopy
+
+	if override.WorktreeRoot != "" {
+		workspaceCopy := config.WorkspaceConfig{}
+		if cfg.Workspace != nil {
+			workspaceCopy = *cfg.Workspace
+		}
+		workspaceCopy.WorktreeRoot = override.WorktreeRoot
+		cfgCopy.Workspace = &workspaceCopy
+	}
+	return &cfgCopy
+}
+
+// withCurrentWorkItemConfigOverride applies the overrides of the work item whose branch is checked
+// out (kira branch format {id}-{title}). Returns cfg unchanged when not on a work item branch.
+func withCurrentWorkItemConfigOverride(cfg *config.Config) (*config.Config, error) {
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		return cfg, nil
+	}
+	currentBranch, err := getCurrentBranch(repoRoot)
+	if err != nil {
+		return cfg, nil
+	}
+	workItemID, err := parseWorkItemIDFromBranch(currentBranch, cfg)
+	if err != nil {
+		return cfg, nil
+	}
+	workItemPath, err := findWorkItemFileInAllStatusFolders(workItemID, cfg)
+	if err != nil || workItemPath == "" {
+		return cfg, nil
+	}
+
+	override, err := loadWorkItemConfigOverride(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config override for work item %s: %w", workItemID, err)
+	}
+	return withWorkItemConfigOverride(cfg, override), nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+func TestWorkItemConfigOverride(t *testing.T) {
+	const itemPath = ".work/1_todo/001-release-fix.task.md"
+	setup := func(t *testing.T, extra string) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-release-fix.task.md": listTestWorkItem("001", "Release fix", "todo", extra),
+		})
+		dir, err := os.Getwd()
+		require.NoError(t, err)
+		return testCfgWithDir(dir)
+	}
+
+	t.Run("reads the kira front matter block", func(t *testing.T) {
+		cfg := setup(t, "kira:\n  trunk_branch: release/1.x\n  remote: upstream\n")
+		override, err := loadWorkItemConfigOverride(itemPath, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, workItemConfigOverride{TrunkBranch: "release/1.x", Remote: "upstream"}, override)
+	})
+
+	t.Run("ignores unknown keys and rejects non-string values", func(t *testing.T) {
+		cfg := setup(t, "kira:\n  trunk_branch: release/1.x\n  ide: vim\n")
+		override, err := loadWorkItemConfigOverride(itemPath, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, workItemConfigOverride{TrunkBranch: "release/1.x"}, override)
+
+		cfg = setup(t, "kira:\n  remote:\n    - a\n")
+		_, err = loadWorkItemConfigOverride(itemPath, cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item config key 'remote' must be a string")
+	})
+
+	t.Run("no override leaves the config unchanged", func(t *testing.T) {
+		cfg := setup(t, "")
+		override, err := loadWorkItemConfigOverride(itemPath, cfg)
+		require.NoError(t, err)
+		assert.Same(t, cfg, withWorkItemConfigOverride(cfg, override))
+	})
+
+	t.Run("merges over a copy of the workspace config", func(t *testing.T) {
+		cfg := &config.Config{Git: &config.GitConfig{TrunkBranch: "main", Remote: "origin"}}
+		merged := withWorkItemConfigOverride(cfg, workItemConfigOverride{TrunkBranch: "release/1.x", WorktreeRoot: "/tmp/wt"})
+
+		assert.Equal(t, "release/1.x", merged.Git.TrunkBranch)
+		assert.Equal(t, "origin", merged.Git.Remote)
+		assert.Equal(t, "/tmp/wt", merged.Workspace.WorktreeRoot)
+		assert.Equal(t, "main", cfg.Git.TrunkBranch, "workspace config must not be modified")
+		assert.Nil(t, cfg.Workspace)
+	})
+
+	t.Run("start context uses the override and --remote still wins", func(t *testing.T) {
+		cfg := setup(t, "kira:\n  remote: upstream\n  worktree_root: "+filepath.Join(os.TempDir(), "kira-wt")+"\n")
+		metadata := workItemMetadata{id: "001", title: "Release fix", currentStatus: "todo"}
+
+		ctx, err := buildStartContextForWorkItem(cfg, "001", itemPath, metadata, StartFlags{})
+		require.NoError(t, err)
+		assert.Equal(t, "upstream", resolveRemoteName(ctx.Config, nil))
+		assert.Equal(t, filepath.Join(os.TempDir(), "kira-wt"), ctx.WorktreeRoot)
+
+		ctx, err = buildStartContextForWorkItem(cfg, "001", itemPath, metadata, StartFlags{Remote: "fork"})
+		require.NoError(t, err)
+		assert.Equal(t, "fork", resolveRemoteName(ctx.Config, nil))
+	})
+}
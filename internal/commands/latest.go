@@ -5,12 +5,17 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"kira/internal/config"
 
@@ -34,10 +39,60 @@ simultaneously, ensuring consistency and coordination between related repositori
 The command can be called repeatedly to work through conflicts progressively.
 
 If uncommitted changes are detected, they will be automatically stashed before update
-and popped after successful update (unless --no-pop-stash is specified).
+and popped after successful update (unless --no-pop-stash is specified). With
+git.use_autostash enabled in kira.yml, git rebase --autostash stashes and reapplies them instead.
+
+With --rebase-merges (or git.rebase_merges in kira.yml), git rebase --rebase-merges keeps the
+merge commits of a branch instead of flattening them into a line of commits. The merges are
+recreated, so conflicts a merge commit resolved must be resolved again.
+
+With git.update_submodules enabled in kira.yml, git submodule update --init --recursive runs
+after each successful update of a repository that has a .gitmodules file.
+
+Repositories are updated in parallel. A repository that fails (for example one you do not
+have access to) does not stop the others: failures are summarized at the end, grouped by cause
+(auth, conflict, dirty, timeout, other) with what to do for each, and the command exits
+non-zero. --keep-going states this default explicitly. With --fail-fast, repositories are
+updated one at a time and kira stops at the first failure.
+
+Shallow clones (e.g. CI checkouts with --depth 1) lack the history a rebase needs, so kira
+stops with a clear error for them. With --unshallow it first runs git fetch --unshallow.
+
+A feature branch that is already merged into the remote trunk is not rebased: kira reports
+"branch X is already merged into main; nothing to rebase". With --cleanup-merged it also
+deletes that local branch and its worktree (repositories with local changes are kept).
+With workflow.advance_on_merge set in kira.yml (e.g. done), the branch's work item is also
+moved to that status (status field and folder, not committed).
+
+--dry-run shows what each repository would get and previews that transition without
+stashing, fetching or rebasing; merged branches are detected as of the last fetch.
+
+With hooks.after_update set in kira.yml (e.g. "make deps"), that command runs with sh -c in
+each repository that was updated successfully; {repo}, {path} and {branch} are replaced first.
+A failing hook marks the repository as failed with the hook's stderr; --verbose shows its output.
+
+For stacked branches (a feature branch built on another feature branch), --onto <ref> rebases the
+current branch onto that ref instead of trunk, replaying only its own commits: those after
+--old-base <ref>, the commit it was built on (git rebase --onto <onto> <old-base>). Both are
+required together and only work in a single repository, since the refs differ per repository.
+Repositories on trunk are still updated from the remote trunk.
 
 By default, when a rebase or trunk update encounters conflicts, kira leaves the repository
-in the conflicted state so you can resolve conflicts and continue (or re-run kira latest).`,
+in the conflicted state so you can resolve conflicts and continue (or re-run kira latest).
+
+With --conflict-format github, existing conflicts are printed as Markdown for a PR comment:
+one collapsible <details> block per file with a fenced diff for each conflict region.
+Files the current branch changed (git diff --name-only <remote>/<trunk>...HEAD) are marked
+"changed by this branch". With --since-commit <ref>, only those files, compared with <ref>
+instead, are shown; the others are counted as hidden.
+
+With --summary, the results are reported as one line per repository instead of the full report,
+e.g. "✓ api (2 commits)" or "✗ web (conflict)"; the command still exits non-zero on any failure.
+
+Commits on the current branch that are not on its upstream (git rev-list @{upstream}..HEAD) are
+reported in the state summary, e.g. "2 unpushed commits on origin/feature", and again after the
+update, since rebased commits have to be pushed with --force-with-lease. They do not stop the
+update unless --warn-unpushed is given.`,
 	Args:         cobra.NoArgs,
 	RunE:         runLatest,
 	SilenceUsage: true, // Don't show usage on errors - error messages are clear enough
@@ -46,6 +101,22 @@ in the conflicted state so you can resolve conflicts and continue (or re-run kir
 func init() {
 	latestCmd.Flags().Bool("no-pop-stash", false, "Stash uncommitted changes before rebase but do not automatically pop them after")
 	latestCmd.Flags().Bool("abort-on-conflict", false, "Abort rebase and restore pre-rebase state when conflicts occur during rebase")
+	latestCmd.Flags().String("remote", "", "Override the remote to fetch from for every repository (e.g. a fork), including projects with their own remote")
+	latestCmd.Flags().Bool("prune", false, "After updating, remove remote-tracking refs for branches deleted on the remote")
+	latestCmd.Flags().Bool("cleanup-merged", false, "Delete the local branch and its worktree when the branch is already merged into trunk")
+	latestCmd.Flags().Bool("json", false, "Print per-repository operation results as JSON on stdout (progress goes to stderr)")
+	latestCmd.Flags().BoolP("verbose", "v", false, "List operation results slowest repository first, with hooks.after_update output")
+	latestCmd.Flags().Bool("summary", false, "Report results as one line per repository, e.g. '✓ api (2 commits)' or '✗ web (conflict)'")
+	latestCmd.Flags().Bool("fail-fast", false, "Update repositories one at a time and stop at the first failure (default: continue and report failures at the end)")
+	latestCmd.Flags().Bool("keep-going", false, "Continue past failed repositories and report failures at the end (the default; cannot be combined with --fail-fast)")
+	latestCmd.Flags().String("onto", "", "Rebase the current branch onto this ref instead of the remote trunk (git rebase --onto, for stacked branches; requires --old-base)")
+	latestCmd.Flags().String("old-base", "", "With --onto, the ref the current branch was built on: only the commits after it are replayed")
+	latestCmd.Flags().Bool("dry-run", false, "Show what each repository would get and preview workflow.advance_on_merge, without stashing, fetching or rebasing")
+	latestCmd.Flags().Bool("rebase-merges", false, "Keep the merge commits of branches when rebasing (git rebase --rebase-merges; default: git.rebase_merges)")
+	latestCmd.Flags().Bool("unshallow", false, "Fetch the full history of shallow clones (git fetch --unshallow) instead of failing")
+	latestCmd.Flags().String("conflict-format", conflictFormatPlain, "How to print existing conflicts: plain (terminal) or github (Markdown for a PR comment)")
+	latestCmd.Flags().Bool("warn-unpushed", false, "Stop before updating when a branch has commits not pushed to its upstream (default: only report them)")
+	latestCmd.Flags().String("since-commit", "", "Only show conflicts in files the current branch changed since this ref (e.g. origin/main or the parent of a stacked branch)")
 }
 
 // RepositoryInfo contains information about a repository that needs to be updated
@@ -53,8 +124,22 @@ type RepositoryInfo struct {
 	Name        string // Project name or directory name for standalone/monorepo
 	Path        string // Absolute path to repository
 	TrunkBranch string // Resolved trunk branch (project override > git.trunk_branch > auto-detect)
-	Remote      string // Resolved remote name (project override > git.remote > "origin")
+	Remote      string // Resolved remote name (--remote > project override > git.remote > "origin")
 	RepoRoot    string // For polyrepo: repo_root value if present
+	// UseAutostash rebases with --autostash instead of kira's stash/pop (git.use_autostash)
+	UseAutostash bool
+	// Onto rebases feature branches onto this ref instead of the remote trunk (--onto)
+	Onto string
+	// OldBase is the ref the branch was built on; only the commits after it move onto Onto (--old-base)
+	OldBase string
+	// Unshallow fetches the full history of a shallow clone before updating (--unshallow)
+	Unshallow bool
+	// AfterUpdateHook runs in the repository after a successful update (hooks.after_update)
+	AfterUpdateHook string
+	// UpdateSubmodules updates the submodules after a successful update (git.update_submodules)
+	UpdateSubmodules bool
+	// RebaseMerges rebases with --rebase-merges, keeping merge commits (git.rebase_merges, --rebase-merges)
+	RebaseMerges bool
 }
 
 // RepositoryState represents the current state of a repository
@@ -82,6 +167,10 @@ type RepositoryStateInfo struct {
 	State   RepositoryState
 	Error   error
 	Details string // Additional context (e.g., which files have conflicts)
+	// Unpushed is the number of commits on the current branch that are not on its upstream.
+	// It is informational and does not change State (a ready or dirty repository can be ahead).
+	Unpushed int
+	Upstream string // The upstream Unpushed was counted against (e.g. origin/feature); "" without one
 }
 
 // AggregatedState represents the overall state across all repositories
@@ -93,6 +182,7 @@ type AggregatedState struct {
 	InOperationRepos []string
 	ErrorRepos       []string
 	ReadyRepos       []string
+	UnpushedRepos    []string // Repositories with commits not on their upstream, in any state
 }
 
 // ConflictRegion represents a single conflict region with markers and content
@@ -112,23 +202,64 @@ type FileConflict struct {
 	FilePath string
 	Regions  []ConflictRegion
 	Error    error // Error if file couldn't be read or parsed
+	// ModifiedByBranch is set when the current branch changed the file since the base ref
+	// (git diff --name-only <base>...HEAD), i.e. the conflict involves the branch's own work
+	ModifiedByBranch bool
 }
 
 // RepositoryConflicts represents all conflicts in a repository grouped by file
 type RepositoryConflicts struct {
 	Repo  RepositoryInfo
 	Files []FileConflict
+	// BranchBase is the ref FileConflict.ModifiedByBranch was determined against; "" when the
+	// files changed by the branch could not be listed
+	BranchBase string
 }
 
-func runLatest(cmd *cobra.Command, _ []string) error {
+// loadLatestConfig loads the config, checks the workspace and git, and applies the current work
+// item's config override and --remote.
+func loadLatestConfig(cmd *cobra.Command) (*config.Config, error) {
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 	if err := checkWorkDir(cfg); err != nil {
+		return nil, err
+	}
+	if err := checkGitAvailable(); err != nil {
+		return nil, err
+	}
+	// Overrides of the work item whose branch is checked out apply over kira.yml; --remote still wins
+	cfg, err = withCurrentWorkItemConfigOverride(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd != nil {
+		remoteOverride, _ := cmd.Flags().GetString("remote")
+		cfg = withRemoteOverride(cfg, remoteOverride)
+	}
+	return cfg, nil
+}
+
+func runLatest(cmd *cobra.Command, _ []string) error {
+	conflictFormat, sinceCommit := conflictFormatPlain, ""
+	if cmd != nil {
+		conflictFormat, _ = cmd.Flags().GetString("conflict-format")
+		sinceCommit, _ = cmd.Flags().GetString("since-commit")
+	}
+	if err := validateConflictFormat(conflictFormat); err != nil {
 		return err
 	}
 
+	cfg, err := loadLatestConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	output := setupLatestOutput(cmd)
+	out := output.Progress
+
 	repos, err := discoverRepositories(cfg)
 	if err != nil {
 		return err
@@ -138,27 +269,35 @@ func runLatest(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("no repositories found for the current workspace")
 	}
 
-	displayDiscoveredRepositories(repos)
+	displayDiscoveredRepositories(out, repos)
 
 	// Phase 3: Check state for each repository
-	stateInfos := checkAllRepositoryStates(repos)
+	stateInfos := checkAllRepositoryStates(out, repos)
 	aggregated := aggregateRepositoryStates(stateInfos)
 
-	displayStateSummary(stateInfos, aggregated)
+	displayStateSummary(out, stateInfos, aggregated)
 
 	// Phase 4: Display conflicts if any exist
 	if aggregated.OverallState == StateConflictsExist {
-		displayAllConflicts(stateInfos)
+		displayAllConflicts(out, stateInfos, conflictFormat, sinceCommit, cfg)
 		return nil
 	}
 
 	// Get flag values
 	noPopStash, _ := cmd.Flags().GetBool("no-pop-stash")
 	abortOnConflict, _ := cmd.Flags().GetBool("abort-on-conflict")
+	prune, _ := cmd.Flags().GetBool("prune")
+	cleanupMerged, _ := cmd.Flags().GetBool("cleanup-merged")
+	failFast, _ := cmd.Flags().GetBool("fail-fast")
+	onto, _ := cmd.Flags().GetString("onto")
+	oldBase, _ := cmd.Flags().GetString("old-base")
+	unshallow, _ := cmd.Flags().GetBool("unshallow")
+	keepMerges, _ := cmd.Flags().GetBool("rebase-merges")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
 
 	// Phase 4.5: If repositories are in an in-progress rebase without conflicts, attempt to continue
 	if aggregated.OverallState == StateInRebase {
-		if err := handleInProgressRebases(stateInfos); err != nil {
+		if err := handleInProgressRebases(out, stateInfos); err != nil {
 			return err
 		}
 		// After continuing in-progress rebases, exit and let the user re-run `kira latest`
@@ -170,22 +309,36 @@ func runLatest(cmd *cobra.Command, _ []string) error {
 	// Also handle repositories with uncommitted changes (stash them)
 	if aggregated.OverallState == StateReadyForUpdate || len(aggregated.DirtyRepos) > 0 {
 		// Phase 6: Pre-flight validation - ensure no blocking states
-		if err := validateAllReposCleanOrDirtyForUpdate(aggregated); err != nil {
+		if err := validateLatestPreflight(aggregated, cmd); err != nil {
 			return err
 		}
 
-		displayUpdateMessage(aggregated.DirtyRepos, noPopStash)
-
 		reposToProcess := getReposToProcess(stateInfos)
 		if len(reposToProcess) == 0 {
 			return fmt.Errorf("no repositories ready for update")
 		}
 
 		// Order repositories by dependencies (respects repo_root grouping and config order)
-		orderedRepos := orderRepositoriesByDependencies(reposToProcess)
+		orderedRepos := withUnshallow(withOntoRef(orderRepositoriesByDependencies(reposToProcess), onto, oldBase), unshallow)
+		orderedRepos = withAfterUpdateHook(orderedRepos, afterUpdateHook(cfg))
+		orderedRepos = withRebaseMerges(orderedRepos, keepMerges)
+		if dryRun {
+			return previewLatestUpdate(out, orderedRepos, cfg)
+		}
+
+		displayUpdateMessage(out, aggregated.DirtyRepos, noPopStash)
 
-		results := performFetchAndRebaseForAllRepos(orderedRepos, abortOnConflict, noPopStash)
-		return handleUpdateResults(results)
+		start := time.Now()
+		var results []RepositoryOperationResult
+		if failFast {
+			results = performFetchAndRebaseUntilFailure(out, orderedRepos, abortOnConflict, noPopStash)
+		} else {
+			results = performFetchAndRebaseForAllRepos(out, orderedRepos, abortOnConflict, noPopStash)
+		}
+		finishLatestUpdate(out, results, prune, cleanupMerged, cfg)
+		output.Elapsed = time.Since(start)
+		output.WorkItem = currentLatestWorkItem(cfg)
+		return handleUpdateResults(results, output)
 	}
 
 	// For other states (dirty, in_rebase, in_merge, error), just return
@@ -194,18 +347,18 @@ func runLatest(cmd *cobra.Command, _ []string) error {
 }
 
 // displayUpdateMessage displays the appropriate message before starting updates
-func displayUpdateMessage(dirtyRepos []string, noPopStash bool) {
+func displayUpdateMessage(out io.Writer, dirtyRepos []string, noPopStash bool) {
 	if len(dirtyRepos) > 0 {
-		fmt.Println("\nSome repositories have uncommitted changes. They will be stashed before rebase.")
+		_, _ = fmt.Fprintln(out, "\nSome repositories have uncommitted changes. They will be stashed before rebase.")
 		if !noPopStash {
-			fmt.Println("Changes will be automatically popped after successful rebase.")
+			_, _ = fmt.Fprintln(out, "Changes will be automatically popped after successful rebase.")
 		} else {
-			fmt.Println("Changes will remain stashed (--no-pop-stash was specified).")
+			_, _ = fmt.Fprintln(out, "Changes will remain stashed (--no-pop-stash was specified).")
 		}
-		fmt.Println()
+		_, _ = fmt.Fprintln(out)
 	} else {
-		fmt.Println("\nAll repositories are ready for update. Proceeding with fetch and rebase...")
-		fmt.Println()
+		_, _ = fmt.Fprintln(out, "\nAll repositories are ready for update. Proceeding with fetch and rebase...")
+		_, _ = fmt.Fprintln(out)
 	}
 }
 
@@ -220,9 +373,45 @@ func getReposToProcess(stateInfos []RepositoryStateInfo) []RepositoryInfo {
 	return reposToProcess
 }
 
+// latestOutput controls how operation results are reported.
+type latestOutput struct {
+	JSON       bool
+	Verbose    bool
+	Summary    bool            // One line per repository (--summary); --json takes precedence
+	JSONWriter io.Writer       // Receives the JSON report
+	Progress   io.Writer       // Receives human-readable progress and results; stderr with --json
+	WorkItem   *latestWorkItem // The work item whose branch is checked out; nil when there is none
+	Elapsed    time.Duration   // Wall-clock time of the update, reported as the total time
+}
+
+// setupLatestOutput reads the output flags. With --json, human-readable progress is sent to
+// stderr so stdout carries only the JSON report.
+func setupLatestOutput(cmd *cobra.Command) latestOutput {
+	if cmd == nil {
+		return latestOutput{JSONWriter: os.Stdout, Progress: os.Stdout}
+	}
+	output := latestOutput{JSONWriter: cmd.OutOrStdout(), Progress: cmd.OutOrStdout()}
+	output.JSON, _ = cmd.Flags().GetBool("json")
+	output.Verbose, _ = cmd.Flags().GetBool("verbose")
+	output.Summary, _ = cmd.Flags().GetBool("summary")
+	if output.JSON {
+		output.Progress = cmd.ErrOrStderr()
+	}
+	return output
+}
+
 // handleUpdateResults processes the results and returns appropriate error
-func handleUpdateResults(results []RepositoryOperationResult) error {
-	displayOperationResults(results)
+func handleUpdateResults(results []RepositoryOperationResult, output latestOutput) error {
+	if output.JSON {
+		if err := writeOperationResultsJSON(output.JSONWriter, results, output.WorkItem, output.Elapsed); err != nil {
+			return fmt.Errorf("failed to write JSON results: %w", err)
+		}
+	} else if output.Summary {
+		displayOperationSummary(output.Progress, results)
+	} else {
+		displayOperationResults(output.Progress, results, output.Verbose, output.Elapsed)
+		displayLatestWorkItemSummary(output.Progress, output.WorkItem, results)
+	}
 
 	// Check if any operations failed
 	for _, result := range results {
@@ -231,13 +420,16 @@ func handleUpdateResults(results []RepositoryOperationResult) error {
 		}
 	}
 
-	fmt.Println("\n✓ All repositories updated successfully!")
+	if output.Summary {
+		return nil
+	}
+	_, _ = fmt.Fprintln(output.Progress, "\n✓ All repositories updated successfully!")
 	return nil
 }
 
 // displayDiscoveredRepositories displays the list of discovered repositories
-func displayDiscoveredRepositories(repos []RepositoryInfo) {
-	fmt.Printf("Discovered %d repository(ies) for current workspace:\n", len(repos))
+func displayDiscoveredRepositories(out io.Writer, repos []RepositoryInfo) {
+	_, _ = fmt.Fprintf(out, "Discovered %d repository(ies) for current workspace:\n", len(repos))
 	for _, repo := range repos {
 		branchNote := ""
 		if onTrunk, err := isOnTrunkBranch(repo); err == nil && onTrunk {
@@ -245,13 +437,13 @@ func displayDiscoveredRepositories(repos []RepositoryInfo) {
 		} else if err == nil {
 			branchNote = " [on feature branch]"
 		}
-		fmt.Printf("  - %s: %s (trunk: %s, remote: %s)%s\n", repo.Name, repo.Path, repo.TrunkBranch, repo.Remote, branchNote)
+		_, _ = fmt.Fprintf(out, "  - %s: %s (trunk: %s, remote: %s)%s\n", repo.Name, repo.Path, repo.TrunkBranch, repo.Remote, branchNote)
 	}
 }
 
 // checkAllRepositoryStates checks the state of all repositories
-func checkAllRepositoryStates(repos []RepositoryInfo) []RepositoryStateInfo {
-	fmt.Println("\nChecking repository state...")
+func checkAllRepositoryStates(out io.Writer, repos []RepositoryInfo) []RepositoryStateInfo {
+	_, _ = fmt.Fprintln(out, "\nChecking repository state...")
 	var stateInfos []RepositoryStateInfo
 	for _, repo := range repos {
 		stateInfo, err := checkRepositoryState(repo)
@@ -267,45 +459,51 @@ func checkAllRepositoryStates(repos []RepositoryInfo) []RepositoryStateInfo {
 }
 
 // displayStateSummary displays the state summary for all repositories
-func displayStateSummary(stateInfos []RepositoryStateInfo, aggregated AggregatedState) {
-	fmt.Println("\nRepository State Summary:")
+func displayStateSummary(out io.Writer, stateInfos []RepositoryStateInfo, aggregated AggregatedState) {
+	_, _ = fmt.Fprintln(out, "\nRepository State Summary:")
 	for _, stateInfo := range stateInfos {
-		displayRepositoryState(stateInfo)
+		displayRepositoryState(out, stateInfo)
 	}
 
-	fmt.Printf("\nOverall State: %s\n", aggregated.OverallState)
-	displayAggregatedStateDetails(aggregated)
+	_, _ = fmt.Fprintf(out, "\nOverall State: %s\n", aggregated.OverallState)
+	displayAggregatedStateDetails(out, aggregated)
 }
 
 // displayRepositoryState displays the state of a single repository
-func displayRepositoryState(stateInfo RepositoryStateInfo) {
+func displayRepositoryState(out io.Writer, stateInfo RepositoryStateInfo) {
 	stateSymbol := getStateSymbol(stateInfo.State)
-	fmt.Printf("  %s %s: %s", stateSymbol, stateInfo.Repo.Name, stateInfo.State)
+	_, _ = fmt.Fprintf(out, "  %s %s: %s", stateSymbol, stateInfo.Repo.Name, stateInfo.State)
 	if stateInfo.Details != "" {
-		fmt.Printf(" (%s)", stateInfo.Details)
+		_, _ = fmt.Fprintf(out, " (%s)", stateInfo.Details)
+	}
+	if stateInfo.Unpushed > 0 {
+		_, _ = fmt.Fprintf(out, " [%s]", formatUnpushedCommits(stateInfo.Unpushed, stateInfo.Upstream))
 	}
 	if stateInfo.Error != nil {
-		fmt.Printf(" - Error: %v", stateInfo.Error)
+		_, _ = fmt.Fprintf(out, " - Error: %v", stateInfo.Error)
 	}
-	fmt.Println()
+	_, _ = fmt.Fprintln(out)
 }
 
 // displayAggregatedStateDetails displays details about the aggregated state
-func displayAggregatedStateDetails(aggregated AggregatedState) {
+func displayAggregatedStateDetails(out io.Writer, aggregated AggregatedState) {
 	if len(aggregated.ConflictingRepos) > 0 {
-		fmt.Printf("  Repositories with conflicts: %s\n", strings.Join(aggregated.ConflictingRepos, ", "))
+		_, _ = fmt.Fprintf(out, "  Repositories with conflicts: %s\n", strings.Join(aggregated.ConflictingRepos, ", "))
 	}
 	if len(aggregated.InOperationRepos) > 0 {
-		fmt.Printf("  Repositories in operation: %s\n", strings.Join(aggregated.InOperationRepos, ", "))
+		_, _ = fmt.Fprintf(out, "  Repositories in operation: %s\n", strings.Join(aggregated.InOperationRepos, ", "))
 	}
 	if len(aggregated.DirtyRepos) > 0 {
-		fmt.Printf("  Repositories with uncommitted changes: %s\n", strings.Join(aggregated.DirtyRepos, ", "))
+		_, _ = fmt.Fprintf(out, "  Repositories with uncommitted changes: %s\n", strings.Join(aggregated.DirtyRepos, ", "))
 	}
 	if len(aggregated.ErrorRepos) > 0 {
-		fmt.Printf("  Repositories with errors: %s\n", strings.Join(aggregated.ErrorRepos, ", "))
+		_, _ = fmt.Fprintf(out, "  Repositories with errors: %s\n", strings.Join(aggregated.ErrorRepos, ", "))
 	}
 	if len(aggregated.ReadyRepos) > 0 {
-		fmt.Printf("  Repositories ready for update: %s\n", strings.Join(aggregated.ReadyRepos, ", "))
+		_, _ = fmt.Fprintf(out, "  Repositories ready for update: %s\n", strings.Join(aggregated.ReadyRepos, ", "))
+	}
+	if len(aggregated.UnpushedRepos) > 0 {
+		_, _ = fmt.Fprintf(out, "  Repositories with unpushed commits: %s\n", strings.Join(aggregated.UnpushedRepos, ", "))
 	}
 }
 
@@ -403,8 +601,8 @@ func orderRepositoriesByDependencies(repos []RepositoryInfo) []RepositoryInfo {
 func resolveRepositoriesForLatest(cfg *config.Config, behavior WorkspaceBehavior) ([]RepositoryInfo, error) {
 	switch behavior {
 	case WorkspaceBehaviorStandalone, WorkspaceBehaviorMonorepo:
-		// For standalone/monorepo, return single repository (current directory)
-		repoRoot, err := getRepoRoot()
+		// For standalone/monorepo, return single repository (current working tree)
+		repoRoot, err := getWorkingTreeRoot()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get repository root: %w", err)
 		}
@@ -425,16 +623,19 @@ func resolveRepositoriesForLatest(cfg *config.Config, behavior WorkspaceBehavior
 
 		return []RepositoryInfo{
 			{
-				Name:        repoName,
-				Path:        repoRoot,
-				TrunkBranch: trunkBranch,
-				Remote:      remote,
+				Name:             repoName,
+				Path:             repoRoot,
+				TrunkBranch:      trunkBranch,
+				Remote:           remote,
+				UseAutostash:     useAutostash(cfg),
+				UpdateSubmodules: updateSubmodules(cfg),
+				RebaseMerges:     rebaseMerges(cfg),
 			},
 		}, nil
 
 	case WorkspaceBehaviorPolyrepo:
 		// For polyrepo, resolve all projects
-		repoRoot, err := getRepoRoot()
+		repoRoot, err := getWorkingTreeRoot()
 		if err != nil {
 			return nil, fmt.Errorf("failed to get repository root: %w", err)
 		}
@@ -458,11 +659,14 @@ func resolveRepositoriesForLatest(cfg *config.Config, behavior WorkspaceBehavior
 			}
 
 			repos = append(repos, RepositoryInfo{
-				Name:        project.Name,
-				Path:        project.Path,
-				TrunkBranch: trunkBranch,
-				Remote:      project.Remote,
-				RepoRoot:    project.RepoRoot,
+				Name:             project.Name,
+				Path:             project.Path,
+				TrunkBranch:      trunkBranch,
+				Remote:           project.Remote,
+				RepoRoot:         project.RepoRoot,
+				UseAutostash:     useAutostash(cfg),
+				UpdateSubmodules: updateSubmodules(cfg),
+				RebaseMerges:     rebaseMerges(cfg),
 			})
 		}
 
@@ -473,6 +677,33 @@ func resolveRepositoriesForLatest(cfg *config.Config, behavior WorkspaceBehavior
 	}
 }
 
+// useAutostash reports whether git.use_autostash is enabled.
+func useAutostash(cfg *config.Config) bool {
+	return cfg.Git != nil && cfg.Git.UseAutostash
+}
+
+// rebaseMerges reports whether git.rebase_merges is enabled.
+func rebaseMerges(cfg *config.Config) bool {
+	return cfg.Git != nil && cfg.Git.RebaseMerges
+}
+
+// getWorkingTreeRoot returns the top level of the working tree containing the current directory.
+// Inside a linked worktree (e.g. one created by 'kira start') this is the worktree itself rather
+// than the main working tree, so latest operates on the branch checked out there.
+// Falls back to getRepoRoot when git cannot resolve the top level.
+func getWorkingTreeRoot() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"rev-parse", "--show-toplevel"}, "", false)
+	if err == nil {
+		if topLevel := strings.TrimSpace(output); topLevel != "" {
+			return filepath.Clean(topLevel), nil
+		}
+	}
+	return getRepoRoot()
+}
+
 // findProjectConfig finds the ProjectConfig for a given project name
 func findProjectConfig(cfg *config.Config, projectName string) *config.ProjectConfig {
 	if cfg.Workspace == nil {
@@ -502,6 +733,12 @@ func validateRepositories(repos []RepositoryInfo) error {
 		// Check if path is a git repository
 		if !isExternalGitRepo(repo.Path) {
 			errors = append(errors, fmt.Sprintf("path is not a git repository: %s (for %s)", repo.Path, repo.Name))
+			continue
+		}
+
+		// Check that a linked worktree is still registered with its main repository
+		if err := checkWorktreeRegistration(repo.Path); err != nil {
+			errors = append(errors, fmt.Sprintf("%v (for %s)", err, repo.Name))
 		}
 	}
 
@@ -610,6 +847,7 @@ func checkGitStatus(repo RepositoryInfo, stateInfo RepositoryStateInfo) (Reposit
 
 	hasUncommitted := strings.TrimSpace(statusOutput) != ""
 	hasConflicts := checkForConflicts(ctx, repo)
+	stateInfo.Unpushed, stateInfo.Upstream = countUnpushedCommits(ctx, repo)
 
 	// Determine state based on checks
 	if hasConflicts {
@@ -634,26 +872,15 @@ func checkGitStatus(repo RepositoryInfo, stateInfo RepositoryStateInfo) (Reposit
 	return stateInfo, nil
 }
 
-// checkForConflicts checks for conflict markers in the repository
+// checkForConflicts reports whether the repository has unmerged files. It relies on git's list of
+// unmerged paths rather than looking for conflict markers in file content, so files that merely
+// document markers (e.g. inside a code fence) are not mistaken for conflicts.
 func checkForConflicts(ctx context.Context, repo RepositoryInfo) bool {
-	// Check for conflict markers in tracked files
-	diffCheckOutput, err := executeCommand(ctx, "git", []string{"diff", "--check"}, repo.Path, false)
+	unmergedOutput, err := executeCommand(ctx, "git", []string{"diff", "--name-only", "--diff-filter=U"}, repo.Path, false)
 	if err != nil {
-		// git diff --check returns non-zero exit code if conflicts found
-		// This is expected behavior, so we check the output
-		diffCheckOutput, _ = executeCommandCombinedOutput(ctx, "git", []string{"diff", "--check"}, repo.Path, false)
-	}
-
-	hasConflicts := strings.Contains(diffCheckOutput, "<<<<<<<") ||
-		strings.Contains(diffCheckOutput, "conflict") ||
-		strings.Contains(diffCheckOutput, "CONFLICT")
-
-	// Also check for conflict markers in working directory files
-	if !hasConflicts {
-		hasConflicts = checkStatusForConflicts(ctx, repo)
+		return checkStatusForConflicts(ctx, repo)
 	}
-
-	return hasConflicts
+	return strings.TrimSpace(unmergedOutput) != "" || checkStatusForConflicts(ctx, repo)
 }
 
 // checkStatusForConflicts checks git status output for conflict indicators
@@ -752,10 +979,14 @@ func aggregateRepositoryStates(states []RepositoryStateInfo) AggregatedState {
 		InOperationRepos: []string{},
 		ErrorRepos:       []string{},
 		ReadyRepos:       []string{},
+		UnpushedRepos:    []string{},
 	}
 
 	// Categorize repositories by state
 	for _, stateInfo := range states {
+		if stateInfo.Unpushed > 0 {
+			aggregated.UnpushedRepos = append(aggregated.UnpushedRepos, stateInfo.Repo.Name)
+		}
 		switch stateInfo.State {
 		case StateConflictsExist:
 			aggregated.ConflictingRepos = append(aggregated.ConflictingRepos, stateInfo.Repo.Name)
@@ -869,43 +1100,87 @@ type conflictMarkerPosition struct {
 	content   string // The full line including any branch name
 }
 
-// findConflictMarkers finds all conflict marker positions in file content
+// findConflictMarkers finds all conflict marker positions in file content. Only lines in git's
+// marker format count: the marker at column 0, followed by a space and a label or the end of the
+// line (the separator never has a label). Indented or longer runs, such as "<<<<<<<<", and markers
+// quoted in documentation inside a closed code fence (see closedCodeFenceLines) are ignored.
 func findConflictMarkers(content []byte) []conflictMarkerPosition {
 	var markers []conflictMarkerPosition
+	var lines []string
 	scanner := bufio.NewScanner(bytes.NewReader(content))
-	lineIndex := 0
-
 	for scanner.Scan() {
-		line := scanner.Text()
-		trimmed := strings.TrimSpace(line)
+		lines = append(lines, scanner.Text())
+	}
+	fenced := closedCodeFenceLines(lines)
 
-		if strings.HasPrefix(trimmed, conflictMarkerStart) {
-			markers = append(markers, conflictMarkerPosition{
-				lineIndex: lineIndex,
-				marker:    conflictMarkerStart,
-				content:   line,
-			})
-		} else if strings.HasPrefix(trimmed, conflictMarkerSeparator) && len(trimmed) == 7 {
-			// Only match exact "=======" separator, not lines that contain it
-			markers = append(markers, conflictMarkerPosition{
-				lineIndex: lineIndex,
-				marker:    conflictMarkerSeparator,
-				content:   line,
-			})
-		} else if strings.HasPrefix(trimmed, conflictMarkerEnd) {
+	for lineIndex, line := range lines {
+		if fenced[lineIndex] {
+			continue
+		}
+		if marker, ok := conflictMarkerOfLine(line); ok {
 			markers = append(markers, conflictMarkerPosition{
 				lineIndex: lineIndex,
-				marker:    conflictMarkerEnd,
+				marker:    marker,
 				content:   line,
 			})
 		}
-
-		lineIndex++
 	}
 
 	return markers
 }
 
+// closedCodeFenceLines returns the indexes of the lines of Markdown code fences (a line starting
+// with three or more ` or ~, closed by a line of at least as many of the same character),
+// including the fence lines, so markers documented inside them are not taken for conflicts. A
+// fence that is never closed hides nothing: a stray ``` must not hide a real conflict.
+func closedCodeFenceLines(lines []string) map[int]bool {
+	fenced := make(map[int]bool)
+	open, openRun := -1, ""
+	for i, line := range lines {
+		run, rest := codeFenceRun(line)
+		switch {
+		case run == "":
+		case open < 0:
+			open, openRun = i, run
+		case run[0] == openRun[0] && len(run) >= len(openRun) && strings.TrimSpace(rest) == "":
+			for j := open; j <= i; j++ {
+				fenced[j] = true
+			}
+			open = -1
+		}
+	}
+	return fenced
+}
+
+// codeFenceRun returns the run of three or more ` or ~ that line starts with after its
+// indentation, and the rest of the line; run is "" when line is not a code fence line.
+func codeFenceRun(line string) (run, rest string) {
+	trimmed := strings.TrimLeft(strings.TrimSuffix(line, "\r"), " \t")
+	if trimmed == "" || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return "", ""
+	}
+	n := len(trimmed) - len(strings.TrimLeft(trimmed, trimmed[:1]))
+	if n < 3 {
+		return "", ""
+	}
+	return trimmed[:n], trimmed[n:]
+}
+
+// conflictMarkerOfLine returns the conflict marker line starts with, if line is a git conflict marker line.
+func conflictMarkerOfLine(line string) (string, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	if line == conflictMarkerSeparator {
+		return conflictMarkerSeparator, true
+	}
+	for _, marker := range []string{conflictMarkerStart, conflictMarkerEnd} {
+		rest, ok := strings.CutPrefix(line, marker)
+		if ok && (rest == "" || rest[0] == ' ') {
+			return marker, true
+		}
+	}
+	return "", false
+}
+
 // parseConflictMarkers parses conflict markers from file content and extracts conflict regions
 func parseConflictMarkers(_ string, content []byte) ([]ConflictRegion, error) {
 	lines := strings.Split(string(content), "\n")
@@ -983,8 +1258,9 @@ func parseConflictMarkers(_ string, content []byte) ([]ConflictRegion, error) {
 	return regions, nil
 }
 
-// parseConflictsFromRepository parses all conflicts from a repository
-func parseConflictsFromRepository(repo RepositoryInfo, stateInfo RepositoryStateInfo) (*RepositoryConflicts, error) {
+// parseConflictsFromRepository parses all conflicts from a repository and marks the files the
+// current branch changed since base (see annotateBranchChanges; "" is the remote trunk)
+func parseConflictsFromRepository(repo RepositoryInfo, stateInfo RepositoryStateInfo, base string) (*RepositoryConflicts, error) {
 	if stateInfo.State != StateConflictsExist {
 		return nil, nil
 	}
@@ -1043,10 +1319,12 @@ func parseConflictsFromRepository(repo RepositoryInfo, stateInfo RepositoryState
 		})
 	}
 
-	return &RepositoryConflicts{
+	repoConflicts := &RepositoryConflicts{
 		Repo:  repo,
 		Files: fileConflicts,
-	}, nil
+	}
+	annotateBranchChanges(repoConflicts, base)
+	return repoConflicts, nil
 }
 
 // formatConflictForDisplay formats a single conflict region for terminal display
@@ -1110,7 +1388,7 @@ func formatFileConflicts(fileConflict FileConflict) string {
 	}
 
 	var buf strings.Builder
-	fmt.Fprintf(&buf, "File: %s\n\n", fileConflict.FilePath)
+	fmt.Fprintf(&buf, "File: %s%s\n\n", fileConflict.FilePath, branchChangeNote(fileConflict))
 
 	for i, region := range fileConflict.Regions {
 		if i > 0 {
@@ -1146,7 +1424,7 @@ func formatRepositoryConflicts(repoConflicts RepositoryConflicts) string {
 }
 
 // formatAllConflicts formats conflicts across all repositories
-func formatAllConflicts(allConflicts []RepositoryConflicts) string {
+func formatAllConflicts(allConflicts []RepositoryConflicts, resolution conflictResolution) string {
 	if len(allConflicts) == 0 {
 		return ""
 	}
@@ -1164,42 +1442,166 @@ func formatAllConflicts(allConflicts []RepositoryConflicts) string {
 	}
 
 	buf.WriteString("\n\n")
-	buf.WriteString("───────────────────────────────────────────────────────────────\n")
-	buf.WriteString("[Instructions: Copy the conflict sections above and paste into Cursor or ChatGPT for resolution assistance]\n\n")
-	buf.WriteString("To resolve conflicts:\n")
-	buf.WriteString("1. Copy the conflict sections above\n")
-	buf.WriteString("2. Paste into your LLM tool (Cursor, ChatGPT, etc.)\n")
-	buf.WriteString("3. Ask for help resolving the conflicts\n")
-	buf.WriteString("4. Apply the resolved code\n")
-	buf.WriteString("5. Run 'kira latest' again to continue\n\n")
-	buf.WriteString("To abort an in-progress rebase in a repository, run 'git rebase --abort' in that repository.\n")
+	buf.WriteString(resolution.plainFooter())
+
+	return buf.String()
+}
+
+const (
+	// conflictFormatPlain prints conflicts for the terminal (the default)
+	conflictFormatPlain = "plain"
+	// conflictFormatGitHub prints conflicts as Markdown for a GitHub PR comment
+	conflictFormatGitHub = "github"
+)
+
+// validateConflictFormat checks the --conflict-format value.
+func validateConflictFormat(format string) error {
+	switch format {
+	case conflictFormatPlain, conflictFormatGitHub:
+		return nil
+	default:
+		return fmt.Errorf("invalid --conflict-format '%s' (valid: %s, %s)", format, conflictFormatPlain, conflictFormatGitHub)
+	}
+}
+
+// formatAllConflictsGitHub formats conflicts across all repositories as Markdown for a GitHub
+// PR comment: a collapsible <details> block per file with a fenced diff for each region.
+func formatAllConflictsGitHub(allConflicts []RepositoryConflicts, resolution conflictResolution) string {
+	if len(allConflicts) == 0 {
+		return ""
+	}
+
+	var buf strings.Builder
+	buf.WriteString("### Merge conflicts detected\n")
+
+	for _, repoConflicts := range allConflicts {
+		for _, fileConflict := range repoConflicts.Files {
+			buf.WriteString("\n")
+			buf.WriteString(formatFileConflictsGitHub(repoConflicts.Repo.Name, fileConflict))
+		}
+	}
+
+	buf.WriteString(resolution.githubFooter())
+	return buf.String()
+}
 
+// formatFileConflictsGitHub formats one file's conflicts as a collapsible <details> block.
+func formatFileConflictsGitHub(repoName string, fileConflict FileConflict) string {
+	var buf strings.Builder
+	buf.WriteString("<details>\n")
+
+	switch {
+	case fileConflict.Error != nil:
+		fmt.Fprintf(&buf, "<summary>%s: <code>%s</code> (error)</summary>\n\n", html.EscapeString(repoName), html.EscapeString(fileConflict.FilePath))
+		fmt.Fprintf(&buf, "%s\n", html.EscapeString(fileConflict.Error.Error()))
+	case len(fileConflict.Regions) == 0:
+		fmt.Fprintf(&buf, "<summary>%s: <code>%s</code> (no conflict regions)</summary>\n\n", html.EscapeString(repoName), html.EscapeString(fileConflict.FilePath))
+		buf.WriteString("No conflict regions found - the file may have been resolved.\n")
+	default:
+		noun := "conflicts"
+		if len(fileConflict.Regions) == 1 {
+			noun = "conflict"
+		}
+		fmt.Fprintf(&buf, "<summary>%s: <code>%s</code> (%d %s)%s</summary>\n", html.EscapeString(repoName), html.EscapeString(fileConflict.FilePath), len(fileConflict.Regions), noun, branchChangeNote(fileConflict))
+		for _, region := range fileConflict.Regions {
+			buf.WriteString("\n")
+			buf.WriteString(formatConflictRegionDiff(region))
+		}
+	}
+
+	buf.WriteString("\n</details>\n")
 	return buf.String()
 }
 
+// formatConflictRegionDiff renders a conflict region as a fenced diff: our side as removed lines,
+// their side as added lines, and the surrounding context unchanged.
+func formatConflictRegionDiff(region ConflictRegion) string {
+	var body strings.Builder
+	fmt.Fprintf(&body, "--- %s\n", conflictMarkerLabel(region.StartMarker, "ours"))
+	fmt.Fprintf(&body, "+++ %s\n", conflictMarkerLabel(region.EndMarker, "theirs"))
+	for _, line := range region.ContextBefore {
+		fmt.Fprintf(&body, " %s\n", line)
+	}
+	writePrefixedLines(&body, "-", region.OurContent)
+	writePrefixedLines(&body, "+", region.TheirContent)
+	for _, line := range region.ContextAfter {
+		fmt.Fprintf(&body, " %s\n", line)
+	}
+
+	fence := markdownFence(body.String())
+	return fence + "diff\n" + body.String() + fence + "\n"
+}
+
+// conflictMarkerLabel returns the label after a conflict marker (HEAD in "<<<<<<< HEAD"), or
+// fallback when the marker has none.
+func conflictMarkerLabel(marker, fallback string) string {
+	if _, label, ok := strings.Cut(strings.TrimSpace(marker), " "); ok && strings.TrimSpace(label) != "" {
+		return strings.TrimSpace(label)
+	}
+	return fallback
+}
+
+func writePrefixedLines(buf *strings.Builder, prefix, content string) {
+	if content == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		fmt.Fprintf(buf, "%s%s\n", prefix, line)
+	}
+}
+
+// markdownFence returns a code fence longer than any run of backticks in content, so the
+// content cannot close the block early.
+func markdownFence(content string) string {
+	longest, run := 0, 0
+	for _, r := range content {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return strings.Repeat("`", max(3, longest+1))
+}
+
 // displayAllConflicts parses and displays all conflicts from repositories with conflicts
-func displayAllConflicts(stateInfos []RepositoryStateInfo) {
+// in the given --conflict-format, followed by the resolution guidance for cfg. With sinceCommit
+// (--since-commit) only the files the current branch changed since that ref are shown.
+func displayAllConflicts(out io.Writer, stateInfos []RepositoryStateInfo, format, sinceCommit string, cfg *config.Config) {
 	var allConflicts []RepositoryConflicts
+	hidden := 0
 
 	// Parse conflicts from all repositories that have conflicts
 	for _, stateInfo := range stateInfos {
 		if stateInfo.State == StateConflictsExist {
-			repoConflicts, err := parseConflictsFromRepository(stateInfo.Repo, stateInfo)
+			repoConflicts, err := parseConflictsFromRepository(stateInfo.Repo, stateInfo, sinceCommit)
 			if err != nil {
 				// Log error but continue
-				fmt.Printf("Warning: Failed to parse conflicts from repository %s: %v\n", stateInfo.Repo.Name, err)
+				_, _ = fmt.Fprintf(out, "Warning: Failed to parse conflicts from repository %s: %v\n", stateInfo.Repo.Name, err)
 				continue
 			}
+			if repoConflicts != nil && sinceCommit != "" {
+				hidden += filterBranchConflicts(out, repoConflicts, sinceCommit)
+			}
 			if repoConflicts != nil && len(repoConflicts.Files) > 0 {
 				allConflicts = append(allConflicts, *repoConflicts)
 			}
 		}
 	}
 
+	if hidden > 0 {
+		_, _ = fmt.Fprintf(out, "\n%d conflicted file(s) not changed by this branch since %s are hidden (--since-commit)\n", hidden, sinceCommit)
+	}
+
 	// Display formatted conflicts
 	if len(allConflicts) > 0 {
-		fmt.Println()
-		fmt.Print(formatAllConflicts(allConflicts))
+		_, _ = fmt.Fprintln(out)
+		if format == conflictFormatGitHub {
+			_, _ = fmt.Fprint(out, formatAllConflictsGitHub(allConflicts, conflictResolutionFor(cfg)))
+			return
+		}
+		_, _ = fmt.Fprint(out, formatAllConflicts(allConflicts, conflictResolutionFor(cfg)))
 	}
 }
 
@@ -1207,7 +1609,7 @@ func displayAllConflicts(stateInfos []RepositoryStateInfo) {
 // that are in the StateInRebase state (no current conflicts, but a rebase is ongoing).
 // It runs `git rebase --continue` for each such repository and leaves any new conflicts
 // for the user to resolve.
-func handleInProgressRebases(stateInfos []RepositoryStateInfo) error {
+func handleInProgressRebases(out io.Writer, stateInfos []RepositoryStateInfo) error {
 	var reposInRebase []RepositoryInfo
 	for _, stateInfo := range stateInfos {
 		if stateInfo.State == StateInRebase {
@@ -1219,21 +1621,21 @@ func handleInProgressRebases(stateInfos []RepositoryStateInfo) error {
 		return nil
 	}
 
-	fmt.Println("\nRepositories with in-progress rebases detected. Attempting to continue rebase operations...")
+	_, _ = fmt.Fprintln(out, "\nRepositories with in-progress rebases detected. Attempting to continue rebase operations...")
 
 	for _, repo := range reposInRebase {
-		fmt.Printf("  Updating %s: rebase-continue...\n", repo.Name)
+		_, _ = fmt.Fprintf(out, "  Updating %s: rebase-continue...\n", repo.Name)
 		if err := continueRebase(repo); err != nil {
 			// Surface the error but do not attempt to abort; the repository will remain
 			// in its current rebase state so the user can inspect conflicts or issues.
-			fmt.Printf("  ✗ %s: rebase --continue failed: %v\n", repo.Name, err)
-			fmt.Printf("    Resolve any reported issues or conflicts in %s, then run 'kira latest' again.\n", repo.Path)
+			_, _ = fmt.Fprintf(out, "  ✗ %s: rebase --continue failed: %v\n", repo.Name, err)
+			_, _ = fmt.Fprintf(out, "    Resolve any reported issues or conflicts in %s, then run 'kira latest' again.\n", repo.Path)
 			return fmt.Errorf("failed to continue rebase for %s: %w", repo.Name, err)
 		}
-		fmt.Printf("  ✓ %s: rebase continue completed\n", repo.Name)
+		_, _ = fmt.Fprintf(out, "  ✓ %s: rebase continue completed\n", repo.Name)
 	}
 
-	fmt.Println("\nRebase operations continued. If new conflicts were introduced, resolve them and run 'kira latest' again.")
+	_, _ = fmt.Fprintln(out, "\nRebase operations continued. If new conflicts were introduced, resolve them and run 'kira latest' again.")
 	return nil
 }
 
@@ -1241,12 +1643,21 @@ func handleInProgressRebases(stateInfos []RepositoryStateInfo) error {
 type RepositoryOperationResult struct {
 	Repo               RepositoryInfo
 	Error              error
-	Steps              []string // e.g., ["fetch", "rebase"] for progress tracking
-	HadStash           bool     // Whether changes were stashed before rebase
-	StashPopped        bool     // Whether stash was successfully popped after rebase
-	RebaseAttempted    bool     // Whether rebase operation was attempted (for rollback purposes)
-	RebaseAborted      bool     // Whether rebase was aborted during rollback
-	RebaseHadConflicts bool     // Whether the rebase failure was due to merge conflicts
+	Steps              []string      // e.g., ["fetch", "rebase"] for progress tracking
+	HadStash           bool          // Whether changes were stashed before rebase
+	StashPopped        bool          // Whether stash was successfully popped after rebase
+	RebaseAttempted    bool          // Whether rebase operation was attempted (for rollback purposes)
+	RebaseAborted      bool          // Whether rebase was aborted during rollback
+	RebaseHadConflicts bool          // Whether the rebase failure was due to merge conflicts
+	Autostash          bool          // Whether local changes were handled by git rebase --autostash (git.use_autostash)
+	Duration           time.Duration // Time spent processing the repository (stash, fetch, rebase, pop)
+	PrunedRefs         int           // Remote-tracking refs removed by --prune
+	Unauthorized       bool          // Whether the fetch failed with a permission/authentication error
+	Skipped            bool          // Whether the repository was not attempted because --fail-fast stopped earlier
+	MergedBranch       string        // Branch skipped because it is already merged into the remote trunk
+	CommitsPulled      int           // Commits the rebase or trunk update brought in from upstream
+	HookOutput         string        // Output of the hooks.after_update command, shown with --verbose
+	Branch             string        // Branch checked out when the repository was updated ("" when unknown)
 }
 
 // isNetworkError checks if an error string indicates a network error
@@ -1343,6 +1754,39 @@ func fetchFromRemote(repo RepositoryInfo) error {
 	return nil
 }
 
+// pruneRemoteBranches removes remote-tracking refs for branches deleted on the remote
+// and returns how many refs were pruned
+func pruneRemoteBranches(repo RepositoryInfo) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"remote", "prune", repo.Remote}, repo.Path, false)
+	if err != nil {
+		return 0, fmt.Errorf("prune failed: %w", err)
+	}
+	return strings.Count(output, "[pruned]"), nil
+}
+
+// pruneRemoteBranchesForResults prunes stale remote-tracking refs in each repository that updated
+// successfully, recording the pruned count (and any failure) on its result
+func pruneRemoteBranchesForResults(results []RepositoryOperationResult) {
+	for i := range results {
+		if results[i].Error != nil {
+			continue
+		}
+		start := time.Now()
+		pruned, err := pruneRemoteBranches(results[i].Repo)
+		results[i].Duration += time.Since(start)
+		if err != nil {
+			results[i].Error = err
+			results[i].Steps = append(results[i].Steps, "prune (failed)")
+			continue
+		}
+		results[i].PrunedRefs = pruned
+		results[i].Steps = append(results[i].Steps, "prune")
+	}
+}
+
 // checkRemoteExistsForLatest checks if a remote exists in the repository
 func checkRemoteExistsForLatest(remoteName, dir string) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
@@ -1402,13 +1846,22 @@ func continueRebase(repo RepositoryInfo) error {
 
 // isOnTrunkBranch returns true if the repository's current branch equals its configured trunk branch.
 func isOnTrunkBranch(repo RepositoryInfo) (bool, error) {
-	currentBranch, err := getCurrentBranch(repo.Path)
+	currentBranch, err := activeBranch(repo)
 	if err != nil {
-		return false, fmt.Errorf("failed to determine current branch: %w", err)
+		return false, err
 	}
 	return currentBranch == repo.TrunkBranch, nil
 }
 
+// activeBranch returns the branch checked out in the repository.
+func activeBranch(repo RepositoryInfo) (string, error) {
+	currentBranch, err := getCurrentBranch(repo.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine current branch: %w", err)
+	}
+	return currentBranch, nil
+}
+
 // gitNonInteractiveEnv returns env vars so git rebase never opens an editor or pager (e.g. in CI).
 var gitNonInteractiveEnv = []string{"GIT_EDITOR=true", "GIT_PAGER=cat"}
 
@@ -1419,7 +1872,7 @@ func updateTrunkFromRemote(repo RepositoryInfo) error {
 	defer cancel()
 
 	remoteRef := fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
-	_, err := executeCommandCombinedOutputWithEnv(ctx, "git", []string{"rebase", remoteRef}, repo.Path, gitNonInteractiveEnv, false)
+	_, err := executeCommandCombinedOutputWithEnv(ctx, "git", rebaseArgs(repo, remoteRef), repo.Path, gitNonInteractiveEnv, false)
 	if err != nil {
 		errStr := err.Error()
 		if strings.Contains(errStr, "CONFLICT") || strings.Contains(errStr, "conflict") {
@@ -1434,6 +1887,125 @@ func updateTrunkFromRemote(repo RepositoryInfo) error {
 	return nil
 }
 
+// rebaseArgs returns the git rebase arguments for rebasing onto refs, adding --autostash
+// when the repository uses git's autostash and --rebase-merges when merge commits are kept
+func rebaseArgs(repo RepositoryInfo, refs ...string) []string {
+	args := []string{"rebase"}
+	if repo.UseAutostash {
+		args = append(args, "--autostash")
+	}
+	if repo.RebaseMerges {
+		args = append(args, "--rebase-merges")
+	}
+	return append(args, refs...)
+}
+
+// withOntoRef sets the --onto target and --old-base on every repository; an empty ref keeps
+// rebasing onto trunk.
+func withOntoRef(repos []RepositoryInfo, onto, oldBase string) []RepositoryInfo {
+	for i := range repos {
+		repos[i].Onto = strings.TrimSpace(onto)
+		repos[i].OldBase = strings.TrimSpace(oldBase)
+	}
+	return repos
+}
+
+// validateLatestFailureMode rejects --keep-going together with --fail-fast, the mode it opts out of.
+func validateLatestFailureMode(cmd *cobra.Command) error {
+	if cmd == nil {
+		return nil
+	}
+	keepGoing, _ := cmd.Flags().GetBool("keep-going")
+	failFast, _ := cmd.Flags().GetBool("fail-fast")
+	if keepGoing && failFast {
+		return fmt.Errorf("invalid flag combination: --keep-going cannot be used together with --fail-fast")
+	}
+	return nil
+}
+
+// validateLatestOnto checks --onto and --old-base: they are given together, and only when a
+// single repository is updated, since in polyrepo the refs differ per repository.
+func validateLatestOnto(cmd *cobra.Command, repoCount int) error {
+	if cmd == nil {
+		return nil
+	}
+	onto, _ := cmd.Flags().GetString("onto")
+	oldBase, _ := cmd.Flags().GetString("old-base")
+	onto, oldBase = strings.TrimSpace(onto), strings.TrimSpace(oldBase)
+	switch {
+	case onto == "" && oldBase == "":
+		return nil
+	case onto == "":
+		return fmt.Errorf("--old-base requires --onto")
+	case oldBase == "":
+		return fmt.Errorf("--onto requires --old-base, the ref the current branch was built on")
+	case repoCount > 1:
+		return fmt.Errorf("--onto cannot be used when updating %d repositories: the refs differ per repository", repoCount)
+	}
+	return nil
+}
+
+// withRebaseMerges makes every repository keep its merge commits when rebasing if enabled
+// (--rebase-merges); otherwise git.rebase_merges decides.
+func withRebaseMerges(repos []RepositoryInfo, enabled bool) []RepositoryInfo {
+	for i := range repos {
+		repos[i].RebaseMerges = repos[i].RebaseMerges || enabled
+	}
+	return repos
+}
+
+// withUnshallow sets whether shallow clones are unshallowed before updating.
+func withUnshallow(repos []RepositoryInfo, unshallow bool) []RepositoryInfo {
+	for i := range repos {
+		repos[i].Unshallow = unshallow
+	}
+	return repos
+}
+
+// isShallowRepository reports whether the repository is a shallow clone.
+func isShallowRepository(dir string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"rev-parse", "--is-shallow-repository"}, dir, false)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for a shallow clone: %w", err)
+	}
+	return strings.TrimSpace(output) == "true", nil
+}
+
+// unshallowRepository fetches the full history of a shallow clone from the repository's remote.
+func unshallowRepository(repo RepositoryInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	if _, err := executeCommand(ctx, "git", []string{"fetch", "--unshallow", repo.Remote}, repo.Path, false); err != nil {
+		return fmt.Errorf("git fetch --unshallow %s failed: %w", repo.Remote, err)
+	}
+	return nil
+}
+
+// rebaseOntoRef rebases the current branch onto repo.Onto with git rebase --onto, replaying only
+// the commits made after repo.OldBase.
+func rebaseOntoRef(ctx context.Context, repo RepositoryInfo) error {
+	for _, ref := range []struct{ flag, name string }{{"--onto", repo.Onto}, {"--old-base", repo.OldBase}} {
+		if _, err := executeCommand(ctx, "git", []string{"rev-parse", "--verify", "--quiet", ref.name + "^{commit}"}, repo.Path, false); err != nil {
+			return fmt.Errorf("rebase failed: %s reference '%s' does not exist in %s", ref.flag, ref.name, repo.Name)
+		}
+	}
+
+	args := rebaseArgs(repo, "--onto", repo.Onto, repo.OldBase)
+	_, err := executeCommandCombinedOutputWithEnv(ctx, "git", args, repo.Path, gitNonInteractiveEnv, false)
+	if err != nil {
+		errStr := err.Error()
+		if strings.Contains(errStr, "CONFLICT") || strings.Contains(errStr, "conflict") {
+			return fmt.Errorf("rebase failed due to conflicts. Resolve conflicts and run 'kira latest' again: %w", err)
+		}
+		return fmt.Errorf("rebase onto %s failed: %w", repo.Onto, err)
+	}
+	return nil
+}
+
 // rebaseOntoTrunk rebases the current branch onto the remote trunk branch
 func rebaseOntoTrunk(repo RepositoryInfo) error {
 	// Get current branch name
@@ -1450,9 +2022,13 @@ func rebaseOntoTrunk(repo RepositoryInfo) error {
 	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
 	defer cancel()
 
+	if repo.Onto != "" {
+		return rebaseOntoRef(ctx, repo)
+	}
+
 	// Rebase onto remote/trunkBranch (GIT_EDITOR/GIT_PAGER avoid editor/pager in CI)
 	remoteRef := fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
-	_, err = executeCommandCombinedOutputWithEnv(ctx, "git", []string{"rebase", remoteRef}, repo.Path, gitNonInteractiveEnv, false)
+	_, err = executeCommandCombinedOutputWithEnv(ctx, "git", rebaseArgs(repo, remoteRef), repo.Path, gitNonInteractiveEnv, false)
 	if err != nil {
 		errStr := err.Error()
 		if strings.Contains(errStr, "CONFLICT") || strings.Contains(errStr, "conflict") {
@@ -1478,7 +2054,7 @@ func rebaseOntoLocalTrunk(repo RepositoryInfo) error {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
 	defer cancel()
-	_, err = executeCommandCombinedOutputWithEnv(ctx, "git", []string{"rebase", repo.TrunkBranch}, repo.Path, gitNonInteractiveEnv, false)
+	_, err = executeCommandCombinedOutputWithEnv(ctx, "git", rebaseArgs(repo, repo.TrunkBranch), repo.Path, gitNonInteractiveEnv, false)
 	if err != nil {
 		errStr := err.Error()
 		if strings.Contains(errStr, "CONFLICT") || strings.Contains(errStr, "conflict") {
@@ -1505,7 +2081,7 @@ func performFetchAndRebase(repo RepositoryInfo, noPopStash bool) (bool, error) {
 }
 
 // performFetchAndRebaseForAllRepos performs fetch and rebase operations for all repositories in parallel
-func performFetchAndRebaseForAllRepos(repos []RepositoryInfo, abortOnConflict, noPopStash bool) []RepositoryOperationResult {
+func performFetchAndRebaseForAllRepos(out io.Writer, repos []RepositoryInfo, abortOnConflict, noPopStash bool) []RepositoryOperationResult {
 	var wg sync.WaitGroup
 	results := make([]RepositoryOperationResult, len(repos))
 	var mu sync.Mutex
@@ -1514,7 +2090,7 @@ func performFetchAndRebaseForAllRepos(repos []RepositoryInfo, abortOnConflict, n
 		wg.Add(1)
 		go func(index int, repository RepositoryInfo) {
 			defer wg.Done()
-			result := processRepositoryUpdate(repository, abortOnConflict, noPopStash, &mu)
+			result := processRepositoryUpdate(out, repository, abortOnConflict, noPopStash, &mu)
 			mu.Lock()
 			results[index] = result
 			mu.Unlock()
@@ -1525,21 +2101,50 @@ func performFetchAndRebaseForAllRepos(repos []RepositoryInfo, abortOnConflict, n
 	return results
 }
 
+// performFetchAndRebaseUntilFailure updates repositories one at a time in order (--fail-fast).
+// After the first failure the remaining repositories are not attempted and are marked as skipped.
+func performFetchAndRebaseUntilFailure(out io.Writer, repos []RepositoryInfo, abortOnConflict, noPopStash bool) []RepositoryOperationResult {
+	results := make([]RepositoryOperationResult, len(repos))
+	var mu sync.Mutex
+	failed := ""
+
+	for i, repo := range repos {
+		if failed != "" {
+			results[i] = RepositoryOperationResult{
+				Repo:    repo,
+				Error:   fmt.Errorf("not attempted: %s failed and --fail-fast is set", failed),
+				Steps:   []string{},
+				Skipped: true,
+			}
+			continue
+		}
+		results[i] = processRepositoryUpdate(out, repo, abortOnConflict, noPopStash, &mu)
+		if results[i].Error != nil {
+			failed = repo.Name
+		}
+	}
+	return results
+}
+
 // processRepositoryUpdate handles the update process for a single repository.
 // It uses RunWithCleanTree so the "check → stash → fetch+rebase → pop/restore" flow is centralized.
 // When rebase has conflicts and abortOnConflict is false, the callback returns ErrKeepStashOnFailure
 // so the stash is left in place for the user to resolve and re-run.
-func processRepositoryUpdate(repo RepositoryInfo, abortOnConflict, noPopStash bool, mu *sync.Mutex) RepositoryOperationResult {
+func processRepositoryUpdate(out io.Writer, repo RepositoryInfo, abortOnConflict, noPopStash bool, mu *sync.Mutex) RepositoryOperationResult {
+	start := time.Now()
 	result := RepositoryOperationResult{
 		Repo:  repo,
 		Steps: []string{},
 	}
 
 	callback := func() error {
-		if err := performFetchStep(&result, repo, mu); err != nil {
+		if err := performShallowCheckStep(out, &result, repo, mu); err != nil {
+			return err
+		}
+		if err := performFetchStep(out, &result, repo, mu); err != nil {
 			return err
 		}
-		rebaseErr := performRebaseStep(&result, repo, mu)
+		rebaseErr := performRebaseStep(out, &result, repo, mu)
 		if rebaseErr != nil {
 			if result.RebaseHadConflicts && !abortOnConflict {
 				// Do not abort rebase: leave conflicts for the user to resolve; do not pop stash.
@@ -1556,48 +2161,149 @@ func processRepositoryUpdate(repo RepositoryInfo, abortOnConflict, noPopStash bo
 		return nil
 	}
 
+	if repo.UseAutostash {
+		updateWithAutostash(&result, repo, callback)
+		runSubmoduleUpdate(out, &result, repo, mu)
+		runAfterUpdateHook(out, &result, repo, mu)
+		result.Duration = time.Since(start)
+		mu.Lock()
+		displayOperationProgress(out, repo.Name, "complete")
+		mu.Unlock()
+		return result
+	}
+
 	hadStash, opErr := RunWithCleanTree(repo.Path, "latest", repo.Name, noPopStash, callback)
 	result.HadStash = hadStash
+	recordStashOutcome(&result, hadStash, noPopStash, opErr)
 
 	if opErr != nil {
 		if result.Error == nil {
 			result.Error = opErr
 		}
-		if hadStash && errors.Is(opErr, ErrKeepStashOnFailure) {
-			result.Steps = append(result.Steps, "stash (kept)")
-		} else if hadStash && !noPopStash {
-			// RunWithCleanTree restored the stash on failure
-			result.StashPopped = true
-			result.Steps = append(result.Steps, "stash-pop")
-		}
-		mu.Lock()
-		displayOperationProgress(repo.Name, "complete")
-		mu.Unlock()
-		return result
+	} else {
+		runSubmoduleUpdate(out, &result, repo, mu)
+		runAfterUpdateHook(out, &result, repo, mu)
 	}
 
-	if hadStash && !noPopStash {
+	result.Duration = time.Since(start)
+	mu.Lock()
+	displayOperationProgress(out, repo.Name, "complete")
+	mu.Unlock()
+	return result
+}
+
+// recordStashOutcome records what happened to the stash RunWithCleanTree made: kept after
+// conflicts (for the user to resolve) or with --no-pop-stash after an update, otherwise popped,
+// either after the update or restored after a failure.
+func recordStashOutcome(result *RepositoryOperationResult, hadStash, noPopStash bool, opErr error) {
+	switch {
+	case !hadStash:
+	case errors.Is(opErr, ErrKeepStashOnFailure):
+		result.Steps = append(result.Steps, "stash (kept)")
+	case !noPopStash:
 		result.StashPopped = true
 		result.Steps = append(result.Steps, "stash-pop")
-	} else if hadStash {
+	case opErr == nil:
 		result.Steps = append(result.Steps, "stash (kept)")
 	}
+}
+
+// updateWithAutostash runs the fetch/rebase callback without kira's stash/pop: local changes are
+// stashed and reapplied by git rebase --autostash. HadStash and StashPopped are derived from the
+// working tree state before the rebase and the stash list afterwards.
+func updateWithAutostash(result *RepositoryOperationResult, repo RepositoryInfo, callback func() error) {
+	result.Autostash = true
+	dirty, err := HasUncommitted(repo.Path, false)
+	if err != nil {
+		result.Error = err
+		return
+	}
+	stashesBefore, _ := countStashEntries(repo.Path)
+
+	opErr := callback()
+	if opErr != nil && result.Error == nil {
+		result.Error = opErr
+	}
+
+	// git only autostashes once the rebase starts (not when the fetch failed)
+	result.HadStash = dirty && result.RebaseAttempted
+	if !result.HadStash {
+		return
+	}
+
+	switch {
+	case opErr != nil && !result.RebaseAborted:
+		// Rebase stopped (e.g. on conflicts); git reapplies the autostash when it completes or is aborted
+		result.Steps = append(result.Steps, "autostash (pending rebase)")
+	case opErr != nil:
+		// git rebase --abort reapplied the autostash
+		result.StashPopped = true
+		result.Steps = append(result.Steps, "autostash-restore")
+	default:
+		stashesAfter, _ := countStashEntries(repo.Path)
+		if stashesAfter > stashesBefore {
+			// Reapplying conflicted; git saved the changes as a stash entry
+			result.Steps = append(result.Steps, "autostash (kept)")
+		} else {
+			result.StashPopped = true
+			result.Steps = append(result.Steps, "autostash")
+		}
+	}
+}
+
+// countStashEntries returns the number of entries in the repository's stash list
+func countStashEntries(dir string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"stash", "list"}, dir, false)
+	if err != nil {
+		return 0, err
+	}
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return 0, nil
+	}
+	return len(strings.Split(output, "\n")), nil
+}
+
+// performShallowCheckStep fails early for shallow clones, whose truncated history makes a rebase
+// fail with an opaque error, or unshallows them first when repo.Unshallow is set.
+func performShallowCheckStep(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) error {
+	shallow, err := isShallowRepository(repo.Path)
+	if err != nil || !shallow {
+		// Not being able to tell is not fatal; the fetch and rebase report real problems
+		return nil
+	}
+	if !repo.Unshallow {
+		err := fmt.Errorf("repository %s is shallow; run with --unshallow or fetch more history", repo.Name)
+		result.Error = err
+		result.Steps = append(result.Steps, "shallow-check (failed)")
+		return err
+	}
 
 	mu.Lock()
-	displayOperationProgress(repo.Name, "complete")
+	displayOperationProgress(out, repo.Name, "unshallowing")
 	mu.Unlock()
-	return result
+	if err := unshallowRepository(repo); err != nil {
+		result.Error = fmt.Errorf("unshallow failed: %w", err)
+		result.Steps = append(result.Steps, "unshallow (failed)")
+		return err
+	}
+	result.Steps = append(result.Steps, "unshallow")
+	return nil
 }
 
 // performFetchStep performs the fetch operation
-func performFetchStep(result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) error {
+func performFetchStep(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) error {
 	mu.Lock()
-	displayOperationProgress(repo.Name, "fetching")
+	displayOperationProgress(out, repo.Name, "fetching")
 	mu.Unlock()
 
 	if err := fetchFromRemote(repo); err != nil {
 		result.Error = fmt.Errorf("fetch failed: %w", err)
 		result.Steps = append(result.Steps, "fetch (failed)")
+		result.Unauthorized = isPermissionError(strings.ToLower(err.Error()))
 		return err
 	}
 
@@ -1606,26 +2312,33 @@ func performFetchStep(result *RepositoryOperationResult, repo RepositoryInfo, mu
 }
 
 // performRebaseStep performs the rebase or trunk-update operation depending on current branch
-func performRebaseStep(result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) error {
-	onTrunk, err := isOnTrunkBranch(repo)
+func performRebaseStep(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) error {
+	branch, err := activeBranch(repo)
 	if err != nil {
 		result.Error = err
 		result.Steps = append(result.Steps, "branch-check (failed)")
 		return err
 	}
+	result.Branch = branch
+	onTrunk := branch == repo.TrunkBranch
+
+	if !onTrunk && repo.Onto == "" && skipRebaseIfMerged(out, result, repo, mu) {
+		return nil
+	}
 
 	if onTrunk {
 		mu.Lock()
-		displayOperationProgress(repo.Name, "updating trunk")
+		displayOperationProgress(out, repo.Name, "updating trunk")
 		mu.Unlock()
 	} else {
 		mu.Lock()
-		displayOperationProgress(repo.Name, "rebasing")
+		displayOperationProgress(out, repo.Name, "rebasing")
 		mu.Unlock()
 	}
 
 	// Mark that we're attempting rebase/trunk-update (for rollback purposes)
 	result.RebaseAttempted = true
+	result.CommitsPulled = countIncomingCommits(repo, onTrunk)
 
 	if onTrunk {
 		if err := updateTrunkFromRemote(repo); err != nil {
@@ -1640,22 +2353,26 @@ func performRebaseStep(result *RepositoryOperationResult, repo RepositoryInfo, m
 		return nil
 	}
 
+	step := "rebase"
+	if repo.Onto != "" {
+		step = fmt.Sprintf("rebase --onto %s", repo.Onto)
+	}
 	if err := rebaseOntoTrunk(repo); err != nil {
 		if strings.Contains(err.Error(), "rebase failed due to conflicts") {
 			result.RebaseHadConflicts = true
 		}
 		result.Error = fmt.Errorf("rebase failed: %w", err)
-		result.Steps = append(result.Steps, "rebase (failed)")
+		result.Steps = append(result.Steps, step+" (failed)")
 		return err
 	}
 
-	result.Steps = append(result.Steps, "rebase")
+	result.Steps = append(result.Steps, step)
 	return nil
 }
 
 // displayOperationProgress displays progress for a repository operation
-func displayOperationProgress(repoName, operation string) {
-	fmt.Printf("  Updating %s: %s...\n", repoName, operation)
+func displayOperationProgress(out io.Writer, repoName, operation string) {
+	_, _ = fmt.Fprintf(out, "  Updating %s: %s...\n", repoName, operation)
 }
 
 // getRecoverySteps generates recovery steps for a failed repository operation
@@ -1684,95 +2401,241 @@ func getRecoverySteps(result RepositoryOperationResult) []string {
 
 	// Stash-related guidance
 	if result.HadStash && !result.StashPopped {
-		if result.RebaseAborted || !result.RebaseAttempted {
-			recoverySteps = append(recoverySteps,
-				fmt.Sprintf("Run 'git stash pop' in %s to restore stashed changes", result.Repo.Path),
-			)
-		} else if result.RebaseHadConflicts && !result.RebaseAborted {
-			recoverySteps = append(recoverySteps,
-				fmt.Sprintf("Your uncommitted changes were stashed and kept while the rebase is in progress. After the rebase completes, run 'git stash pop' in %s to restore them.", result.Repo.Path),
-			)
-		} else {
-			recoverySteps = append(recoverySteps,
-				fmt.Sprintf("Use 'git stash list' and 'git stash pop' in %s to restore any stashed changes once the repository is in a clean state.", result.Repo.Path),
-			)
-		}
+		recoverySteps = append(recoverySteps, stashRecoveryStep(result))
 	}
 	return recoverySteps
 }
 
+// stashRecoveryStep returns the guidance for restoring changes that were stashed and not popped
+func stashRecoveryStep(result RepositoryOperationResult) string {
+	switch {
+	case result.Autostash && result.RebaseAttempted && !result.RebaseAborted:
+		return fmt.Sprintf("Your uncommitted changes were autostashed by git. They are reapplied automatically when the rebase in %s completes ('git rebase --continue') or is aborted ('git rebase --abort').", result.Repo.Path)
+	case result.RebaseAborted || !result.RebaseAttempted:
+		return fmt.Sprintf("Run 'git stash pop' in %s to restore stashed changes", result.Repo.Path)
+	case result.RebaseHadConflicts:
+		return fmt.Sprintf("Your uncommitted changes were stashed and kept while the rebase is in progress. After the rebase completes, run 'git stash pop' in %s to restore them.", result.Repo.Path)
+	default:
+		return fmt.Sprintf("Use 'git stash list' and 'git stash pop' in %s to restore any stashed changes once the repository is in a clean state.", result.Repo.Path)
+	}
+}
+
 // displayFailedResult displays information about a failed repository operation
-func displayFailedResult(result RepositoryOperationResult) {
-	fmt.Printf("  ✗ %s: FAILED\n", result.Repo.Name)
-	fmt.Printf("    Error: %v\n", result.Error)
+func displayFailedResult(out io.Writer, result RepositoryOperationResult, verbose bool) {
+	_, _ = fmt.Fprintf(out, "  ✗ %s: FAILED (%s)\n", result.Repo.Name, formatOperationDuration(result.Duration))
+	if result.Branch != "" {
+		_, _ = fmt.Fprintf(out, "    Branch: %s\n", result.Branch)
+	}
+	_, _ = fmt.Fprintf(out, "    Error: %v\n", result.Error)
 	if len(result.Steps) > 0 {
-		fmt.Printf("    Completed steps: %s\n", strings.Join(result.Steps, ", "))
+		_, _ = fmt.Fprintf(out, "    Completed steps: %s\n", strings.Join(result.Steps, ", "))
+	}
+
+	if verbose {
+		displayHookOutput(out, result)
 	}
 
 	recoverySteps := getRecoverySteps(result)
 	if len(recoverySteps) > 0 {
-		fmt.Printf("    Recovery steps:\n")
+		_, _ = fmt.Fprintf(out, "    Recovery steps:\n")
 		for _, step := range recoverySteps {
-			fmt.Printf("      - %s\n", step)
+			_, _ = fmt.Fprintf(out, "      - %s\n", step)
 		}
 	}
 }
 
 // displaySuccessfulResult displays information about a successful repository operation
-func displaySuccessfulResult(result RepositoryOperationResult) {
-	fmt.Printf("  ✓ %s: SUCCESS\n", result.Repo.Name)
+func displaySuccessfulResult(out io.Writer, result RepositoryOperationResult, verbose bool) {
+	_, _ = fmt.Fprintf(out, "  ✓ %s: SUCCESS (%s)\n", result.Repo.Name, formatOperationDuration(result.Duration))
+	if description := describeBranchUpdate(result); description != "" {
+		_, _ = fmt.Fprintf(out, "    Branch: %s\n", description)
+	}
 	if len(result.Steps) > 0 {
-		fmt.Printf("    Completed: %s\n", strings.Join(result.Steps, ", "))
+		_, _ = fmt.Fprintf(out, "    Completed: %s\n", strings.Join(result.Steps, ", "))
+	}
+	if containsString(result.Steps, "prune") {
+		_, _ = fmt.Fprintf(out, "    Pruned: %d stale remote-tracking ref(s)\n", result.PrunedRefs)
+	}
+	if result.MergedBranch != "" {
+		_, _ = fmt.Fprintf(out, "    Note: branch %s is already merged into %s; nothing to rebase\n", result.MergedBranch, result.Repo.TrunkBranch)
+		if containsString(result.Steps, "cleanup-merged") {
+			_, _ = fmt.Fprintf(out, "    Deleted branch %s and its worktree\n", result.MergedBranch)
+		}
 	}
 	if result.HadStash && !result.StashPopped {
-		fmt.Printf("    Note: Changes were stashed and remain in stash (use 'git stash pop' to restore)\n")
+		_, _ = fmt.Fprintf(out, "    Note: Changes were stashed and remain in stash (use 'git stash pop' to restore)\n")
+	}
+	if verbose {
+		displayHookOutput(out, result)
 	}
 }
 
-// displayFailedReposGuidance displays overall guidance for failed repositories
-func displayFailedReposGuidance(failedRepos []RepositoryOperationResult) {
-	if len(failedRepos) == 0 {
-		return
+// describeBranchUpdate describes what happened to the branch of a successful result, e.g.
+// "feature rebased onto origin/main" or "main updated from origin/main". Without a known branch
+// it returns "", and for a branch already merged just the branch (the merge note explains why).
+func describeBranchUpdate(result RepositoryOperationResult) string {
+	repo := result.Repo
+	switch {
+	case result.Branch == "":
+		return ""
+	case result.MergedBranch != "":
+		return result.Branch
+	case result.Branch == repo.TrunkBranch:
+		return fmt.Sprintf("%s updated from %s/%s", result.Branch, repo.Remote, repo.TrunkBranch)
+	case repo.Onto != "":
+		return fmt.Sprintf("%s rebased onto %s", result.Branch, repo.Onto)
+	default:
+		return fmt.Sprintf("%s rebased onto %s/%s", result.Branch, repo.Remote, repo.TrunkBranch)
 	}
+}
 
-	fmt.Println()
-	fmt.Println("Next steps for failed repositories:")
-	for _, result := range failedRepos {
-		fmt.Printf("  %s:\n", result.Repo.Name)
-		steps := getRecoverySteps(result)
-		stepNum := 1
-		for _, step := range steps {
-			fmt.Printf("    %d. %s\n", stepNum, step)
-			stepNum++
-		}
-		fmt.Printf("    %d. Fix the issue described above and run 'kira latest' again\n", stepNum)
+// displayHookOutput prints the output of the hooks.after_update command, indented (--verbose).
+func displayHookOutput(out io.Writer, result RepositoryOperationResult) {
+	if result.HookOutput == "" {
+		return
+	}
+	_, _ = fmt.Fprintf(out, "    Hook output:\n")
+	for _, line := range strings.Split(result.HookOutput, "\n") {
+		_, _ = fmt.Fprintf(out, "      %s\n", line)
 	}
 }
 
-// displayOperationResults displays the results of all repository operations
-func displayOperationResults(results []RepositoryOperationResult) {
-	fmt.Println("\nOperation Results:")
-	fmt.Println("───────────────────────────────────────────────────────────────")
+// formatOperationDuration formats a repository operation duration for display
+func formatOperationDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+// sortResultsByDuration returns a copy of results ordered slowest first
+func sortResultsByDuration(results []RepositoryOperationResult) []RepositoryOperationResult {
+	sorted := make([]RepositoryOperationResult, len(results))
+	copy(sorted, results)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Duration > sorted[j].Duration
+	})
+	return sorted
+}
+
+// displayOperationResults displays the results of all repository operations and elapsed, the
+// wall-clock time of the update (repositories are updated concurrently, so it is less than the
+// sum of their durations). When verbose, results are listed slowest first and the slowest
+// repository is called out.
+func displayOperationResults(out io.Writer, results []RepositoryOperationResult, verbose bool, elapsed time.Duration) {
+	_, _ = fmt.Fprintln(out, "\nOperation Results:")
+	_, _ = fmt.Fprintln(out, "───────────────────────────────────────────────────────────────")
 
 	successCount := 0
 	failureCount := 0
 	var failedRepos []RepositoryOperationResult
 
-	for _, result := range results {
+	ordered := results
+	if verbose {
+		ordered = sortResultsByDuration(results)
+	}
+
+	for _, result := range ordered {
 		if result.Error != nil {
 			failureCount++
 			failedRepos = append(failedRepos, result)
-			displayFailedResult(result)
+			displayFailedResult(out, result, verbose)
 		} else {
 			successCount++
-			displaySuccessfulResult(result)
+			displaySuccessfulResult(out, result, verbose)
 		}
 	}
 
-	fmt.Println("───────────────────────────────────────────────────────────────")
-	fmt.Printf("Summary: %d succeeded, %d failed\n", successCount, failureCount)
+	_, _ = fmt.Fprintln(out, "───────────────────────────────────────────────────────────────")
+	_, _ = fmt.Fprintf(out, "Summary: %d succeeded, %d failed (total time: %s)\n", successCount, failureCount, formatOperationDuration(elapsed))
+	if failureCount > 0 {
+		_, _ = fmt.Fprintf(out, "Failures by cause: %s\n", formatFailureCauseCounts(groupFailuresByCause(failedRepos)))
+	}
+	if verbose && len(ordered) > 1 {
+		_, _ = fmt.Fprintf(out, "Slowest: %s (%s)\n", ordered[0].Repo.Name, formatOperationDuration(ordered[0].Duration))
+	}
+	displaySkippedRepos(out, results)
+
+	displayFailedReposGuidance(out, failedRepos)
+}
+
+// displaySkippedRepos lists repositories that could not be fetched for lack of access and
+// repositories that were not attempted because of --fail-fast
+func displaySkippedRepos(out io.Writer, results []RepositoryOperationResult) {
+	var unauthorized, notAttempted []string
+	for _, result := range results {
+		switch {
+		case result.Unauthorized:
+			unauthorized = append(unauthorized, result.Repo.Name)
+		case result.Skipped:
+			notAttempted = append(notAttempted, result.Repo.Name)
+		}
+	}
+	if len(unauthorized) > 0 {
+		_, _ = fmt.Fprintf(out, "No access (skipped, other repositories were still updated): %s\n", strings.Join(unauthorized, ", "))
+	}
+	if len(notAttempted) > 0 {
+		_, _ = fmt.Fprintf(out, "Not attempted (--fail-fast): %s\n", strings.Join(notAttempted, ", "))
+	}
+}
+
+// writeOperationResultsJSON writes the repository operation results, including durations, as JSON.
+// The work item they were for, if any, is included as work_item, and elapsed, the wall-clock time
+// of the update, as total_duration_ms.
+func writeOperationResultsJSON(w io.Writer, results []RepositoryOperationResult, workItem *latestWorkItem, elapsed time.Duration) error {
+	type jsonResult struct {
+		Name         string   `json:"name"`
+		Path         string   `json:"path"`
+		Branch       string   `json:"branch,omitempty"`
+		Success      bool     `json:"success"`
+		Error        string   `json:"error,omitempty"`
+		Steps        []string `json:"steps"`
+		HadStash     bool     `json:"had_stash"`
+		PrunedRefs   int      `json:"pruned_refs"`
+		Unauthorized bool     `json:"unauthorized"`
+		Skipped      bool     `json:"skipped"`
+		MergedBranch string   `json:"merged_branch,omitempty"`
+		Cause        string   `json:"cause,omitempty"`
+		DurationMs   int64    `json:"duration_ms"`
+	}
+
+	jsonResults := make([]jsonResult, len(results))
+	succeeded := 0
+	for i, result := range results {
+		jsonResults[i] = jsonResult{
+			Name:         result.Repo.Name,
+			Path:         result.Repo.Path,
+			Branch:       result.Branch,
+			Success:      result.Error == nil,
+			Steps:        result.Steps,
+			HadStash:     result.HadStash,
+			PrunedRefs:   result.PrunedRefs,
+			Unauthorized: result.Unauthorized,
+			Skipped:      result.Skipped,
+			MergedBranch: result.MergedBranch,
+			DurationMs:   result.Duration.Milliseconds(),
+		}
+		if jsonResults[i].Steps == nil {
+			jsonResults[i].Steps = []string{}
+		}
+		if result.Error != nil {
+			jsonResults[i].Error = result.Error.Error()
+			jsonResults[i].Cause = string(latestFailureCauseOf(result))
+		} else {
+			succeeded++
+		}
+	}
+
+	output := map[string]interface{}{
+		"repositories":      jsonResults,
+		"succeeded":         succeeded,
+		"failed":            len(results) - succeeded,
+		"total_duration_ms": elapsed.Milliseconds(),
+	}
+	if workItem != nil {
+		output["work_item"] = workItem
+	}
 
-	displayFailedReposGuidance(failedRepos)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
 }
 
 // runReviewTrunkUpdateAndRebase runs trunk update and/or rebase for the review command.
@@ -1789,11 +2652,11 @@ func runReviewTrunkUpdateAndRebase(cfg *config.Config, _ string, noTrunkUpdate,
 	if len(repos) == 0 {
 		return fmt.Errorf("no repositories found for the current workspace")
 	}
-	displayDiscoveredRepositories(repos)
-	stateInfos := checkAllRepositoryStates(repos)
+	displayDiscoveredRepositories(os.Stdout, repos)
+	stateInfos := checkAllRepositoryStates(os.Stdout, repos)
 	aggregated := aggregateRepositoryStates(stateInfos)
-	displayStateSummary(stateInfos, aggregated)
-	skip, err := runReviewValidateState(aggregated, stateInfos)
+	displayStateSummary(os.Stdout, stateInfos, aggregated)
+	skip, err := runReviewValidateState(aggregated, stateInfos, cfg)
 	if err != nil {
 		return err
 	}
@@ -1807,11 +2670,12 @@ func runReviewTrunkUpdateAndRebase(cfg *config.Config, _ string, noTrunkUpdate,
 	if len(reposToProcess) == 0 {
 		return nil
 	}
-	displayUpdateMessage(aggregated.DirtyRepos, false)
+	displayUpdateMessage(os.Stdout, aggregated.DirtyRepos, false)
 	orderedRepos := orderRepositoriesByDependencies(reposToProcess)
 	if !noTrunkUpdate && !noRebase {
-		results := performFetchAndRebaseForAllRepos(orderedRepos, false, false)
-		return handleUpdateResults(results)
+		start := time.Now()
+		results := performFetchAndRebaseForAllRepos(os.Stdout, orderedRepos, false, false)
+		return handleUpdateResults(results, latestOutput{Progress: os.Stdout, Elapsed: time.Since(start)})
 	}
 	if noTrunkUpdate && !noRebase {
 		return runReviewRebaseOntoLocalOnly(orderedRepos)
@@ -1819,9 +2683,9 @@ func runReviewTrunkUpdateAndRebase(cfg *config.Config, _ string, noTrunkUpdate,
 	return runReviewFetchAndTrunkUpdateOnly(orderedRepos)
 }
 
-func runReviewValidateState(aggregated AggregatedState, stateInfos []RepositoryStateInfo) (skip bool, err error) {
+func runReviewValidateState(aggregated AggregatedState, stateInfos []RepositoryStateInfo, cfg *config.Config) (skip bool, err error) {
 	if aggregated.OverallState == StateConflictsExist {
-		displayAllConflicts(stateInfos)
+		displayAllConflicts(os.Stdout, stateInfos, conflictFormatPlain, "", cfg)
 		return false, fmt.Errorf("resolve conflicts before submitting for review")
 	}
 	if aggregated.OverallState == StateInRebase {
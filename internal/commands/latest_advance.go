@@ -0,0 +1,123 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"kira/internal/config"
+)
+
+// advanceOnMergeStatus returns workflow.advance_on_merge: the status a work item is moved to once
+// its branch is merged, or "" when merged branches leave their work item alone.
+func advanceOnMergeStatus(cfg *config.Config) string {
+	if cfg == nil || cfg.Workflow == nil {
+		return ""
+	}
+	return cfg.Workflow.AdvanceOnMerge
+}
+
+// advanceMergedWorkItems moves the work item of each branch found to be merged into trunk to
+// workflow.advance_on_merge, once per work item, and reports it. The move updates the status field
+// and folder but is not committed. With dryRun the move is only previewed.
+func advanceMergedWorkItems(out io.Writer, results []RepositoryOperationResult, cfg *config.Config, dryRun bool) {
+	target := advanceOnMergeStatus(cfg)
+	if target == "" {
+		return
+	}
+	advanced := make(map[string]bool)
+	for i := range results {
+		if results[i].Error != nil || results[i].MergedBranch == "" {
+			continue
+		}
+		workItemID, err := parseWorkItemIDFromBranch(results[i].MergedBranch, cfg)
+		if err != nil || advanced[workItemID] {
+			continue // not a kira branch, or already advanced for another repository
+		}
+		advanced[workItemID] = true
+		advanceMergedWorkItem(out, &results[i], workItemID, target, cfg, dryRun)
+	}
+}
+
+// advanceMergedWorkItem moves one merged branch's work item to target. A failed move marks the
+// result as failed so kira latest exits non-zero.
+func advanceMergedWorkItem(out io.Writer, result *RepositoryOperationResult, workItemID, target string, cfg *config.Config, dryRun bool) {
+	if containsString(result.Steps, "cleanup-merged") {
+		_, _ = fmt.Fprintf(out, "Note: work item %s was not moved to %s because --cleanup-merged removed its worktree; run 'kira move %s %s' on trunk\n", workItemID, target, workItemID, target)
+		return
+	}
+	workItemPath, err := findWorkItemFileInAllStatusFolders(workItemID, cfg)
+	if err != nil || workItemPath == "" {
+		_, _ = fmt.Fprintf(out, "Note: branch %s is merged but work item %s was not found; nothing to advance\n", result.MergedBranch, workItemID)
+		return
+	}
+	var metadata workItemMetadata
+	metadata.workItemType, metadata.id, metadata.title, metadata.currentStatus, metadata.repos, err = extractWorkItemMetadata(workItemPath, cfg)
+	if err != nil {
+		result.Error = fmt.Errorf("failed to advance work item %s: %w", workItemID, err)
+		return
+	}
+	if metadata.currentStatus == target {
+		return
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintf(out, "[DRY RUN] Would advance work item %s from %s to %s (branch %s is merged)\n", workItemID, metadata.currentStatus, target, result.MergedBranch)
+	}
+	if _, err := moveResolvedWorkItem(cfg, workItemID, workItemPath, target, false, dryRun, metadata, nil, out); err != nil {
+		result.Error = fmt.Errorf("failed to advance work item %s to %s: %w", workItemID, target, err)
+		result.Steps = append(result.Steps, "advance-on-merge (failed)")
+		return
+	}
+	if !dryRun {
+		_, _ = fmt.Fprintf(out, "Advanced work item %s from %s to %s (branch %s is merged)\n", workItemID, metadata.currentStatus, target, result.MergedBranch)
+		result.Steps = append(result.Steps, "advance-on-merge")
+	}
+}
+
+// finishLatestUpdate runs the steps that follow the fetch and rebase of all repositories: --prune,
+// --cleanup-merged and workflow.advance_on_merge, and reports the commits left to push.
+func finishLatestUpdate(out io.Writer, results []RepositoryOperationResult, prune, cleanupMerged bool, cfg *config.Config) {
+	if prune {
+		pruneRemoteBranchesForResults(results)
+	}
+	if cleanupMerged {
+		cleanupMergedBranchesForResults(results)
+	}
+	advanceMergedWorkItems(out, results, cfg, false)
+	displayUnpushedAfterUpdate(out, results)
+}
+
+// previewLatestUpdate is kira latest --dry-run: it reports what each repository would get (a
+// trunk update or a rebase) without stashing, fetching or rebasing, and previews
+// workflow.advance_on_merge for branches already merged as of the last fetch.
+func previewLatestUpdate(out io.Writer, repos []RepositoryInfo, cfg *config.Config) error {
+	_, _ = fmt.Fprintln(out, "[DRY RUN] Would perform the following operations (remote state as of the last fetch):")
+	var mu sync.Mutex
+	results := make([]RepositoryOperationResult, 0, len(repos))
+	for _, repo := range repos {
+		result := RepositoryOperationResult{Repo: repo}
+		onTrunk, err := isOnTrunkBranch(repo)
+		switch {
+		case err != nil:
+			return err
+		case onTrunk:
+			_, _ = fmt.Fprintf(out, "[DRY RUN] %s: fetch %s and update %s from %s/%s\n", repo.Name, repo.Remote, repo.TrunkBranch, repo.Remote, repo.TrunkBranch)
+		case repo.Onto == "" && skipRebaseIfMerged(out, &result, repo, &mu):
+		default:
+			_, _ = fmt.Fprintf(out, "[DRY RUN] %s: fetch %s and rebase onto %s\n", repo.Name, repo.Remote, latestRebaseTarget(repo))
+		}
+		results = append(results, result)
+	}
+	advanceMergedWorkItems(out, results, cfg, true)
+	return nil
+}
+
+// latestRebaseTarget returns the ref a feature branch is rebased onto: --onto, else the remote trunk.
+func latestRebaseTarget(repo RepositoryInfo) string {
+	if repo.Onto != "" {
+		return repo.Onto
+	}
+	return fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
+}
@@ -0,0 +1,102 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// annotateBranchChanges sets ModifiedByBranch on each conflicted file the current branch changed
+// since base (the remote trunk when base is ""), per git diff --name-only <base>...<branch>.
+// During a rebase HEAD is the upstream being rebased onto, so the branch's original tip is used.
+// BranchBase records the base; when the changed files cannot be listed, e.g. because the base
+// ref does not exist, it stays "" and the files are left unmarked.
+func annotateBranchChanges(repoConflicts *RepositoryConflicts, base string) {
+	repo := repoConflicts.Repo
+	if base == "" {
+		if repo.Remote == "" || repo.TrunkBranch == "" {
+			return
+		}
+		base = fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
+	}
+	changed, err := branchChangedFiles(repo.Path, base)
+	if err != nil {
+		return
+	}
+	repoConflicts.BranchBase = base
+	for i := range repoConflicts.Files {
+		repoConflicts.Files[i].ModifiedByBranch = changed[filepath.ToSlash(repoConflicts.Files[i].FilePath)]
+	}
+}
+
+// branchChangedFiles returns the paths (relative to the repository root) changed on the current
+// branch since it forked from base.
+func branchChangedFiles(repoPath, base string) (map[string]bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"diff", "--name-only", base + "..." + branchTip(ctx, repoPath)}, repoPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files changed since %s: %w", base, err)
+	}
+	changed := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		if path := strings.TrimSpace(line); path != "" {
+			changed[path] = true
+		}
+	}
+	return changed, nil
+}
+
+// branchTip returns the commit of the branch being worked on: the original tip recorded by an
+// in-progress rebase (rebase-merge/orig-head or rebase-apply/orig-head), else HEAD.
+func branchTip(ctx context.Context, repoPath string) string {
+	for _, name := range []string{"rebase-merge/orig-head", "rebase-apply/orig-head"} {
+		output, err := executeCommand(ctx, "git", []string{"rev-parse", "--git-path", name}, repoPath, false)
+		if err != nil {
+			continue
+		}
+		path := strings.TrimSpace(output)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(repoPath, path)
+		}
+		// #nosec G304 - path is inside the repository's git directory, as reported by git
+		content, err := os.ReadFile(path)
+		if err == nil && strings.TrimSpace(string(content)) != "" {
+			return strings.TrimSpace(string(content))
+		}
+	}
+	return "HEAD"
+}
+
+// filterBranchConflicts drops the conflicted files the current branch did not change
+// (--since-commit) and returns how many were dropped. When the changed files could not be
+// listed, all files are kept with a warning rather than hiding conflicts that may be the
+// branch's.
+func filterBranchConflicts(out io.Writer, repoConflicts *RepositoryConflicts, sinceCommit string) int {
+	if repoConflicts.BranchBase == "" {
+		_, _ = fmt.Fprintf(out, "Warning: could not list the files changed since %s in repository %s; showing all conflicts\n", sinceCommit, repoConflicts.Repo.Name)
+		return 0
+	}
+	kept := repoConflicts.Files[:0]
+	for _, file := range repoConflicts.Files {
+		if file.ModifiedByBranch {
+			kept = append(kept, file)
+		}
+	}
+	hidden := len(repoConflicts.Files) - len(kept)
+	repoConflicts.Files = kept
+	return hidden
+}
+
+// branchChangeNote returns the marker shown after a conflicted file the current branch changed.
+func branchChangeNote(fileConflict FileConflict) string {
+	if !fileConflict.ModifiedByBranch {
+		return ""
+	}
+	return " (changed by this branch)"
+}
@@ -0,0 +1,69 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// latestContinueCommand continues kira latest once conflicts are resolved. kira latest has no
+// --continue flag: running it again continues in-progress rebases (see handleInProgressRebases).
+const latestContinueCommand = "kira latest"
+
+// latestAbortCommand aborts an in-progress rebase; kira latest has no --abort flag.
+const latestAbortCommand = "git rebase --abort"
+
+// conflictResolution is the guidance printed below merge conflicts.
+type conflictResolution struct {
+	Continue string // command that continues after the conflicts are resolved
+	Abort    string // command that aborts the rebase, run in the repository
+	Help     string // conflicts.resolution_help: replaces the generated instructions when set
+}
+
+// conflictResolutionFor returns the resolution guidance for kira latest, with
+// conflicts.resolution_help from kira.yml when set. cfg may be nil.
+func conflictResolutionFor(cfg *config.Config) conflictResolution {
+	resolution := conflictResolution{Continue: latestContinueCommand, Abort: latestAbortCommand}
+	if cfg != nil && cfg.Conflicts != nil {
+		resolution.Help = strings.TrimSpace(cfg.Conflicts.ResolutionHelp)
+	}
+	return resolution
+}
+
+// plainFooter returns the instructions printed after conflicts in the terminal.
+func (r conflictResolution) plainFooter() string {
+	var buf strings.Builder
+	buf.WriteString("───────────────────────────────────────────────────────────────\n")
+	if r.Help != "" {
+		buf.WriteString(r.Help + "\n")
+		return buf.String()
+	}
+	buf.WriteString("[Instructions: Copy the conflict sections above and paste into Cursor or ChatGPT for resolution assistance]\n\n")
+	buf.WriteString("To resolve conflicts:\n")
+	buf.WriteString("1. Copy the conflict sections above\n")
+	buf.WriteString("2. Paste into your LLM tool (Cursor, ChatGPT, etc.)\n")
+	buf.WriteString("3. Ask for help resolving the conflicts\n")
+	buf.WriteString("4. Apply the resolved code\n")
+	fmt.Fprintf(&buf, "5. Run '%s'%s to continue\n\n", r.Continue, r.again())
+	fmt.Fprintf(&buf, "To abort an in-progress rebase in a repository, run '%s' in that repository.\n", r.Abort)
+	return buf.String()
+}
+
+// githubFooter returns the closing sentence of --conflict-format github output.
+func (r conflictResolution) githubFooter() string {
+	if r.Help != "" {
+		return "\n" + r.Help + "\n"
+	}
+	return fmt.Sprintf("\nResolve the conflicts and run `%s`%s to continue, or run `%s` to abort.\n", r.Continue, r.again(), r.Abort)
+}
+
+// again returns " again" when continuing means re-running kira latest itself, and "" for a
+// dedicated continue command.
+func (r conflictResolution) again() string {
+	if r.Continue == latestContinueCommand {
+		return " again"
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// latestFailureCause is the kind of problem that made kira latest fail for a repository.
+type latestFailureCause string
+
+const (
+	latestFailureAuth         latestFailureCause = "auth"
+	latestFailureConflict     latestFailureCause = "conflict"
+	latestFailureDirty        latestFailureCause = "dirty"
+	latestFailureTimeout      latestFailureCause = "timeout"
+	latestFailureOther        latestFailureCause = "other"
+	latestFailureNotAttempted latestFailureCause = "not-attempted"
+)
+
+// latestFailureCauses lists the causes in the order they are reported.
+var latestFailureCauses = []latestFailureCause{
+	latestFailureAuth,
+	latestFailureConflict,
+	latestFailureDirty,
+	latestFailureTimeout,
+	latestFailureOther,
+	latestFailureNotAttempted,
+}
+
+// latestFailureGuidance is the heading and remediation shown once for all repositories failing
+// for the same cause.
+var latestFailureGuidance = map[latestFailureCause][2]string{
+	latestFailureAuth: {
+		"Authentication or access",
+		"Check the remote URL and your credentials (SSH key or token), e.g. by running 'git fetch' in the repository.",
+	},
+	latestFailureConflict: {
+		"Merge conflicts",
+		"Resolve the conflicts, stage them with 'git add', then run 'git rebase --continue' or 'kira latest' again.",
+	},
+	latestFailureDirty: {
+		"Uncommitted changes",
+		"Commit, stash or discard the local changes shown by 'git status', then run 'kira latest' again.",
+	},
+	latestFailureTimeout: {
+		"Timeouts and network errors",
+		"Check the network connection and that the remote is reachable, then run 'kira latest' again.",
+	},
+	latestFailureOther: {
+		"Other errors",
+		"Inspect the errors above, fix them and run 'kira latest' again.",
+	},
+	latestFailureNotAttempted: {
+		"Not attempted",
+		"These repositories were skipped because --fail-fast stopped at an earlier failure; run 'kira latest' again once it is fixed.",
+	},
+}
+
+// dirtyErrorPatterns are git error fragments caused by local changes in the working tree.
+var dirtyErrorPatterns = []string{
+	"uncommitted",
+	"unstaged changes",
+	"would be overwritten",
+	"stash failed",
+	"please commit or stash",
+	"dirty",
+}
+
+// latestFailureCauseOf classifies a failed repository result.
+func latestFailureCauseOf(result RepositoryOperationResult) latestFailureCause {
+	errStr := strings.ToLower(result.Error.Error())
+	switch {
+	case result.Skipped:
+		return latestFailureNotAttempted
+	case result.Unauthorized || isPermissionError(errStr):
+		return latestFailureAuth
+	case result.RebaseHadConflicts:
+		return latestFailureConflict
+	case errors.Is(result.Error, context.DeadlineExceeded) || strings.Contains(errStr, "timed out") ||
+		strings.Contains(errStr, "deadline exceeded") || isNetworkError(errStr):
+		return latestFailureTimeout
+	}
+	for _, pattern := range dirtyErrorPatterns {
+		if strings.Contains(errStr, pattern) {
+			return latestFailureDirty
+		}
+	}
+	return latestFailureOther
+}
+
+// groupFailuresByCause groups the failed results by cause, keeping their order within a cause.
+func groupFailuresByCause(failedRepos []RepositoryOperationResult) map[latestFailureCause][]RepositoryOperationResult {
+	groups := make(map[latestFailureCause][]RepositoryOperationResult)
+	for _, result := range failedRepos {
+		cause := latestFailureCauseOf(result)
+		groups[cause] = append(groups[cause], result)
+	}
+	return groups
+}
+
+// formatFailureCauseCounts formats the number of failures per cause, e.g. "2 conflict, 1 auth".
+func formatFailureCauseCounts(groups map[latestFailureCause][]RepositoryOperationResult) string {
+	var parts []string
+	for _, cause := range latestFailureCauses {
+		if n := len(groups[cause]); n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, cause))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// displayFailedReposGuidance displays the failed repositories grouped by cause, each group with
+// one remediation and the repository specific recovery steps (rebase and stash state).
+func displayFailedReposGuidance(out io.Writer, failedRepos []RepositoryOperationResult) {
+	if len(failedRepos) == 0 {
+		return
+	}
+
+	groups := groupFailuresByCause(failedRepos)
+	_, _ = fmt.Fprintln(out)
+	_, _ = fmt.Fprintln(out, "Next steps for failed repositories:")
+	for _, cause := range latestFailureCauses {
+		group := groups[cause]
+		if len(group) == 0 {
+			continue
+		}
+		names := make([]string, len(group))
+		for i, result := range group {
+			names[i] = result.Repo.Name
+		}
+		guidance := latestFailureGuidance[cause]
+		_, _ = fmt.Fprintf(out, "\n  %s (%d): %s\n", guidance[0], len(group), strings.Join(names, ", "))
+		_, _ = fmt.Fprintf(out, "    %s\n", guidance[1])
+		for _, result := range group {
+			steps := getRecoverySteps(result)
+			if len(steps) == 0 {
+				continue
+			}
+			_, _ = fmt.Fprintf(out, "    %s:\n", result.Repo.Name)
+			for i, step := range steps {
+				_, _ = fmt.Fprintf(out, "      %d. %s\n", i+1, step)
+			}
+		}
+	}
+}
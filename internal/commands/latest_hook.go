@@ -0,0 +1,94 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"kira/internal/config"
+)
+
+// afterUpdateHookTimeout bounds a hooks.after_update command (e.g. make deps) in one repository.
+const afterUpdateHookTimeout = 5 * time.Minute
+
+// afterUpdateHook returns the hooks.after_update command template, or "" when none is configured.
+func afterUpdateHook(cfg *config.Config) string {
+	if cfg.Hooks == nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.Hooks.AfterUpdate)
+}
+
+// withAfterUpdateHook sets the command run in each repository after a successful update.
+func withAfterUpdateHook(repos []RepositoryInfo, hook string) []RepositoryInfo {
+	for i := range repos {
+		repos[i].AfterUpdateHook = hook
+	}
+	return repos
+}
+
+// expandAfterUpdateHook replaces the {repo}, {path} and {branch} placeholders of the hook.
+func expandAfterUpdateHook(hook string, repo RepositoryInfo, branch string) string {
+	return strings.NewReplacer("{repo}", repo.Name, "{path}", repo.Path, "{branch}", branch).Replace(hook)
+}
+
+// runAfterUpdateHook runs the repository's hooks.after_update command in its directory once the
+// fetch and rebase succeeded. Repositories that failed or whose rebase was skipped because the
+// branch is already merged are left alone. The hook's output is kept for --verbose; when it
+// fails, the result fails with the hook's stderr.
+func runAfterUpdateHook(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) {
+	if repo.AfterUpdateHook == "" || result.Error != nil || result.MergedBranch != "" {
+		return
+	}
+	branch, err := getCurrentBranch(repo.Path)
+	if err != nil {
+		branch = repo.TrunkBranch
+	}
+	command := expandAfterUpdateHook(repo.AfterUpdateHook, repo, branch)
+	mu.Lock()
+	displayOperationProgress(out, repo.Name, fmt.Sprintf("running hook: %s", command))
+	mu.Unlock()
+
+	output, err := executeAfterUpdateHook(command, repo.Path)
+	result.HookOutput = output
+	if err != nil {
+		result.Error = fmt.Errorf("after_update hook failed: %w", err)
+		result.Steps = append(result.Steps, "hook (failed)")
+		return
+	}
+	result.Steps = append(result.Steps, "hook")
+}
+
+// executeAfterUpdateHook runs command with sh -c in dir and returns its output (stdout, then
+// stderr). The error includes the command's stderr.
+func executeAfterUpdateHook(command, dir string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), afterUpdateHookTimeout)
+	defer cancel()
+
+	cmd, err := newCommand(ctx, "sh", "-c", command)
+	if err != nil {
+		return "", err
+	}
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = startAndWait(ctx, cmd)
+	output := strings.TrimSpace(stdout.String() + stderr.String())
+	if ctx.Err() == context.DeadlineExceeded {
+		return output, fmt.Errorf("timed out after %s", afterUpdateHookTimeout)
+	}
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return output, fmt.Errorf("%w: %s", err, msg)
+		}
+		return output, err
+	}
+	return output, nil
+}
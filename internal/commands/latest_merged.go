@@ -0,0 +1,117 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mergedBranchOf returns the current branch when it is fully merged into the remote trunk, i.e.
+// its tip is an ancestor of <remote>/<trunk> but not on the trunk's own (first-parent) history.
+// A branch without commits of its own, such as one just created by kira start, is not merged:
+// rebasing it fast-forwards it to trunk.
+func mergedBranchOf(repo RepositoryInfo) (string, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	remoteRef := fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
+	if _, err := executeCommand(ctx, "git", []string{"merge-base", "--is-ancestor", "HEAD", remoteRef}, repo.Path, false); err != nil {
+		return "", false
+	}
+	head, err := executeCommand(ctx, "git", []string{"rev-parse", "HEAD"}, repo.Path, false)
+	if err != nil {
+		return "", false
+	}
+	trunkHistory, err := executeCommand(ctx, "git", []string{"rev-list", "--first-parent", remoteRef}, repo.Path, false)
+	if err != nil || containsString(strings.Fields(trunkHistory), strings.TrimSpace(head)) {
+		return "", false
+	}
+	branch, err := getCurrentBranch(repo.Path)
+	if err != nil || branch == "HEAD" {
+		return "", false
+	}
+	return branch, true
+}
+
+// skipRebaseIfMerged records a skipped rebase and reports true when the current branch is already
+// merged into the remote trunk, so there is nothing to rebase.
+func skipRebaseIfMerged(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) bool {
+	branch, merged := mergedBranchOf(repo)
+	if !merged {
+		return false
+	}
+	mu.Lock()
+	displayOperationProgress(out, repo.Name, fmt.Sprintf("branch %s is already merged into %s; nothing to rebase", branch, repo.TrunkBranch))
+	mu.Unlock()
+	result.MergedBranch = branch
+	result.Steps = append(result.Steps, "rebase (skipped: merged)")
+	return true
+}
+
+// cleanupMergedBranch deletes a merged branch and its worktree. In a linked worktree the worktree
+// is removed from the main worktree; in the main worktree trunk is checked out first. The branch
+// is deleted with -D because it was verified to be merged into the remote trunk, which the local
+// trunk may not have caught up with.
+func cleanupMergedBranch(repo RepositoryInfo, branch string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	mainPath, err := mainWorktreePath(ctx, repo.Path)
+	if err != nil {
+		return err
+	}
+	if samePath(mainPath, repo.Path) {
+		if _, err := executeCommand(ctx, "git", []string{"checkout", repo.TrunkBranch}, repo.Path, false); err != nil {
+			return fmt.Errorf("failed to check out %s: %w", repo.TrunkBranch, err)
+		}
+	} else if _, err := executeCommand(ctx, "git", []string{"worktree", "remove", repo.Path}, mainPath, false); err != nil {
+		return fmt.Errorf("failed to remove worktree %s: %w", repo.Path, err)
+	}
+	if _, err := executeCommand(ctx, "git", []string{"branch", "-D", branch}, mainPath, false); err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w", branch, err)
+	}
+	return nil
+}
+
+// mainWorktreePath returns the path of the main worktree of the repository at dir (the first
+// entry of git worktree list).
+func mainWorktreePath(ctx context.Context, dir string) (string, error) {
+	output, err := executeCommand(ctx, "git", []string{"worktree", "list", "--porcelain"}, dir, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to list worktrees: %w", err)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if path, ok := strings.CutPrefix(line, "worktree "); ok {
+			return strings.TrimSpace(path), nil
+		}
+	}
+	return "", fmt.Errorf("failed to find the main worktree of %s", dir)
+}
+
+// cleanupMergedBranchesForResults deletes the local branch and worktree of each repository whose
+// branch was already merged (--cleanup-merged). Repositories with stashed local changes are kept
+// so no work is lost.
+func cleanupMergedBranchesForResults(results []RepositoryOperationResult) {
+	for i := range results {
+		if results[i].Error != nil || results[i].MergedBranch == "" {
+			continue
+		}
+		if results[i].HadStash {
+			results[i].Steps = append(results[i].Steps, "cleanup-merged (skipped: local changes)")
+			continue
+		}
+		start := time.Now()
+		err := cleanupMergedBranch(results[i].Repo, results[i].MergedBranch)
+		results[i].Duration += time.Since(start)
+		if err != nil {
+			results[i].Error = fmt.Errorf("cleanup of merged branch failed: %w", err)
+			results[i].Steps = append(results[i].Steps, "cleanup-merged (failed)")
+			continue
+		}
+		results[i].Steps = append(results[i].Steps, "cleanup-merged")
+	}
+}
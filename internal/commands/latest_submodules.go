@@ -0,0 +1,47 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"kira/internal/config"
+)
+
+// submoduleUpdateTimeout bounds git submodule update in one repository, which may clone.
+const submoduleUpdateTimeout = 5 * time.Minute
+
+// updateSubmodules reports whether git.update_submodules is enabled.
+func updateSubmodules(cfg *config.Config) bool {
+	return cfg.Git != nil && cfg.Git.UpdateSubmodules
+}
+
+// runSubmoduleUpdate runs git submodule update --init --recursive in the repository once the
+// fetch and rebase succeeded (git.update_submodules), so the submodules match the commits the
+// update brought in. Repositories without a .gitmodules file, that failed, or whose rebase was
+// skipped because the branch is already merged are left alone. A failing update fails the result.
+func runSubmoduleUpdate(out io.Writer, result *RepositoryOperationResult, repo RepositoryInfo, mu *sync.Mutex) {
+	if !repo.UpdateSubmodules || result.Error != nil || result.MergedBranch != "" {
+		return
+	}
+	if _, err := os.Stat(filepath.Join(repo.Path, ".gitmodules")); err != nil {
+		return
+	}
+	mu.Lock()
+	displayOperationProgress(out, repo.Name, "updating submodules")
+	mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), submoduleUpdateTimeout)
+	defer cancel()
+	if _, err := executeCommand(ctx, "git", []string{"submodule", "update", "--init", "--recursive"}, repo.Path, false); err != nil {
+		result.Error = fmt.Errorf("submodule update failed: %w", err)
+		result.Steps = append(result.Steps, "submodule-update (failed)")
+		return
+	}
+	result.Steps = append(result.Steps, "submodule-update")
+}
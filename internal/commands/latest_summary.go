@@ -0,0 +1,69 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// latestSummaryCauseLabels is the short failure reason shown by kira latest --summary per cause.
+var latestSummaryCauseLabels = map[latestFailureCause]string{
+	latestFailureAuth:         "no access",
+	latestFailureConflict:     "conflict",
+	latestFailureDirty:        "uncommitted changes",
+	latestFailureTimeout:      "timeout",
+	latestFailureOther:        "failed",
+	latestFailureNotAttempted: "not attempted",
+}
+
+// countIncomingCommits returns how many commits the rebase or trunk update is about to bring in:
+// those on the remote trunk (or the --onto ref for feature branches) that HEAD does not have yet.
+// It returns 0 when they cannot be counted.
+func countIncomingCommits(repo RepositoryInfo, onTrunk bool) int {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	upstream := fmt.Sprintf("%s/%s", repo.Remote, repo.TrunkBranch)
+	if !onTrunk && repo.Onto != "" {
+		upstream = repo.Onto
+	}
+	output, err := executeCommand(ctx, "git", []string{"rev-list", "--count", "HEAD.." + upstream}, repo.Path, false)
+	if err != nil {
+		return 0
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+// displayOperationSummary writes one line per repository to out (--summary), in the order the
+// repositories were discovered: "✓ name (2 commits)" for an update and "✗ name (conflict)" for a
+// failure, with the failure cause.
+func displayOperationSummary(out io.Writer, results []RepositoryOperationResult) {
+	for _, result := range results {
+		if result.Error != nil {
+			_, _ = fmt.Fprintf(out, "%s %s (%s)\n", errorStyle("✗"), result.Repo.Name, latestSummaryCauseLabels[latestFailureCauseOf(result)])
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "%s %s (%s)\n", successStyle("✓"), result.Repo.Name, latestSummaryDetail(result))
+	}
+}
+
+// latestSummaryDetail describes what happened to a repository that was updated successfully.
+func latestSummaryDetail(result RepositoryOperationResult) string {
+	switch {
+	case result.MergedBranch != "":
+		return "already merged"
+	case result.CommitsPulled == 0:
+		return "up to date"
+	case result.CommitsPulled == 1:
+		return "1 commit"
+	default:
+		return fmt.Sprintf("%d commits", result.CommitsPulled)
+	}
+}
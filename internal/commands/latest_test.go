@@ -2,16 +2,22 @@ package commands
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"kira/internal/config"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -510,6 +516,70 @@ func TestResolveRepositoriesForLatest(t *testing.T) {
 	})
 }
 
+func TestLatestFromLinkedWorktree(t *testing.T) {
+	setupGitConfigForCISerial(t)
+
+	mainDir := t.TempDir()
+	remoteDir := t.TempDir()
+	worktreeDir := filepath.Join(t.TempDir(), "001-feature")
+
+	runGit(t, mainDir, "init")
+	runGit(t, mainDir, "config", "user.email", "test@example.com")
+	runGit(t, mainDir, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(mainDir, "base.txt"), []byte("base\n"), 0o600))
+	runGit(t, mainDir, "add", "base.txt")
+	runGit(t, mainDir, "commit", "-m", "Initial")
+	runGit(t, mainDir, "branch", "-M", "main")
+	runGit(t, remoteDir, "init", "--bare")
+	runGit(t, mainDir, "remote", "add", "origin", remoteDir)
+	runGit(t, mainDir, "push", "-u", "origin", "main")
+
+	// Linked worktree on a feature branch, as created by kira start
+	runGit(t, mainDir, "worktree", "add", "-b", "001-feature", worktreeDir, "main")
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeDir, "feature.txt"), []byte("feature\n"), 0o600))
+	runGit(t, worktreeDir, "add", "feature.txt")
+	runGit(t, worktreeDir, "commit", "-m", "Feature")
+
+	// Advance trunk on the remote
+	require.NoError(t, os.WriteFile(filepath.Join(mainDir, "trunk.txt"), []byte("trunk\n"), 0o600))
+	runGit(t, mainDir, "add", "trunk.txt")
+	runGit(t, mainDir, "commit", "-m", "Trunk change")
+	runGit(t, mainDir, "push", "origin", "main")
+
+	// Run from a subdirectory of the linked worktree
+	subDir := filepath.Join(worktreeDir, "sub")
+	require.NoError(t, os.MkdirAll(subDir, 0o700))
+	require.NoError(t, os.Chdir(subDir))
+	defer func() { _ = os.Chdir("/") }()
+
+	cfg := &config.Config{
+		Git: &config.GitConfig{TrunkBranch: "main", Remote: "origin"},
+	}
+	repos, err := resolveRepositoriesForLatest(cfg, WorkspaceBehaviorStandalone)
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+
+	expectedPath, _ := filepath.EvalSymlinks(worktreeDir)
+	actualPath, _ := filepath.EvalSymlinks(repos[0].Path)
+	assert.Equal(t, expectedPath, actualPath, "latest should operate on the linked worktree, not the main working tree")
+
+	onTrunk, err := isOnTrunkBranch(repos[0])
+	require.NoError(t, err)
+	assert.False(t, onTrunk)
+
+	results := performFetchAndRebaseForAllRepos(io.Discard, repos, true, false)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Error)
+
+	// The feature branch in the worktree now contains the trunk change
+	_, err = os.Stat(filepath.Join(worktreeDir, "trunk.txt"))
+	require.NoError(t, err)
+	// The main working tree stays on main, untouched by the rebase
+	branch, err := getCurrentBranch(mainDir)
+	require.NoError(t, err)
+	assert.Equal(t, "main", branch)
+}
+
 func TestValidateRepositories(t *testing.T) {
 	t.Run("validates valid repositories", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -532,6 +602,26 @@ func TestValidateRepositories(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("validates project paths that use $HOME in kira.yml", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		t.Setenv("HOME", tmpDir)
+
+		repoPath := filepath.Join(tmpDir, "repo1")
+		require.NoError(t, os.MkdirAll(filepath.Join(repoPath, ".git"), 0o700))
+		configDir := filepath.Join(tmpDir, "workspace")
+		require.NoError(t, os.MkdirAll(configDir, 0o700))
+		kiraYml := "version: \"1.0\"\nworkspace:\n  projects:\n    - name: repo1\n      path: $HOME/repo1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, "kira.yml"), []byte(kiraYml), 0o600))
+
+		cfg, err := config.LoadConfigFromDir(configDir)
+		require.NoError(t, err)
+		require.Len(t, cfg.Workspace.Projects, 1)
+		assert.Equal(t, repoPath, cfg.Workspace.Projects[0].Path)
+
+		repos := []RepositoryInfo{{Name: "repo1", Path: cfg.Workspace.Projects[0].Path}}
+		require.NoError(t, validateRepositories(repos))
+	})
+
 	t.Run("returns error for non-existent path", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		require.NoError(t, os.Chdir(tmpDir))
@@ -606,6 +696,54 @@ func TestValidateRepositories(t *testing.T) {
 	})
 }
 
+func TestCheckWorktreeRegistration(t *testing.T) {
+	setupWorktree := func(t *testing.T) (mainRepo, worktree string) {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		mainRepo = filepath.Join(tmpDir, "main")
+		worktree = filepath.Join(tmpDir, "feature")
+		require.NoError(t, os.MkdirAll(mainRepo, 0o700))
+		runGit(t, mainRepo, "init", "-b", "main")
+		runGit(t, mainRepo, "config", "user.email", "test@example.com")
+		runGit(t, mainRepo, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile(filepath.Join(mainRepo, "README.md"), []byte("# Test\n"), 0o600))
+		runGit(t, mainRepo, "add", "README.md")
+		runGit(t, mainRepo, "commit", "-m", "initial")
+		runGit(t, mainRepo, "worktree", "add", "-b", "feature", worktree)
+		return mainRepo, worktree
+	}
+
+	t.Run("accepts a regular repository and a healthy worktree", func(t *testing.T) {
+		mainRepo, worktree := setupWorktree(t)
+
+		assert.NoError(t, checkWorktreeRegistration(mainRepo))
+		assert.NoError(t, checkWorktreeRegistration(worktree))
+	})
+
+	t.Run("explains how to prune a worktree whose registration was removed", func(t *testing.T) {
+		mainRepo, worktree := setupWorktree(t)
+		require.NoError(t, os.RemoveAll(filepath.Join(mainRepo, ".git", "worktrees")))
+
+		err := validateRepositories([]RepositoryInfo{{Name: "feature", Path: worktree}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is a git worktree whose registration no longer exists")
+		assert.Contains(t, err.Error(), "git worktree prune")
+		assert.Contains(t, err.Error(), "(for feature)")
+	})
+
+	t.Run("explains how to repair a worktree registered elsewhere", func(t *testing.T) {
+		mainRepo, worktree := setupWorktree(t)
+		gitdirFile := filepath.Join(mainRepo, ".git", "worktrees", "feature", "gitdir")
+		require.NoError(t, os.WriteFile(gitdirFile, []byte(filepath.Join(filepath.Dir(worktree), "moved", ".git")+"\n"), 0o600))
+
+		err := checkWorktreeRegistration(worktree)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "prunable")
+		assert.Contains(t, err.Error(), "git worktree repair")
+	})
+}
+
 func TestDiscoverRepositories(t *testing.T) {
 	t.Run("discovers standalone repository", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -1115,6 +1253,65 @@ line3`)
 		markers := findConflictMarkers(content)
 		assert.Empty(t, markers)
 	})
+
+	t.Run("only matches markers at column 0 in git's format", func(t *testing.T) {
+		content := []byte("  <<<<<<< HEAD\n<<<<<<<< HEAD\n<<<<<<<HEAD\n ======= \n========\n>>>>>>>>\n<<<<<<<\n=======\r\n>>>>>>> feature/x\n")
+		markers := findConflictMarkers(content)
+		require.Len(t, markers, 3)
+		assert.Equal(t, 6, markers[0].lineIndex)
+		assert.Equal(t, conflictMarkerStart, markers[0].marker)
+		assert.Equal(t, conflictMarkerSeparator, markers[1].marker)
+		assert.Equal(t, conflictMarkerEnd, markers[2].marker)
+	})
+
+	t.Run("ignores markers inside an indented code fence", func(t *testing.T) {
+		content := []byte("# Resolving conflicts\n\n- Example:\n\n  ```\n  <<<<<<< HEAD\n  ours\n  =======\n  theirs\n  >>>>>>> branch\n  ```\n")
+		assert.Empty(t, findConflictMarkers(content))
+
+		regions, err := parseConflictMarkers("docs/conflicts.md", content)
+		require.NoError(t, err)
+		assert.Empty(t, regions)
+	})
+
+	t.Run("ignores markers inside a column-0 backtick fence", func(t *testing.T) {
+		content := []byte("# Resolving conflicts\n\n```diff\n<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\n```\n")
+		assert.Empty(t, findConflictMarkers(content))
+
+		regions, err := parseConflictMarkers("docs/conflicts.md", content)
+		require.NoError(t, err)
+		assert.Empty(t, regions)
+	})
+
+	t.Run("ignores markers inside a column-0 tilde fence", func(t *testing.T) {
+		content := []byte("~~~~\n<<<<<<< HEAD\nours\n```\n=======\ntheirs\n>>>>>>> branch\n~~~~\n")
+		assert.Empty(t, findConflictMarkers(content))
+	})
+
+	t.Run("finds markers after a closed fence", func(t *testing.T) {
+		content := []byte("```\n<<<<<<< example\n```\n<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\n")
+		markers := findConflictMarkers(content)
+		require.Len(t, markers, 3)
+		assert.Equal(t, 3, markers[0].lineIndex)
+	})
+
+	t.Run("an unterminated fence does not hide a conflict", func(t *testing.T) {
+		content := []byte("```go\n<<<<<<< HEAD\nours\n=======\ntheirs\n>>>>>>> branch\n")
+		markers := findConflictMarkers(content)
+		require.Len(t, markers, 3)
+		assert.Equal(t, 1, markers[0].lineIndex)
+
+		regions, err := parseConflictMarkers("docs/conflicts.md", content)
+		require.NoError(t, err)
+		require.Len(t, regions, 1)
+		assert.Equal(t, "ours", regions[0].OurContent)
+	})
+
+	t.Run("does not parse documented markers as a conflict", func(t *testing.T) {
+		content := []byte("# Resolving conflicts\n\n```text\n<<<<<<<< example\n ours\n=======  \n theirs\n>>>>>>>> example\n```\n")
+		regions, err := parseConflictMarkers("docs/conflicts.md", content)
+		require.NoError(t, err)
+		assert.Empty(t, regions)
+	})
 }
 
 func TestExtractContextLines(t *testing.T) {
@@ -1359,11 +1556,120 @@ func TestFormatAllConflicts(t *testing.T) {
 			},
 		}
 
-		formatted := formatAllConflicts(allConflicts)
+		formatted := formatAllConflicts(allConflicts, conflictResolutionFor(nil))
 		assert.Contains(t, formatted, "Merge Conflicts Detected")
 		assert.Contains(t, formatted, "Repository: repo1")
 		assert.Contains(t, formatted, "To resolve conflicts:")
 		assert.Contains(t, formatted, "Run 'kira latest' again to continue")
+		assert.Contains(t, formatted, "run 'git rebase --abort' in that repository")
+	})
+
+	t.Run("footer names the continue and abort commands when they exist", func(t *testing.T) {
+		resolution := conflictResolution{Continue: "kira latest --continue", Abort: "kira latest --abort"}
+		footer := resolution.plainFooter()
+		assert.Contains(t, footer, "5. Run 'kira latest --continue' to continue\n")
+		assert.Contains(t, footer, "run 'kira latest --abort' in that repository")
+		assert.NotContains(t, footer, "git rebase --abort")
+		assert.Equal(t, "\nResolve the conflicts and run `kira latest --continue` to continue, or run `kira latest --abort` to abort.\n", resolution.githubFooter())
+	})
+
+	t.Run("conflicts.resolution_help replaces the instructions", func(t *testing.T) {
+		cfg := &config.Config{Conflicts: &config.ConflictsConfig{ResolutionHelp: "Ask #release in chat before resolving.\n"}}
+		resolution := conflictResolutionFor(cfg)
+		footer := resolution.plainFooter()
+		assert.True(t, strings.HasSuffix(footer, "───\nAsk #release in chat before resolving.\n"), footer)
+		assert.NotContains(t, footer, "To resolve conflicts:")
+		assert.Equal(t, "\nAsk #release in chat before resolving.\n", resolution.githubFooter())
+	})
+}
+
+func TestFormatAllConflictsGitHub(t *testing.T) {
+	t.Run("renders details blocks with a fenced diff per region", func(t *testing.T) {
+		allConflicts := []RepositoryConflicts{
+			{
+				Repo: RepositoryInfo{Name: "main"},
+				Files: []FileConflict{
+					{
+						RepoName: "main",
+						FilePath: "src/app.go",
+						Regions: []ConflictRegion{
+							{
+								StartMarker:   "<<<<<<< HEAD",
+								OurContent:    "return a",
+								Separator:     "=======",
+								TheirContent:  "return b\nreturn c",
+								EndMarker:     ">>>>>>> 1a2b3c4 (Change return)",
+								ContextBefore: []string{"func f() int {"},
+								ContextAfter:  []string{"}"},
+							},
+							{
+								StartMarker:  "<<<<<<<",
+								OurContent:   "x",
+								Separator:    "=======",
+								TheirContent: "",
+								EndMarker:    ">>>>>>>",
+							},
+						},
+					},
+					{
+						RepoName: "main",
+						FilePath: "README.md",
+						Error:    fmt.Errorf("permission denied"),
+					},
+				},
+			},
+		}
+
+		expected := "### Merge conflicts detected\n" +
+			"\n" +
+			"<details>\n" +
+			"<summary>main: <code>src/app.go</code> (2 conflicts)</summary>\n" +
+			"\n" +
+			"```diff\n" +
+			"--- HEAD\n" +
+			"+++ 1a2b3c4 (Change return)\n" +
+			" func f() int {\n" +
+			"-return a\n" +
+			"+return b\n" +
+			"+return c\n" +
+			" }\n" +
+			"```\n" +
+			"\n" +
+			"```diff\n" +
+			"--- ours\n" +
+			"+++ theirs\n" +
+			"-x\n" +
+			"```\n" +
+			"\n" +
+			"</details>\n" +
+			"\n" +
+			"<details>\n" +
+			"<summary>main: <code>README.md</code> (error)</summary>\n" +
+			"\n" +
+			"permission denied\n" +
+			"\n" +
+			"</details>\n" +
+			"\n" +
+			"Resolve the conflicts and run `kira latest` again to continue, or run `git rebase --abort` to abort.\n"
+
+		assert.Equal(t, expected, formatAllConflictsGitHub(allConflicts, conflictResolutionFor(nil)))
+	})
+
+	t.Run("lengthens the fence when content contains backticks", func(t *testing.T) {
+		region := ConflictRegion{StartMarker: "<<<<<<< HEAD", OurContent: "```go", Separator: "=======", EndMarker: ">>>>>>> b"}
+		assert.Equal(t, "````diff\n--- HEAD\n+++ b\n-```go\n````\n", formatConflictRegionDiff(region))
+	})
+
+	t.Run("returns empty for no conflicts", func(t *testing.T) {
+		assert.Empty(t, formatAllConflictsGitHub(nil, conflictResolutionFor(nil)))
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		require.NoError(t, validateConflictFormat(conflictFormatPlain))
+		require.NoError(t, validateConflictFormat(conflictFormatGitHub))
+		err := validateConflictFormat("gitlab")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --conflict-format 'gitlab'")
 	})
 }
 
@@ -1410,7 +1716,7 @@ func TestParseConflictsFromRepository(t *testing.T) {
 			State: StateConflictsExist,
 		}
 
-		repoConflicts, err := parseConflictsFromRepository(repo, stateInfo)
+		repoConflicts, err := parseConflictsFromRepository(repo, stateInfo, "")
 		require.NoError(t, err)
 		require.NotNil(t, repoConflicts)
 		// Should have at least one conflicting file
@@ -1428,7 +1734,7 @@ func TestParseConflictsFromRepository(t *testing.T) {
 			State: StateReadyForUpdate,
 		}
 
-		repoConflicts, err := parseConflictsFromRepository(repo, stateInfo)
+		repoConflicts, err := parseConflictsFromRepository(repo, stateInfo, "")
 		require.NoError(t, err)
 		assert.Nil(t, repoConflicts)
 	})
@@ -1729,159 +2035,703 @@ func TestProcessRepositoryUpdateOnTrunk_stashAndPop(t *testing.T) {
 
 	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
 	var mu sync.Mutex
-	result := processRepositoryUpdate(repo, false, false, &mu)
+	result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
 
 	require.NoError(t, result.Error)
 	assert.True(t, result.HadStash)
 	assert.True(t, result.StashPopped)
+	assert.Positive(t, result.Duration, "duration should be recorded")
 	// Working tree should have dirty.txt back
 	_, err := os.Stat(filepath.Join(tmpDir, "dirty.txt"))
 	require.NoError(t, err)
 }
 
-func TestProcessRepositoryUpdateOnTrunk_noPopStash(t *testing.T) {
-	tmpDir := t.TempDir()
-	require.NoError(t, os.Chdir(tmpDir))
-	defer func() { _ = os.Chdir("/") }()
-
-	require.NoError(t, exec.Command("git", "init").Run())
-	require.NoError(t, exec.Command("git", "config", "user.email", "test@example.com").Run())
-	require.NoError(t, exec.Command("git", "config", "user.name", "Test User").Run())
-	require.NoError(t, os.WriteFile("a.txt", []byte("a"), 0o600))
-	require.NoError(t, exec.Command("git", "add", "a.txt").Run())
-	require.NoError(t, exec.Command("git", "commit", "-m", "Initial").Run())
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	_ = exec.Command("git", "branch", "-M", "main").Run()
-
-	remoteDir := t.TempDir()
-	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
-	require.NoError(t, exec.Command("git", "init", "--bare", remoteDir).Run())
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	require.NoError(t, exec.Command("git", "remote", "add", "origin", remoteDir).Run())
-	require.NoError(t, exec.Command("git", "push", "-u", "origin", "main").Run())
+func TestProcessRepositoryUpdate_onto(t *testing.T) {
+	// setupStack creates main pushed to a bare remote, feature-a with commit A1 and feature-b
+	// stacked on it with commit B1 (tagged a-old at A1). feature-a is then rewritten: A1 is
+	// amended to A1' with different content and A2 is added. feature-b is checked out.
+	setupStack := func(t *testing.T) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		addSafeDirectory(t, tmpDir)
+		runGit(t, tmpDir, "init", "-b", "main")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		commitFile := func(name string) {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(name), 0o600))
+			runGit(t, tmpDir, "add", name)
+			runGit(t, tmpDir, "commit", "-m", name)
+		}
+		commitFile("base")
 
-	require.NoError(t, os.WriteFile("dirty.txt", []byte("dirty"), 0o600))
+		remoteDir := t.TempDir()
+		runGit(t, tmpDir, "init", "--bare", remoteDir)
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "-u", "origin", "main")
+
+		runGit(t, tmpDir, "checkout", "-b", "feature-a")
+		commitFile("a1")
+		runGit(t, tmpDir, "tag", "a-old")
+		runGit(t, tmpDir, "checkout", "-b", "feature-b")
+		commitFile("b1")
+		runGit(t, tmpDir, "checkout", "feature-a")
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "a1"), []byte("a1 reworked"), 0o600))
+		runGit(t, tmpDir, "commit", "-a", "--amend", "-m", "a1 reworked")
+		commitFile("a2")
+		runGit(t, tmpDir, "checkout", "feature-b")
+		return tmpDir
+	}
 
-	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
-	var mu sync.Mutex
-	result := processRepositoryUpdate(repo, false, true, &mu) // noPopStash=true
+	t.Run("rebases the stacked branch onto the given ref", func(t *testing.T) {
+		tmpDir := setupStack(t)
 
-	require.NoError(t, result.Error)
-	assert.True(t, result.HadStash)
-	assert.False(t, result.StashPopped)
-	// Stash should still have one entry
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	out, err := exec.Command("git", "-C", tmpDir, "stash", "list").Output()
-	require.NoError(t, err)
-	assert.Contains(t, string(out), "kira latest")
-}
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin", Onto: "feature-a", OldBase: "a-old"}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
 
-func TestProcessRepositoryUpdateOnTrunk_conflict_doesNotPopStash(t *testing.T) {
-	setupGitConfigForCISerial(t)
-	tmpDir := t.TempDir()
-	addSafeDirectory(t, tmpDir)
-	t.Cleanup(func() {
-		if t.Failed() {
-			logGitDebug(t, tmpDir)
+		require.NoError(t, result.Error, "the old A1 is not replayed, so it does not conflict with A1'")
+		assert.Contains(t, result.Steps, "rebase --onto feature-a")
+		for _, name := range []string{"a1", "a2", "b1"} {
+			_, err := os.Stat(filepath.Join(tmpDir, name))
+			require.NoError(t, err, name)
 		}
+		// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+		count, err := exec.Command("git", "-C", tmpDir, "rev-list", "--count", "feature-a..HEAD").Output()
+		require.NoError(t, err)
+		assert.Equal(t, "1", strings.TrimSpace(string(count)), "only b1 should be replayed")
 	})
-	require.NoError(t, os.Chdir(tmpDir))
-	defer func() { _ = os.Chdir("/") }()
 
-	runGit(t, "", "init", "-b", "main")
-	runGit(t, "", "config", "user.email", "test@example.com")
-	runGit(t, "", "config", "user.name", "Test User")
-	require.NoError(t, os.WriteFile("f", []byte("a"), 0o600))
-	runGit(t, "", "add", "f")
-	runGit(t, "", "commit", "-m", "A")
+	t.Run("fails when the ref does not exist", func(t *testing.T) {
+		tmpDir := setupStack(t)
 
-	remoteDir := t.TempDir()
-	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
-	runGit(t, "", "init", "--bare", remoteDir)
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	runGit(t, "", "remote", "add", "origin", remoteDir)
-	runGit(t, "", "push", "-u", "origin", "main")
-	// Ensure the bare repo HEAD points at main so clones check out main.
-	runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin", Onto: "nope", OldBase: "a-old"}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
 
-	// Divergent commit on remote (clone, change f, push)
-	cloneDir := t.TempDir()
-	// #nosec G204 - paths from t.TempDir(), safe for test use
-	runGit(t, "", "clone", remoteDir, cloneDir)
-	require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "f"), []byte("b"), 0o600))
-	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
-	runGit(t, cloneDir, "add", "f")
-	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
-	runGit(t, cloneDir, "config", "user.email", "test@example.com")
-	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
-	runGit(t, cloneDir, "config", "user.name", "Test User")
-	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
-	runGit(t, cloneDir, "commit", "-m", "B")
-	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
-	runGit(t, cloneDir, "push", "origin", "main")
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "--onto reference 'nope' does not exist")
+		assert.Contains(t, result.Steps, "rebase --onto nope (failed)")
 
-	// Local divergent commit (change f to c) so rebase will conflict
-	require.NoError(t, os.WriteFile("f", []byte("c"), 0o600))
-	runGit(t, "", "add", "f")
-	runGit(t, "", "commit", "-m", "C")
+		repo.Onto, repo.OldBase = "feature-a", "nope"
+		result = processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "--old-base reference 'nope' does not exist")
+	})
 
-	// Uncommitted file so we get a stash
-	require.NoError(t, os.WriteFile("g", []byte("g"), 0o600))
+	t.Run("requires --old-base with --onto and a single repository", func(t *testing.T) {
+		newCmd := func(onto, oldBase string) *cobra.Command {
+			cmd := &cobra.Command{}
+			cmd.Flags().String("onto", onto, "")
+			cmd.Flags().String("old-base", oldBase, "")
+			return cmd
+		}
 
-	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
-	var mu sync.Mutex
-	result := processRepositoryUpdate(repo, false, false, &mu) // abortOnConflict=false
+		require.NoError(t, validateLatestOnto(newCmd("", ""), 2))
+		require.NoError(t, validateLatestOnto(newCmd("feature-a", "a-old"), 1))
+		require.EqualError(t, validateLatestOnto(newCmd("feature-a", ""), 1), "--onto requires --old-base, the ref the current branch was built on")
+		require.EqualError(t, validateLatestOnto(newCmd("", "a-old"), 1), "--old-base requires --onto")
+		require.EqualError(t, validateLatestOnto(newCmd("feature-a", "a-old"), 2), "--onto cannot be used when updating 2 repositories: the refs differ per repository")
+	})
 
-	require.Error(t, result.Error, "expected rebase conflict")
-	assert.True(t, result.HadStash)
-	assert.False(t, result.StashPopped)
-	assert.True(t, result.RebaseHadConflicts)
-	// Stash should still contain one entry
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	stashOut, err := exec.Command("git", "-C", tmpDir, "stash", "list").Output()
-	require.NoErrorf(t, err, "git stash list: %v", err)
-	assert.Contains(t, string(stashOut), "kira latest")
-	// Rebase should be in progress
-	// #nosec G304 - path under tmpDir from t.TempDir(), safe for test use
-	_, err = os.Stat(filepath.Join(tmpDir, ".git", "rebase-merge"))
-	require.NoErrorf(t, err, "rebase-merge dir: %v", err)
+	t.Run("empty ref keeps rebasing onto trunk", func(t *testing.T) {
+		repos := withOntoRef([]RepositoryInfo{{Name: "a"}, {Name: "b"}}, " ", "")
+		assert.Empty(t, repos[0].Onto)
+		assert.Equal(t, []string{"rebase", "origin/main"}, rebaseArgs(repos[1], "origin/main"))
+		assert.Equal(t, []string{"rebase", "--autostash", "--onto", "x", "y"}, rebaseArgs(RepositoryInfo{UseAutostash: true}, "--onto", "x", "y"))
+	})
 }
 
-func TestProcessRepositoryUpdateOnTrunk_abortOnConflict_popsStash(t *testing.T) {
-	setupGitConfigForCISerial(t)
-	tmpDir := t.TempDir()
-	addSafeDirectory(t, tmpDir)
-	t.Cleanup(func() {
-		if t.Failed() {
-			logGitDebug(t, tmpDir)
+func TestProcessRepositoryUpdate_merged(t *testing.T) {
+	// setupRepo creates main pushed to a bare remote and a feature branch with one commit.
+	// When merge is true, the feature branch is merged into main with a merge commit and pushed.
+	setupRepo := func(t *testing.T, merge bool) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		addSafeDirectory(t, tmpDir)
+		runGit(t, tmpDir, "init", "-b", "main")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		commitFile := func(name string) {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(name), 0o600))
+			runGit(t, tmpDir, "add", name)
+			runGit(t, tmpDir, "commit", "-m", name)
 		}
+		commitFile("base")
+
+		remoteDir := t.TempDir()
+		runGit(t, tmpDir, "init", "--bare", remoteDir)
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "-u", "origin", "main")
+
+		runGit(t, tmpDir, "checkout", "-b", "feature")
+		commitFile("feature")
+		if merge {
+			runGit(t, tmpDir, "checkout", "main")
+			commitFile("other")
+			runGit(t, tmpDir, "merge", "--no-ff", "-m", "merge feature", "feature")
+			runGit(t, tmpDir, "push", "origin", "main")
+			runGit(t, tmpDir, "checkout", "feature")
+		}
+		return tmpDir
+	}
+
+	t.Run("skips the rebase of a branch already merged into trunk", func(t *testing.T) {
+		tmpDir := setupRepo(t, true)
+		// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+		before, err := exec.Command("git", "-C", tmpDir, "rev-parse", "HEAD").Output()
+		require.NoError(t, err)
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+		var mu sync.Mutex
+		var result RepositoryOperationResult
+		output, _ := captureStdout(func() error {
+			result = processRepositoryUpdate(os.Stdout, repo, false, false, &mu)
+			return nil
+		})
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, "feature", result.MergedBranch)
+		assert.Contains(t, result.Steps, "rebase (skipped: merged)")
+		assert.Contains(t, output, "branch feature is already merged into main; nothing to rebase")
+		// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+		after, err := exec.Command("git", "-C", tmpDir, "rev-parse", "HEAD").Output()
+		require.NoError(t, err)
+		assert.Equal(t, string(before), string(after))
 	})
-	require.NoError(t, os.Chdir(tmpDir))
-	defer func() { _ = os.Chdir("/") }()
 
-	runGit(t, "", "init", "-b", "main")
-	runGit(t, "", "config", "user.email", "test@example.com")
-	runGit(t, "", "config", "user.name", "Test User")
-	require.NoError(t, os.WriteFile("f", []byte("a"), 0o600))
-	runGit(t, "", "add", "f")
-	runGit(t, "", "commit", "-m", "A")
+	t.Run("rebases a branch that is not merged", func(t *testing.T) {
+		tmpDir := setupRepo(t, false)
 
-	remoteDir := t.TempDir()
-	addSafeDirectory(t, remoteDir)
-	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
-	runGit(t, "", "init", "--bare", remoteDir)
-	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
-	runGit(t, "", "remote", "add", "origin", remoteDir)
-	runGit(t, "", "push", "-u", "origin", "main")
-	// Ensure the bare repo HEAD points at main so clones check out main.
-	runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+		var mu sync.Mutex
+		var result RepositoryOperationResult
+		_, _ = captureStdout(func() error {
+			result = processRepositoryUpdate(os.Stdout, repo, false, false, &mu)
+			return nil
+		})
 
-	// Divergent commit on remote
-	cloneDir := t.TempDir()
-	addSafeDirectory(t, cloneDir)
-	// #nosec G204 - paths from t.TempDir(), safe for test use
-	runGit(t, "", "clone", remoteDir, cloneDir)
-	require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "f"), []byte("b"), 0o600))
+		require.NoError(t, result.Error)
+		assert.Empty(t, result.MergedBranch)
+		assert.Contains(t, result.Steps, "rebase")
+	})
+
+	t.Run("a branch without commits of its own is not merged", func(t *testing.T) {
+		tmpDir := setupRepo(t, false)
+		runGit(t, tmpDir, "checkout", "-b", "fresh", "main")
+
+		_, merged := mergedBranchOf(RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"})
+		assert.False(t, merged)
+	})
+
+	t.Run("--cleanup-merged deletes the merged branch", func(t *testing.T) {
+		tmpDir := setupRepo(t, true)
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+		results := []RepositoryOperationResult{{Repo: repo, MergedBranch: "feature"}}
+
+		cleanupMergedBranchesForResults(results)
+
+		require.NoError(t, results[0].Error)
+		assert.Contains(t, results[0].Steps, "cleanup-merged")
+		branch, err := getCurrentBranch(tmpDir)
+		require.NoError(t, err)
+		assert.Equal(t, "main", branch)
+		// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+		err = exec.Command("git", "-C", tmpDir, "rev-parse", "--verify", "--quiet", "refs/heads/feature").Run()
+		assert.Error(t, err, "feature branch should be deleted")
+	})
+}
+
+func TestProcessRepositoryUpdate_afterUpdateHook(t *testing.T) {
+	// setupRepo creates main pushed to a bare remote and a feature branch with one commit.
+	setupRepo := func(t *testing.T) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		addSafeDirectory(t, tmpDir)
+		runGit(t, tmpDir, "init", "-b", "main")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "base"), []byte("base"), 0o600))
+		runGit(t, tmpDir, "add", "base")
+		runGit(t, tmpDir, "commit", "-m", "base")
+		remoteDir := t.TempDir()
+		runGit(t, tmpDir, "init", "--bare", remoteDir)
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "-u", "origin", "main")
+		runGit(t, tmpDir, "checkout", "-b", "feature")
+		return tmpDir
+	}
+	update := func(repo RepositoryInfo) RepositoryOperationResult {
+		var mu sync.Mutex
+		var result RepositoryOperationResult
+		_, _ = captureStdout(func() error {
+			result = processRepositoryUpdate(os.Stdout, repo, false, false, &mu)
+			return nil
+		})
+		return result
+	}
+
+	t.Run("runs the hook in the repository with placeholders replaced", func(t *testing.T) {
+		tmpDir := setupRepo(t)
+		marker := filepath.Join(t.TempDir(), "hook.txt")
+		repo := RepositoryInfo{
+			Name: "api", Path: tmpDir, TrunkBranch: "main", Remote: "origin",
+			AfterUpdateHook: "echo '{repo} {branch} {path}' > " + marker + " && echo deps installed",
+		}
+
+		result := update(repo)
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, "feature", result.Branch)
+		assert.Equal(t, "hook", result.Steps[len(result.Steps)-1])
+		assert.Equal(t, "deps installed", result.HookOutput)
+		assert.Equal(t, "api feature "+tmpDir+"\n", string(mustReadFile(t, marker)))
+	})
+
+	t.Run("a failing hook fails the repository with its stderr", func(t *testing.T) {
+		tmpDir := setupRepo(t)
+		repo := RepositoryInfo{
+			Name: "api", Path: tmpDir, TrunkBranch: "main", Remote: "origin",
+			AfterUpdateHook: "echo 'missing target deps' >&2; exit 2",
+		}
+
+		result := update(repo)
+
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "after_update hook failed")
+		assert.Contains(t, result.Error.Error(), "missing target deps")
+		assert.Contains(t, result.Steps, "hook (failed)")
+	})
+
+	t.Run("skips the hook when the update fails", func(t *testing.T) {
+		tmpDir := setupRepo(t)
+		marker := filepath.Join(t.TempDir(), "hook.txt")
+		repo := RepositoryInfo{
+			Name: "api", Path: tmpDir, TrunkBranch: "main", Remote: "missing",
+			AfterUpdateHook: "touch " + marker,
+		}
+
+		result := update(repo)
+
+		require.Error(t, result.Error)
+		assert.NotContains(t, result.Steps, "hook")
+		assert.NoFileExists(t, marker)
+	})
+
+	t.Run("shows the hook output with --verbose", func(t *testing.T) {
+		result := RepositoryOperationResult{Repo: RepositoryInfo{Name: "api"}, Steps: []string{"fetch", "rebase", "hook"}, HookOutput: "line one\nline two"}
+
+		output, _ := captureStdout(func() error {
+			displaySuccessfulResult(os.Stdout, result, true)
+			return nil
+		})
+		assert.Contains(t, output, "    Hook output:\n      line one\n      line two\n")
+
+		output, _ = captureStdout(func() error {
+			displaySuccessfulResult(os.Stdout, result, false)
+			return nil
+		})
+		assert.NotContains(t, output, "Hook output")
+	})
+}
+
+func TestProcessRepositoryUpdate_shallow(t *testing.T) {
+	// setupShallowClone creates a remote with three commits on main, a --depth 1 clone of it with a
+	// feature branch, and one more commit on the remote main.
+	setupShallowClone := func(t *testing.T) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		sourceDir := t.TempDir()
+		addSafeDirectory(t, sourceDir)
+		runGit(t, sourceDir, "init", "-b", "main")
+		runGit(t, sourceDir, "config", "user.email", "test@example.com")
+		runGit(t, sourceDir, "config", "user.name", "Test User")
+		commitFile := func(dir, name string) {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+			runGit(t, dir, "add", name)
+			runGit(t, dir, "commit", "-m", name)
+		}
+		for _, name := range []string{"one", "two", "three"} {
+			commitFile(sourceDir, name)
+		}
+		remoteDir := t.TempDir()
+		runGit(t, sourceDir, "init", "--bare", remoteDir)
+		runGit(t, sourceDir, "remote", "add", "origin", remoteDir)
+		runGit(t, sourceDir, "push", "-u", "origin", "main")
+
+		cloneDir := filepath.Join(t.TempDir(), "clone")
+		runGit(t, sourceDir, "clone", "--depth", "1", "--branch", "main", "file://"+remoteDir, cloneDir)
+		addSafeDirectory(t, cloneDir)
+		runGit(t, cloneDir, "config", "user.email", "test@example.com")
+		runGit(t, cloneDir, "config", "user.name", "Test User")
+		runGit(t, cloneDir, "checkout", "-b", "feature")
+		commitFile(cloneDir, "feature")
+
+		commitFile(sourceDir, "four")
+		runGit(t, sourceDir, "push", "origin", "main")
+		return cloneDir
+	}
+
+	t.Run("fails with a clear message without --unshallow", func(t *testing.T) {
+		cloneDir := setupShallowClone(t)
+
+		repo := RepositoryInfo{Name: "test", Path: cloneDir, TrunkBranch: "main", Remote: "origin"}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.Error(t, result.Error)
+		assert.Equal(t, "repository test is shallow; run with --unshallow or fetch more history", result.Error.Error())
+		assert.Equal(t, []string{"shallow-check (failed)"}, result.Steps)
+	})
+
+	t.Run("unshallows and then rebases with --unshallow", func(t *testing.T) {
+		cloneDir := setupShallowClone(t)
+
+		repo := RepositoryInfo{Name: "test", Path: cloneDir, TrunkBranch: "main", Remote: "origin", Unshallow: true}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, []string{"unshallow", "fetch", "rebase"}, result.Steps)
+		shallow, err := isShallowRepository(cloneDir)
+		require.NoError(t, err)
+		assert.False(t, shallow)
+		_, err = os.Stat(filepath.Join(cloneDir, "four"))
+		require.NoError(t, err, "rebased onto the new trunk commit")
+	})
+
+	t.Run("full clones are not shallow", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		runGit(t, tmpDir, "init", "-b", "main")
+		shallow, err := isShallowRepository(tmpDir)
+		require.NoError(t, err)
+		assert.False(t, shallow)
+		assert.True(t, withUnshallow([]RepositoryInfo{{Name: "a"}}, true)[0].Unshallow)
+	})
+}
+
+func TestProcessRepositoryUpdate_rebaseMerges(t *testing.T) {
+	// setupRepo creates main pushed to a bare remote and a feature branch that merged a side
+	// branch with a merge commit, then adds a commit to main on the remote.
+	setupRepo := func(t *testing.T) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		addSafeDirectory(t, tmpDir)
+		commit := func(dir, name string) {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+			runGit(t, dir, "add", name)
+			runGit(t, dir, "commit", "-m", name)
+		}
+		runGit(t, tmpDir, "init", "-b", "main")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		commit(tmpDir, "base")
+
+		remoteDir := t.TempDir()
+		runGit(t, tmpDir, "init", "--bare", remoteDir)
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "-u", "origin", "main")
+		runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+
+		runGit(t, tmpDir, "checkout", "-b", "feature")
+		commit(tmpDir, "feature-one")
+		runGit(t, tmpDir, "checkout", "-b", "side")
+		commit(tmpDir, "side")
+		runGit(t, tmpDir, "checkout", "feature")
+		commit(tmpDir, "feature-two")
+		runGit(t, tmpDir, "merge", "--no-ff", "-m", "merge side", "side")
+
+		cloneDir := t.TempDir()
+		runGit(t, tmpDir, "clone", remoteDir, cloneDir)
+		runGit(t, cloneDir, "config", "user.email", "test@example.com")
+		runGit(t, cloneDir, "config", "user.name", "Test User")
+		commit(cloneDir, "trunk")
+		runGit(t, cloneDir, "push", "origin", "main")
+		return tmpDir
+	}
+	// mergeCommits returns how many merge commits the branch has on top of the remote trunk.
+	mergeCommits := func(t *testing.T, dir string) string {
+		t.Helper()
+		// #nosec G204 - dir from t.TempDir(), safe for test use
+		output, err := exec.Command("git", "-C", dir, "rev-list", "--merges", "--count", "origin/main..HEAD").Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(output))
+	}
+
+	t.Run("keeps the merge commit with --rebase-merges", func(t *testing.T) {
+		tmpDir := setupRepo(t)
+
+		repos := withRebaseMerges([]RepositoryInfo{{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}}, true)
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repos[0], false, false, &mu)
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, "1", mergeCommits(t, tmpDir))
+		_, err := os.Stat(filepath.Join(tmpDir, "trunk"))
+		require.NoError(t, err, "rebased onto the new trunk commit")
+	})
+
+	t.Run("flattens the merge commit by default", func(t *testing.T) {
+		tmpDir := setupRepo(t)
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.NoError(t, result.Error)
+		assert.Equal(t, "0", mergeCommits(t, tmpDir))
+	})
+
+	t.Run("git.rebase_merges enables it and adds the rebase flag", func(t *testing.T) {
+		cfg := &config.Config{Git: &config.GitConfig{RebaseMerges: true}}
+		repo := RepositoryInfo{RebaseMerges: rebaseMerges(cfg), UseAutostash: true}
+		assert.Equal(t, []string{"rebase", "--autostash", "--rebase-merges", "origin/main"}, rebaseArgs(repo, "origin/main"))
+		assert.True(t, withRebaseMerges([]RepositoryInfo{repo}, false)[0].RebaseMerges)
+		assert.False(t, withRebaseMerges([]RepositoryInfo{{}}, false)[0].RebaseMerges)
+	})
+}
+
+func TestProcessRepositoryUpdateOnTrunk_autostash(t *testing.T) {
+	// setupRepo creates a main branch pushed to a bare remote, with a divergent remote commit
+	// changing f to remoteContent and a local commit changing f to localContent.
+	setupRepo := func(t *testing.T, localContent, remoteContent string) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		tmpDir := t.TempDir()
+		addSafeDirectory(t, tmpDir)
+		runGit(t, tmpDir, "init", "-b", "main")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "f"), []byte("a\n"), 0o600))
+		runGit(t, tmpDir, "add", "f")
+		runGit(t, tmpDir, "commit", "-m", "A")
+
+		remoteDir := t.TempDir()
+		runGit(t, tmpDir, "init", "--bare", remoteDir)
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "-u", "origin", "main")
+		runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+
+		cloneDir := t.TempDir()
+		runGit(t, tmpDir, "clone", remoteDir, cloneDir)
+		runGit(t, cloneDir, "config", "user.email", "test@example.com")
+		runGit(t, cloneDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "remote.txt"), []byte(remoteContent), 0o600))
+		if remoteContent != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "f"), []byte(remoteContent), 0o600))
+		}
+		runGit(t, cloneDir, "add", "-A")
+		runGit(t, cloneDir, "commit", "-m", "B")
+		runGit(t, cloneDir, "push", "origin", "main")
+
+		if localContent != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "f"), []byte(localContent), 0o600))
+			runGit(t, tmpDir, "commit", "-am", "C")
+		}
+		// Uncommitted change for git to autostash
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "g"), []byte("g"), 0o600))
+		runGit(t, tmpDir, "add", "g")
+		return tmpDir
+	}
+
+	t.Run("git stashes and reapplies changes", func(t *testing.T) {
+		tmpDir := setupRepo(t, "", "")
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin", UseAutostash: true}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.NoError(t, result.Error)
+		assert.True(t, result.Autostash)
+		assert.True(t, result.HadStash)
+		assert.True(t, result.StashPopped)
+		assert.Contains(t, result.Steps, "autostash")
+		_, err := os.Stat(filepath.Join(tmpDir, "g"))
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(tmpDir, "remote.txt"))
+		require.NoError(t, err, "remote commit should be applied")
+		// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+		stashOut, err := exec.Command("git", "-C", tmpDir, "stash", "list").Output()
+		require.NoError(t, err)
+		assert.Empty(t, strings.TrimSpace(string(stashOut)), "kira should not create its own stash")
+	})
+
+	t.Run("conflicted rebase keeps autostash pending", func(t *testing.T) {
+		tmpDir := setupRepo(t, "c\n", "b\n")
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin", UseAutostash: true}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.Error(t, result.Error)
+		assert.True(t, result.RebaseHadConflicts)
+		assert.True(t, result.HadStash)
+		assert.False(t, result.StashPopped)
+		steps := getRecoverySteps(result)
+		require.NotEmpty(t, steps)
+		assert.Contains(t, steps[len(steps)-1], "autostashed by git")
+	})
+
+	t.Run("clean tree reports no stash", func(t *testing.T) {
+		tmpDir := setupRepo(t, "", "")
+		runGit(t, tmpDir, "commit", "-m", "G")
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin", UseAutostash: true}
+		var mu sync.Mutex
+		result := processRepositoryUpdate(io.Discard, repo, false, false, &mu)
+
+		require.NoError(t, result.Error)
+		assert.False(t, result.HadStash)
+		assert.NotContains(t, result.Steps, "autostash")
+	})
+}
+
+func TestProcessRepositoryUpdateOnTrunk_noPopStash(t *testing.T) {
+	tmpDir := t.TempDir()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir("/") }()
+
+	require.NoError(t, exec.Command("git", "init").Run())
+	require.NoError(t, exec.Command("git", "config", "user.email", "test@example.com").Run())
+	require.NoError(t, exec.Command("git", "config", "user.name", "Test User").Run())
+	require.NoError(t, os.WriteFile("a.txt", []byte("a"), 0o600))
+	require.NoError(t, exec.Command("git", "add", "a.txt").Run())
+	require.NoError(t, exec.Command("git", "commit", "-m", "Initial").Run())
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	_ = exec.Command("git", "branch", "-M", "main").Run()
+
+	remoteDir := t.TempDir()
+	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
+	require.NoError(t, exec.Command("git", "init", "--bare", remoteDir).Run())
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	require.NoError(t, exec.Command("git", "remote", "add", "origin", remoteDir).Run())
+	require.NoError(t, exec.Command("git", "push", "-u", "origin", "main").Run())
+
+	require.NoError(t, os.WriteFile("dirty.txt", []byte("dirty"), 0o600))
+
+	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+	var mu sync.Mutex
+	result := processRepositoryUpdate(io.Discard, repo, false, true, &mu) // noPopStash=true
+
+	require.NoError(t, result.Error)
+	assert.True(t, result.HadStash)
+	assert.False(t, result.StashPopped)
+	// Stash should still have one entry
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	out, err := exec.Command("git", "-C", tmpDir, "stash", "list").Output()
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "kira latest")
+}
+
+func TestProcessRepositoryUpdateOnTrunk_conflict_doesNotPopStash(t *testing.T) {
+	setupGitConfigForCISerial(t)
+	tmpDir := t.TempDir()
+	addSafeDirectory(t, tmpDir)
+	t.Cleanup(func() {
+		if t.Failed() {
+			logGitDebug(t, tmpDir)
+		}
+	})
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir("/") }()
+
+	runGit(t, "", "init", "-b", "main")
+	runGit(t, "", "config", "user.email", "test@example.com")
+	runGit(t, "", "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile("f", []byte("a"), 0o600))
+	runGit(t, "", "add", "f")
+	runGit(t, "", "commit", "-m", "A")
+
+	remoteDir := t.TempDir()
+	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
+	runGit(t, "", "init", "--bare", remoteDir)
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	runGit(t, "", "remote", "add", "origin", remoteDir)
+	runGit(t, "", "push", "-u", "origin", "main")
+	// Ensure the bare repo HEAD points at main so clones check out main.
+	runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+
+	// Divergent commit on remote (clone, change f, push)
+	cloneDir := t.TempDir()
+	// #nosec G204 - paths from t.TempDir(), safe for test use
+	runGit(t, "", "clone", remoteDir, cloneDir)
+	require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "f"), []byte("b"), 0o600))
+	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
+	runGit(t, cloneDir, "add", "f")
+	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
+	runGit(t, cloneDir, "config", "user.email", "test@example.com")
+	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
+	runGit(t, cloneDir, "config", "user.name", "Test User")
+	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
+	runGit(t, cloneDir, "commit", "-m", "B")
+	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
+	runGit(t, cloneDir, "push", "origin", "main")
+
+	// Local divergent commit (change f to c) so rebase will conflict
+	require.NoError(t, os.WriteFile("f", []byte("c"), 0o600))
+	runGit(t, "", "add", "f")
+	runGit(t, "", "commit", "-m", "C")
+
+	// Uncommitted file so we get a stash
+	require.NoError(t, os.WriteFile("g", []byte("g"), 0o600))
+
+	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+	var mu sync.Mutex
+	result := processRepositoryUpdate(io.Discard, repo, false, false, &mu) // abortOnConflict=false
+
+	require.Error(t, result.Error, "expected rebase conflict")
+	assert.True(t, result.HadStash)
+	assert.False(t, result.StashPopped)
+	assert.True(t, result.RebaseHadConflicts)
+	// Stash should still contain one entry
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	stashOut, err := exec.Command("git", "-C", tmpDir, "stash", "list").Output()
+	require.NoErrorf(t, err, "git stash list: %v", err)
+	assert.Contains(t, string(stashOut), "kira latest")
+	// Rebase should be in progress
+	// #nosec G304 - path under tmpDir from t.TempDir(), safe for test use
+	_, err = os.Stat(filepath.Join(tmpDir, ".git", "rebase-merge"))
+	require.NoErrorf(t, err, "rebase-merge dir: %v", err)
+}
+
+func TestProcessRepositoryUpdateOnTrunk_abortOnConflict_popsStash(t *testing.T) {
+	setupGitConfigForCISerial(t)
+	tmpDir := t.TempDir()
+	addSafeDirectory(t, tmpDir)
+	t.Cleanup(func() {
+		if t.Failed() {
+			logGitDebug(t, tmpDir)
+		}
+	})
+	require.NoError(t, os.Chdir(tmpDir))
+	defer func() { _ = os.Chdir("/") }()
+
+	runGit(t, "", "init", "-b", "main")
+	runGit(t, "", "config", "user.email", "test@example.com")
+	runGit(t, "", "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile("f", []byte("a"), 0o600))
+	runGit(t, "", "add", "f")
+	runGit(t, "", "commit", "-m", "A")
+
+	remoteDir := t.TempDir()
+	addSafeDirectory(t, remoteDir)
+	// #nosec G204 - remoteDir from t.TempDir(), safe for test use
+	runGit(t, "", "init", "--bare", remoteDir)
+	// #nosec G204 - tmpDir from t.TempDir(), safe for test use
+	runGit(t, "", "remote", "add", "origin", remoteDir)
+	runGit(t, "", "push", "-u", "origin", "main")
+	// Ensure the bare repo HEAD points at main so clones check out main.
+	runGit(t, remoteDir, "symbolic-ref", "HEAD", "refs/heads/main")
+
+	// Divergent commit on remote
+	cloneDir := t.TempDir()
+	addSafeDirectory(t, cloneDir)
+	// #nosec G204 - paths from t.TempDir(), safe for test use
+	runGit(t, "", "clone", remoteDir, cloneDir)
+	require.NoError(t, os.WriteFile(filepath.Join(cloneDir, "f"), []byte("b"), 0o600))
 	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
 	runGit(t, cloneDir, "add", "f")
 	// #nosec G204 - cloneDir from t.TempDir(), safe for test use
@@ -1902,7 +2752,7 @@ func TestProcessRepositoryUpdateOnTrunk_abortOnConflict_popsStash(t *testing.T)
 
 	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
 	var mu sync.Mutex
-	result := processRepositoryUpdate(repo, true, false, &mu) // abortOnConflict=true
+	result := processRepositoryUpdate(io.Discard, repo, true, false, &mu) // abortOnConflict=true
 
 	require.Error(t, result.Error, "expected rebase conflict")
 	assert.True(t, result.HadStash)
@@ -2021,7 +2871,7 @@ func TestPerformFetchAndRebaseForAllRepos(t *testing.T) {
 			},
 		}
 
-		results := performFetchAndRebaseForAllRepos(repos, false, false)
+		results := performFetchAndRebaseForAllRepos(io.Discard, repos, false, false)
 		require.Len(t, results, 1)
 		// May have errors if remote doesn't exist, which is expected
 		// The important thing is the function completes
@@ -2065,12 +2915,95 @@ func TestPerformFetchAndRebaseForAllRepos(t *testing.T) {
 			},
 		}
 
-		results := performFetchAndRebaseForAllRepos(repos, false, false)
+		results := performFetchAndRebaseForAllRepos(io.Discard, repos, false, false)
 		require.Len(t, results, 2)
 		// Both should be processed (may have errors if remotes don't exist)
 	})
 }
 
+func TestPerformFetchAndRebaseFailureHandling(t *testing.T) {
+	// setupRepos creates a repository whose remote cannot be read (first) and one with a working
+	// bare remote (second).
+	setupRepos := func(t *testing.T) []RepositoryInfo {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		var repos []RepositoryInfo
+		for _, name := range []string{"no-access", "ok"} {
+			dir := t.TempDir()
+			runGit(t, dir, "init", "-b", "main")
+			runGit(t, dir, "config", "user.email", "test@example.com")
+			runGit(t, dir, "config", "user.name", "Test User")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+			runGit(t, dir, "add", "a.txt")
+			runGit(t, dir, "commit", "-m", "Initial")
+
+			remoteDir := filepath.Join(t.TempDir(), "missing.git")
+			if name == "ok" {
+				runGit(t, dir, "init", "--bare", remoteDir)
+			}
+			runGit(t, dir, "remote", "add", "origin", remoteDir)
+			if name == "ok" {
+				runGit(t, dir, "push", "-u", "origin", "main")
+			}
+			repos = append(repos, RepositoryInfo{Name: name, Path: dir, TrunkBranch: "main", Remote: "origin"})
+		}
+		return repos
+	}
+
+	t.Run("continues past a repository that cannot be fetched", func(t *testing.T) {
+		repos := setupRepos(t)
+
+		results := performFetchAndRebaseForAllRepos(io.Discard, repos, false, false)
+		require.Len(t, results, 2)
+		require.Error(t, results[0].Error)
+		assert.True(t, results[0].Unauthorized)
+		assert.False(t, results[0].Skipped)
+		require.NoError(t, results[1].Error)
+
+		err := handleUpdateResults(results, latestOutput{Progress: io.Discard})
+		require.Error(t, err, "a failed repository should still make the command fail")
+	})
+
+	t.Run("fail-fast stops at the first failure", func(t *testing.T) {
+		repos := setupRepos(t)
+
+		results := performFetchAndRebaseUntilFailure(io.Discard, repos, false, false)
+		require.Len(t, results, 2)
+		require.Error(t, results[0].Error)
+		require.Error(t, results[1].Error)
+		assert.True(t, results[1].Skipped)
+		assert.Contains(t, results[1].Error.Error(), "no-access failed")
+		assert.Empty(t, results[1].Steps, "skipped repository must not be fetched")
+	})
+
+	t.Run("--keep-going cannot be combined with --fail-fast", func(t *testing.T) {
+		newCmd := func(keepGoing, failFast bool) *cobra.Command {
+			cmd := &cobra.Command{}
+			cmd.Flags().Bool("keep-going", keepGoing, "")
+			cmd.Flags().Bool("fail-fast", failFast, "")
+			return cmd
+		}
+
+		require.NoError(t, validateLatestFailureMode(newCmd(true, false)))
+		require.NoError(t, validateLatestFailureMode(newCmd(false, true)))
+		require.EqualError(t, validateLatestFailureMode(newCmd(true, true)),
+			"invalid flag combination: --keep-going cannot be used together with --fail-fast")
+	})
+
+	t.Run("summary lists skipped repositories", func(t *testing.T) {
+		results := []RepositoryOperationResult{
+			{Repo: RepositoryInfo{Name: "a"}, Error: fmt.Errorf("fetch failed"), Unauthorized: true},
+			{Repo: RepositoryInfo{Name: "b"}, Error: fmt.Errorf("not attempted"), Skipped: true},
+		}
+
+		var buf bytes.Buffer
+		displaySkippedRepos(&buf, results)
+
+		assert.Contains(t, buf.String(), "No access (skipped, other repositories were still updated): a")
+		assert.Contains(t, buf.String(), "Not attempted (--fail-fast): b")
+	})
+}
+
 func TestPerformFetchAndRebaseForAllRepos_RebaseConflictsAbortFlag(t *testing.T) {
 	setupRepoWithRebaseConflict := func(t *testing.T) (string, RepositoryInfo) {
 		tmpDir := t.TempDir()
@@ -2126,7 +3059,7 @@ func TestPerformFetchAndRebaseForAllRepos_RebaseConflictsAbortFlag(t *testing.T)
 		tmpDir, repo := setupRepoWithRebaseConflict(t)
 		defer func() { _ = os.Chdir("/") }()
 
-		results := performFetchAndRebaseForAllRepos([]RepositoryInfo{repo}, false, false)
+		results := performFetchAndRebaseForAllRepos(io.Discard, []RepositoryInfo{repo}, false, false)
 		require.Len(t, results, 1)
 		result := results[0]
 
@@ -2153,7 +3086,7 @@ func TestPerformFetchAndRebaseForAllRepos_RebaseConflictsAbortFlag(t *testing.T)
 		tmpDir, repo := setupRepoWithRebaseConflict(t)
 		defer func() { _ = os.Chdir("/") }()
 
-		results := performFetchAndRebaseForAllRepos([]RepositoryInfo{repo}, true, false)
+		results := performFetchAndRebaseForAllRepos(io.Discard, []RepositoryInfo{repo}, true, false)
 		require.Len(t, results, 1)
 		result := results[0]
 
@@ -2241,7 +3174,7 @@ func TestHandleInProgressRebases_ContinuesRebase(t *testing.T) {
 	}
 
 	// handleInProgressRebases should run `git rebase --continue` and complete the rebase
-	err = handleInProgressRebases(stateInfos)
+	err = handleInProgressRebases(io.Discard, stateInfos)
 	require.NoError(t, err)
 
 	// After continue, there should be no in-progress rebase directory
@@ -2257,57 +3190,253 @@ func TestHandleInProgressRebases_ContinuesRebase(t *testing.T) {
 func TestDisplayOperationProgress(t *testing.T) {
 	t.Run("displays progress message", func(t *testing.T) {
 		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+		var buf bytes.Buffer
+
+		displayOperationProgress(&buf, "test-repo", "fetching")
+
+		output := buf.String()
+
+		assert.Contains(t, output, "test-repo")
+		assert.Contains(t, output, "fetching")
+	})
+}
+
+func TestDisplayOperationResults(t *testing.T) {
+	t.Run("displays success and failure results", func(t *testing.T) {
+		// Capture output
+		var buf bytes.Buffer
+
+		results := []RepositoryOperationResult{
+			{
+				Repo:  RepositoryInfo{Name: "repo1"},
+				Steps: []string{"fetch", "rebase"},
+			},
+			{
+				Repo:  RepositoryInfo{Name: "repo2"},
+				Error: fmt.Errorf("test error"),
+				Steps: []string{"fetch (failed)"},
+			},
+		}
+
+		displayOperationResults(&buf, results, false, 0)
+
+		output := buf.String()
+
+		assert.Contains(t, output, "repo1")
+		assert.Contains(t, output, "repo2")
+		assert.Contains(t, output, "SUCCESS")
+		assert.Contains(t, output, "FAILED")
+		assert.Contains(t, output, "Summary")
+	})
+}
+
+func TestDisplayOperationResultsDurations(t *testing.T) {
+	results := []RepositoryOperationResult{
+		{Repo: RepositoryInfo{Name: "fast"}, Steps: []string{"fetch"}, Duration: 200 * time.Millisecond},
+		{Repo: RepositoryInfo{Name: "slow"}, Steps: []string{"fetch"}, Duration: 2 * time.Second},
+	}
+
+	capture := func(verbose bool) string {
+		var buf bytes.Buffer
+		displayOperationResults(&buf, results, verbose, 2*time.Second)
 
-		displayOperationProgress("test-repo", "fetching")
+		return buf.String()
+	}
+
+	t.Run("shows per-repo and total time in original order", func(t *testing.T) {
+		output := capture(false)
+		assert.Contains(t, output, "fast: SUCCESS (200ms)")
+		assert.Contains(t, output, "slow: SUCCESS (2s)")
+		assert.Contains(t, output, "(total time: 2s)", "the wall-clock time, not the sum of the repositories")
+		assert.Less(t, strings.Index(output, "fast:"), strings.Index(output, "slow:"))
+		assert.NotContains(t, output, "Slowest:")
+	})
+
+	t.Run("verbose lists slowest first", func(t *testing.T) {
+		output := capture(true)
+		assert.Less(t, strings.Index(output, "slow:"), strings.Index(output, "fast:"))
+		assert.Contains(t, output, "Slowest: slow (2s)")
+		assert.Equal(t, "fast", results[0].Repo.Name, "input order must not change")
+	})
+}
+
+func TestDisplayOperationResultsBranch(t *testing.T) {
+	results := []RepositoryOperationResult{
+		{Repo: RepositoryInfo{Name: "api", TrunkBranch: "main", Remote: "origin"}, Steps: []string{"fetch", "rebase"}, Branch: "feature"},
+		{Repo: RepositoryInfo{Name: "web", TrunkBranch: "main", Remote: "origin"}, Steps: []string{"fetch", "trunk-update"}, Branch: "main"},
+		{Repo: RepositoryInfo{Name: "lib", TrunkBranch: "main", Remote: "origin", Onto: "parent"}, Steps: []string{"fetch", "rebase --onto parent"}, Branch: "child"},
+		{Repo: RepositoryInfo{Name: "docs", TrunkBranch: "main", Remote: "origin"}, Error: fmt.Errorf("rebase failed"), Branch: "fix"},
+	}
+
+	output, err := captureStdout(func() error {
+		displayOperationResults(os.Stdout, results, false, 0)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, output, "✓ api: SUCCESS (0s)\n    Branch: feature rebased onto origin/main\n")
+	assert.Contains(t, output, "✓ web: SUCCESS (0s)\n    Branch: main updated from origin/main\n")
+	assert.Contains(t, output, "    Branch: child rebased onto parent\n")
+	assert.Contains(t, output, "✗ docs: FAILED (0s)\n    Branch: fix\n")
+}
+
+func TestWriteOperationResultsJSON(t *testing.T) {
+	results := []RepositoryOperationResult{
+		{Repo: RepositoryInfo{Name: "repo1", Path: "/tmp/repo1"}, Steps: []string{"fetch", "rebase"}, Duration: 1500 * time.Millisecond, Branch: "feature"},
+		{Repo: RepositoryInfo{Name: "repo2", Path: "/tmp/repo2"}, Error: fmt.Errorf("fetch failed"), Duration: 250 * time.Millisecond},
+	}
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	require.NoError(t, writeOperationResultsJSON(w, results, nil, 1600*time.Millisecond))
+	_ = w.Close()
+
+	var report struct {
+		Repositories []struct {
+			Name       string   `json:"name"`
+			Branch     string   `json:"branch"`
+			Success    bool     `json:"success"`
+			Error      string   `json:"error"`
+			Steps      []string `json:"steps"`
+			DurationMs int64    `json:"duration_ms"`
+		} `json:"repositories"`
+		Succeeded       int   `json:"succeeded"`
+		Failed          int   `json:"failed"`
+		TotalDurationMs int64 `json:"total_duration_ms"`
+	}
+	require.NoError(t, json.NewDecoder(r).Decode(&report))
+
+	require.Len(t, report.Repositories, 2)
+	assert.Equal(t, "repo1", report.Repositories[0].Name)
+	assert.Equal(t, "feature", report.Repositories[0].Branch)
+	assert.Empty(t, report.Repositories[1].Branch)
+	assert.True(t, report.Repositories[0].Success)
+	assert.Equal(t, int64(1500), report.Repositories[0].DurationMs)
+	assert.False(t, report.Repositories[1].Success)
+	assert.Equal(t, "fetch failed", report.Repositories[1].Error)
+	assert.NotNil(t, report.Repositories[1].Steps)
+	assert.Equal(t, 1, report.Succeeded)
+	assert.Equal(t, 1, report.Failed)
+	assert.Equal(t, int64(1600), report.TotalDurationMs)
+}
+
+func TestSetupLatestOutput(t *testing.T) {
+	newCmd := func(t *testing.T, jsonFlag bool) (*cobra.Command, *bytes.Buffer, *bytes.Buffer) {
+		t.Helper()
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("json", false, "")
+		cmd.Flags().Bool("verbose", false, "")
+		cmd.Flags().Bool("summary", false, "")
+		if jsonFlag {
+			require.NoError(t, cmd.Flags().Set("json", "true"))
+		}
+		var stdout, stderr bytes.Buffer
+		cmd.SetOut(&stdout)
+		cmd.SetErr(&stderr)
+		return cmd, &stdout, &stderr
+	}
+	results := []RepositoryOperationResult{{Repo: RepositoryInfo{Name: "api"}, Steps: []string{"fetch", "rebase"}}}
+
+	t.Run("with --json progress goes to stderr and stdout carries only the report", func(t *testing.T) {
+		cmd, stdout, stderr := newCmd(t, true)
+		stdoutBefore := os.Stdout
+
+		output := setupLatestOutput(cmd)
+		assert.Same(t, stdoutBefore, os.Stdout, "os.Stdout must not be replaced")
+		displayOperationProgress(output.Progress, "api", "fetching")
+		require.NoError(t, handleUpdateResults(results, output))
+
+		assert.Contains(t, stderr.String(), "  Updating api: fetching...\n")
+		assert.Contains(t, stderr.String(), "All repositories updated successfully!")
+		var report map[string]interface{}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+		assert.Equal(t, float64(1), report["succeeded"])
+	})
 
-		_ = w.Close()
-		os.Stdout = oldStdout
+	t.Run("without --json progress and results go to stdout", func(t *testing.T) {
+		cmd, stdout, stderr := newCmd(t, false)
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		output := setupLatestOutput(cmd)
+		displayOperationProgress(output.Progress, "api", "fetching")
+		require.NoError(t, handleUpdateResults(results, output))
 
-		assert.Contains(t, output, "test-repo")
-		assert.Contains(t, output, "fetching")
+		assert.Contains(t, stdout.String(), "  Updating api: fetching...\n")
+		assert.Contains(t, stdout.String(), "Summary: 1 succeeded, 0 failed")
+		assert.Empty(t, stderr.String())
 	})
 }
 
-func TestDisplayOperationResults(t *testing.T) {
-	t.Run("displays success and failure results", func(t *testing.T) {
-		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+func TestLatestWorkItemSummary(t *testing.T) {
+	item := &latestWorkItem{ID: "012", Title: "Add login", Status: "doing", Kind: "task", Branch: "012-add-login", Path: ".work/2_doing/012-add-login.task.md"}
+	results := []RepositoryOperationResult{
+		{Repo: RepositoryInfo{Name: "api"}},
+		{Repo: RepositoryInfo{Name: "web"}},
+		{Repo: RepositoryInfo{Name: "docs"}, Error: fmt.Errorf("fetch failed")},
+	}
 
-		results := []RepositoryOperationResult{
-			{
-				Repo:  RepositoryInfo{Name: "repo1"},
-				Steps: []string{"fetch", "rebase"},
-			},
-			{
-				Repo:  RepositoryInfo{Name: "repo2"},
-				Error: fmt.Errorf("test error"),
-				Steps: []string{"fetch (failed)"},
-			},
-		}
+	t.Run("finds the work item of the checked out branch", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{
+			"2_doing/012-add-login.task.md": listTestWorkItem("012", "Add login", "doing", ""),
+		})
+		require.NoError(t, exec.Command("git", "init").Run())
+		require.NoError(t, exec.Command("git", "config", "user.email", "test@example.com").Run())
+		require.NoError(t, exec.Command("git", "config", "user.name", "Test User").Run())
+		require.NoError(t, exec.Command("git", "commit", "--allow-empty", "-m", "initial").Run())
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		assert.Nil(t, currentLatestWorkItem(cfg), "trunk is not a work item branch")
+
+		require.NoError(t, exec.Command("git", "checkout", "-b", "012-add-login").Run())
+		found := currentLatestWorkItem(cfg)
+		require.NotNil(t, found)
+		assert.Equal(t, "012", found.ID)
+		assert.Equal(t, "Add login", found.Title)
+		assert.Equal(t, "doing", found.Status)
+		assert.Equal(t, "task", found.Kind)
+		assert.Equal(t, "012-add-login", found.Branch)
+		assert.Contains(t, found.Path, "012-add-login.task.md")
+	})
+
+	t.Run("closes the results with the work item and the repositories updated", func(t *testing.T) {
+		output, err := captureStdout(func() error {
+			return handleUpdateResults(results, latestOutput{Progress: os.Stdout, WorkItem: item})
+		})
+		require.Error(t, err)
+		assert.Contains(t, output, "Summary: 2 succeeded, 1 failed")
+		assert.Contains(t, output, "\nWork item 012 \"Add login\" (branch 012-add-login): 2 of 3 repositories updated\n")
+		assert.Less(t, strings.Index(output, "Summary:"), strings.Index(output, "Work item 012"))
+	})
 
-		displayOperationResults(results)
+	t.Run("prints no work item line off a work item branch", func(t *testing.T) {
+		output, err := captureStdout(func() error {
+			return handleUpdateResults(results[:1], latestOutput{Progress: os.Stdout})
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, output, "Work item")
+	})
 
+	t.Run("includes the work item in the JSON report", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		require.NoError(t, writeOperationResultsJSON(w, results, item, 0))
 		_ = w.Close()
-		os.Stdout = oldStdout
 
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
-		output := buf.String()
+		var report struct {
+			WorkItem  *latestWorkItem `json:"work_item"`
+			Succeeded int             `json:"succeeded"`
+		}
+		require.NoError(t, json.NewDecoder(r).Decode(&report))
+		assert.Equal(t, item, report.WorkItem)
+		assert.Equal(t, 2, report.Succeeded)
 
-		assert.Contains(t, output, "repo1")
-		assert.Contains(t, output, "repo2")
-		assert.Contains(t, output, "SUCCESS")
-		assert.Contains(t, output, "FAILED")
-		assert.Contains(t, output, "Summary")
+		r, w, err = os.Pipe()
+		require.NoError(t, err)
+		require.NoError(t, writeOperationResultsJSON(w, results, nil, 0))
+		_ = w.Close()
+		var raw map[string]interface{}
+		require.NoError(t, json.NewDecoder(r).Decode(&raw))
+		assert.NotContains(t, raw, "work_item")
 	})
 }
 
@@ -2627,6 +3756,120 @@ func TestValidateAllReposCleanOrDirtyForUpdate(t *testing.T) {
 	})
 }
 
+func TestLatestRemoteOverride(t *testing.T) {
+	t.Run("override reaches fetchFromRemote", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		runGit(t, tmpDir, "init")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile("test.txt", []byte("test"), 0o600))
+		runGit(t, tmpDir, "add", "test.txt")
+		runGit(t, tmpDir, "commit", "-m", "Initial commit")
+		runGit(t, tmpDir, "branch", "-M", "main")
+
+		forkDir := t.TempDir()
+		runGit(t, forkDir, "init", "--bare")
+		runGit(t, tmpDir, "remote", "add", "fork", forkDir)
+		runGit(t, tmpDir, "push", "fork", "main")
+
+		cfg := withRemoteOverride(&config.Config{Git: &config.GitConfig{TrunkBranch: "main", Remote: "origin"}}, "fork")
+		repos, err := resolveRepositoriesForLatest(cfg, WorkspaceBehaviorStandalone)
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+		assert.Equal(t, "fork", repos[0].Remote)
+
+		// origin does not exist, so success proves the fetch used the override
+		require.NoError(t, fetchFromRemote(repos[0]))
+	})
+
+	t.Run("missing override remote is reported by fetchFromRemote", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		runGit(t, tmpDir, "init")
+
+		cfg := withRemoteOverride(&config.Config{Git: &config.GitConfig{TrunkBranch: "main"}}, "nosuchremote")
+		repos, err := resolveRepositoriesForLatest(cfg, WorkspaceBehaviorStandalone)
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+
+		err = fetchFromRemote(repos[0])
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote 'nosuchremote' does not exist")
+	})
+}
+
+func TestLatestPrune(t *testing.T) {
+	t.Run("removes tracking refs for branches deleted on the remote", func(t *testing.T) {
+		remoteDir := t.TempDir()
+		runGit(t, remoteDir, "init", "--bare")
+
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		runGit(t, tmpDir, "init")
+		runGit(t, tmpDir, "config", "user.email", "test@example.com")
+		runGit(t, tmpDir, "config", "user.name", "Test User")
+		require.NoError(t, os.WriteFile("test.txt", []byte("test"), 0o600))
+		runGit(t, tmpDir, "add", "test.txt")
+		runGit(t, tmpDir, "commit", "-m", "Initial commit")
+		runGit(t, tmpDir, "branch", "-M", "main")
+		runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+		runGit(t, tmpDir, "push", "origin", "main", "main:feature-a", "main:feature-b")
+		runGit(t, tmpDir, "fetch", "origin")
+
+		// Delete the branches upstream (directly in the bare remote)
+		runGit(t, remoteDir, "branch", "-D", "feature-a", "feature-b")
+
+		repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+		results := []RepositoryOperationResult{{Repo: repo, Steps: []string{"fetch", "trunk-update"}}}
+		pruneRemoteBranchesForResults(results)
+
+		require.NoError(t, results[0].Error)
+		assert.Equal(t, 2, results[0].PrunedRefs)
+		assert.Contains(t, results[0].Steps, "prune")
+
+		// #nosec G204 - tmpDir is from t.TempDir(), safe for test use
+		err := exec.Command("git", "-C", tmpDir, "rev-parse", "--verify", "refs/remotes/origin/feature-a").Run()
+		require.Error(t, err, "tracking ref should be gone after prune")
+		// #nosec G204 - tmpDir is from t.TempDir(), safe for test use
+		require.NoError(t, exec.Command("git", "-C", tmpDir, "rev-parse", "--verify", "refs/remotes/origin/main").Run())
+
+		// Nothing left to prune on a second run
+		results = []RepositoryOperationResult{{Repo: repo}}
+		pruneRemoteBranchesForResults(results)
+		require.NoError(t, results[0].Error)
+		assert.Equal(t, 0, results[0].PrunedRefs)
+	})
+
+	t.Run("skips repositories that failed to update", func(t *testing.T) {
+		results := []RepositoryOperationResult{{
+			Repo:  RepositoryInfo{Name: "broken", Path: t.TempDir(), Remote: "origin"},
+			Error: fmt.Errorf("fetch failed"),
+			Steps: []string{"fetch (failed)"},
+		}}
+		pruneRemoteBranchesForResults(results)
+		assert.Equal(t, []string{"fetch (failed)"}, results[0].Steps)
+		assert.EqualError(t, results[0].Error, "fetch failed")
+	})
+
+	t.Run("reports pruned count in results summary", func(t *testing.T) {
+		var buf bytes.Buffer
+		displayOperationResults(&buf, []RepositoryOperationResult{{
+			Repo:       RepositoryInfo{Name: "repo1"},
+			Steps:      []string{"fetch", "rebase", "prune"},
+			PrunedRefs: 3,
+		}}, false, 0)
+
+		assert.Contains(t, buf.String(), "Pruned: 3 stale remote-tracking ref(s)")
+	})
+}
+
 func TestFetchFromRemote_PermissionErrors(t *testing.T) {
 	t.Run("classifies permission errors", func(t *testing.T) {
 		tmpDir := t.TempDir()
@@ -2656,9 +3899,7 @@ func TestFetchFromRemote_PermissionErrors(t *testing.T) {
 func TestDisplayOperationResults_PartialFailure(t *testing.T) {
 	t.Run("displays recovery guidance for failed repos with rebase", func(t *testing.T) {
 		// Capture output
-		oldStdout := os.Stdout
-		r, w, _ := os.Pipe()
-		os.Stdout = w
+		var buf bytes.Buffer
 
 		results := []RepositoryOperationResult{
 			{
@@ -2675,13 +3916,8 @@ func TestDisplayOperationResults_PartialFailure(t *testing.T) {
 			},
 		}
 
-		displayOperationResults(results)
+		displayOperationResults(&buf, results, false, 0)
 
-		_ = w.Close()
-		os.Stdout = oldStdout
-
-		var buf bytes.Buffer
-		_, _ = buf.ReadFrom(r)
 		output := buf.String()
 
 		assert.Contains(t, output, "repo1")
@@ -2695,6 +3931,69 @@ func TestDisplayOperationResults_PartialFailure(t *testing.T) {
 	})
 }
 
+func TestDisplayOperationResults_GroupsFailuresByCause(t *testing.T) {
+	results := []RepositoryOperationResult{
+		{Repo: RepositoryInfo{Name: "ok", Path: "/path/to/ok"}, Steps: []string{"fetch", "rebase"}},
+		{
+			Repo:         RepositoryInfo{Name: "private", Path: "/path/to/private"},
+			Error:        fmt.Errorf("fetch failed: permission or authentication error"),
+			Unauthorized: true,
+		},
+		{
+			Repo:               RepositoryInfo{Name: "api", Path: "/path/to/api"},
+			Error:              fmt.Errorf("rebase failed: conflicts in main.go"),
+			RebaseAttempted:    true,
+			RebaseHadConflicts: true,
+		},
+		{
+			Repo:               RepositoryInfo{Name: "web", Path: "/path/to/web"},
+			Error:              fmt.Errorf("rebase failed: conflicts in app.ts"),
+			RebaseAttempted:    true,
+			RebaseHadConflicts: true,
+		},
+		{
+			Repo:  RepositoryInfo{Name: "docs", Path: "/path/to/docs"},
+			Error: fmt.Errorf("stash failed: error: Your local changes to the following files would be overwritten"),
+		},
+		{
+			Repo:  RepositoryInfo{Name: "slow", Path: "/path/to/slow"},
+			Error: fmt.Errorf("fetch failed: %w", context.DeadlineExceeded),
+		},
+	}
+
+	t.Run("groups failures by cause with one remediation per cause", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		displayOperationResults(&buf, results, false, 0)
+		output := buf.String()
+
+		assert.Contains(t, output, "Summary: 1 succeeded, 5 failed")
+		assert.Contains(t, output, "Failures by cause: 1 auth, 2 conflict, 1 dirty, 1 timeout\n")
+		assert.Contains(t, output, "  Authentication or access (1): private\n")
+		assert.Contains(t, output, "  Merge conflicts (2): api, web\n    Resolve the conflicts")
+		assert.Contains(t, output, "  Uncommitted changes (1): docs\n")
+		assert.Contains(t, output, "  Timeouts and network errors (1): slow\n")
+		assert.NotContains(t, output, "Other errors")
+
+		auth := strings.Index(output, "Authentication or access (1)")
+		conflict := strings.Index(output, "Merge conflicts (2)")
+		dirty := strings.Index(output, "Uncommitted changes (1)")
+		timeout := strings.Index(output, "Timeouts and network errors (1)")
+		assert.True(t, auth < conflict && conflict < dirty && dirty < timeout, "causes should be reported in a fixed order")
+		assert.Contains(t, output[conflict:dirty], "    api:\n      1. Resolve merge conflicts in /path/to/api")
+	})
+
+	t.Run("classifies each failure", func(t *testing.T) {
+		causes := make([]latestFailureCause, 0, len(results)-1)
+		for _, result := range results[1:] {
+			causes = append(causes, latestFailureCauseOf(result))
+		}
+		assert.Equal(t, []latestFailureCause{latestFailureAuth, latestFailureConflict, latestFailureConflict, latestFailureDirty, latestFailureTimeout}, causes)
+		assert.Equal(t, latestFailureOther, latestFailureCauseOf(RepositoryOperationResult{Error: fmt.Errorf("remote 'origin' does not exist")}))
+		assert.Equal(t, latestFailureNotAttempted, latestFailureCauseOf(RepositoryOperationResult{Error: fmt.Errorf("not attempted"), Skipped: true}))
+	})
+}
+
 func TestOrderRepositoriesByDependencies(t *testing.T) {
 	t.Run("groups repositories by repo_root", func(t *testing.T) {
 		repos := []RepositoryInfo{
@@ -2998,3 +4297,394 @@ func TestResolveTrunkBranchForLatest(t *testing.T) {
 		assert.Equal(t, "main", trunkBranch)
 	})
 }
+
+func TestDisplayOperationSummary(t *testing.T) {
+	t.Run("writes one line per repository", func(t *testing.T) {
+		results := []RepositoryOperationResult{
+			{Repo: RepositoryInfo{Name: "api"}, Steps: []string{"fetch", "rebase"}, CommitsPulled: 2},
+			{Repo: RepositoryInfo{Name: "docs"}, Steps: []string{"fetch", "trunk-update"}, CommitsPulled: 1},
+			{Repo: RepositoryInfo{Name: "cli"}, Steps: []string{"fetch", "rebase"}},
+			{Repo: RepositoryInfo{Name: "lib"}, Steps: []string{"fetch", "rebase (skipped: merged)"}, MergedBranch: "feature-a"},
+			{Repo: RepositoryInfo{Name: "web"}, Error: errors.New("rebase failed: rebase failed due to conflicts"), RebaseHadConflicts: true},
+			{Repo: RepositoryInfo{Name: "infra"}, Error: errors.New("fetch failed: permission denied"), Unauthorized: true},
+			{Repo: RepositoryInfo{Name: "tools"}, Error: errors.New("not attempted"), Skipped: true},
+		}
+
+		var buf bytes.Buffer
+		displayOperationSummary(&buf, results)
+
+		golden := "✓ api (2 commits)\n" +
+			"✓ docs (1 commit)\n" +
+			"✓ cli (up to date)\n" +
+			"✓ lib (already merged)\n" +
+			"✗ web (conflict)\n" +
+			"✗ infra (no access)\n" +
+			"✗ tools (not attempted)\n"
+		assert.Equal(t, golden, buf.String())
+	})
+
+	t.Run("replaces the full report and still fails on any failure", func(t *testing.T) {
+		results := []RepositoryOperationResult{
+			{Repo: RepositoryInfo{Name: "api"}, CommitsPulled: 3},
+			{Repo: RepositoryInfo{Name: "web"}, Error: errors.New("rebase failed due to conflicts"), RebaseHadConflicts: true},
+		}
+		out, err := captureStdout(func() error {
+			return handleUpdateResults(results, latestOutput{Progress: os.Stdout, Summary: true})
+		})
+		require.EqualError(t, err, "some repositories failed to update")
+		assert.Equal(t, "✓ api (3 commits)\n✗ web (conflict)\n", out)
+
+		out, err = captureStdout(func() error {
+			return handleUpdateResults(results[:1], latestOutput{Progress: os.Stdout, Summary: true})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "✓ api (3 commits)\n", out)
+	})
+}
+
+func TestCountIncomingCommits(t *testing.T) {
+	remoteDir := t.TempDir()
+	runGit(t, remoteDir, "init", "--bare")
+
+	tmpDir := t.TempDir()
+	runGit(t, tmpDir, "init")
+	runGit(t, tmpDir, "config", "user.email", "test@example.com")
+	runGit(t, tmpDir, "config", "user.name", "Test User")
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "Initial commit")
+	runGit(t, tmpDir, "branch", "-M", "main")
+	runGit(t, tmpDir, "remote", "add", "origin", remoteDir)
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "Second")
+	runGit(t, tmpDir, "commit", "--allow-empty", "-m", "Third")
+	runGit(t, tmpDir, "push", "origin", "main")
+	runGit(t, tmpDir, "fetch", "origin")
+	runGit(t, tmpDir, "reset", "--hard", "HEAD~2")
+
+	repo := RepositoryInfo{Name: "test", Path: tmpDir, TrunkBranch: "main", Remote: "origin"}
+	assert.Equal(t, 2, countIncomingCommits(repo, true))
+
+	repo.Onto = "origin/main~1"
+	assert.Equal(t, 1, countIncomingCommits(repo, false))
+	assert.Equal(t, 2, countIncomingCommits(repo, true), "--onto does not apply on trunk")
+
+	repo.Remote = "missing"
+	assert.Equal(t, 0, countIncomingCommits(repo, true))
+}
+
+func TestAdvanceMergedWorkItems(t *testing.T) {
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"2_doing/001-login.task.md": listTestWorkItem("001", "Login", "doing", ""),
+		})
+		dir, err := os.Getwd()
+		require.NoError(t, err)
+		cfg := testCfgWithDir(dir)
+		cfg.Workflow = &config.WorkflowConfig{AdvanceOnMerge: "done"}
+		return cfg
+	}
+	mergedResults := func() []RepositoryOperationResult {
+		return []RepositoryOperationResult{
+			{Repo: RepositoryInfo{Name: "api"}, MergedBranch: "001-login"},
+			{Repo: RepositoryInfo{Name: "web"}, MergedBranch: "001-login"},
+		}
+	}
+
+	t.Run("moves the work item of a merged branch once", func(t *testing.T) {
+		cfg := setup(t)
+		results := mergedResults()
+
+		out, _ := captureStdout(func() error {
+			advanceMergedWorkItems(os.Stdout, results, cfg, false)
+			return nil
+		})
+
+		assert.Contains(t, out, "Advanced work item 001 from doing to done (branch 001-login is merged)")
+		assert.Contains(t, results[0].Steps, "advance-on-merge")
+		assert.Empty(t, results[1].Steps)
+		assert.NoFileExists(t, filepath.Join(".work", "2_doing", "001-login.task.md"))
+		assert.Contains(t, mustReadFile(t, filepath.Join(".work", "4_done", "001-login.task.md")), "status: done")
+	})
+
+	t.Run("dry-run previews the transition without moving", func(t *testing.T) {
+		cfg := setup(t)
+		results := mergedResults()
+
+		out, _ := captureStdout(func() error {
+			advanceMergedWorkItems(os.Stdout, results, cfg, true)
+			return nil
+		})
+
+		assert.Contains(t, out, "[DRY RUN] Would advance work item 001 from doing to done (branch 001-login is merged)")
+		assert.Contains(t, out, "[DRY RUN] Update status field: doing -> done")
+		assert.FileExists(t, filepath.Join(".work", "2_doing", "001-login.task.md"))
+		assert.Empty(t, results[0].Steps)
+	})
+
+	t.Run("does nothing when advance_on_merge is not set", func(t *testing.T) {
+		cfg := setup(t)
+		cfg.Workflow = nil
+
+		advanceMergedWorkItems(io.Discard, mergedResults(), cfg, false)
+
+		assert.FileExists(t, filepath.Join(".work", "2_doing", "001-login.task.md"))
+	})
+
+	t.Run("skips failed repositories and branches removed by --cleanup-merged", func(t *testing.T) {
+		cfg := setup(t)
+		results := []RepositoryOperationResult{
+			{Repo: RepositoryInfo{Name: "api"}, MergedBranch: "001-login", Error: errors.New("hook failed")},
+			{Repo: RepositoryInfo{Name: "web"}, MergedBranch: "001-login", Steps: []string{"cleanup-merged"}},
+		}
+
+		out, _ := captureStdout(func() error {
+			advanceMergedWorkItems(os.Stdout, results, cfg, false)
+			return nil
+		})
+
+		assert.Contains(t, out, "run 'kira move 001 done' on trunk")
+		assert.FileExists(t, filepath.Join(".work", "2_doing", "001-login.task.md"))
+	})
+}
+
+func TestParseConflictsBranchChanges(t *testing.T) {
+	// setup merges main into feature so that a.txt and b.txt conflict. The feature branch is
+	// stacked on parent: parent changed b.txt, and feature's own commit changed a.txt.
+	setup := func(t *testing.T) RepositoryInfo {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		dir := t.TempDir()
+		runGit(t, dir, "init", "-b", "main")
+		runGit(t, dir, "config", "user.email", "test@example.com")
+		runGit(t, dir, "config", "user.name", "Test")
+		commitFiles := func(message string, files map[string]string) {
+			for name, content := range files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+			runGit(t, dir, "add", ".")
+			runGit(t, dir, "commit", "-m", message)
+		}
+		commitFiles("initial", map[string]string{"a.txt": "a\n", "b.txt": "b\n"})
+		runGit(t, dir, "checkout", "-b", "parent")
+		commitFiles("parent change", map[string]string{"b.txt": "b parent\n"})
+		runGit(t, dir, "checkout", "-b", "feature")
+		commitFiles("feature change", map[string]string{"a.txt": "a feature\n"})
+		runGit(t, dir, "checkout", "main")
+		commitFiles("main change", map[string]string{"a.txt": "a main\n", "b.txt": "b main\n"})
+		runGit(t, dir, "checkout", "feature")
+		// #nosec G204 - fixed args in a test repository
+		_ = exec.Command("git", "-C", dir, "merge", "main").Run() // conflicts in a.txt and b.txt
+		return RepositoryInfo{Name: "app", Path: dir, Remote: "origin", TrunkBranch: "main"}
+	}
+	modifiedByBranch := func(files []FileConflict) map[string]bool {
+		result := make(map[string]bool)
+		for _, file := range files {
+			result[file.FilePath] = file.ModifiedByBranch
+		}
+		return result
+	}
+
+	t.Run("marks the files the branch changed since the base ref", func(t *testing.T) {
+		repo := setup(t)
+
+		repoConflicts, err := parseConflictsFromRepository(repo, RepositoryStateInfo{Repo: repo, State: StateConflictsExist}, "parent")
+		require.NoError(t, err)
+		require.NotNil(t, repoConflicts)
+
+		assert.Equal(t, map[string]bool{"a.txt": true, "b.txt": false}, modifiedByBranch(repoConflicts.Files))
+		assert.Equal(t, "parent", repoConflicts.BranchBase)
+		assert.Contains(t, formatRepositoryConflicts(*repoConflicts), "File: a.txt (changed by this branch)")
+		assert.Contains(t, formatRepositoryConflicts(*repoConflicts), "File: b.txt\n")
+	})
+
+	t.Run("--since-commit keeps only the files the branch changed", func(t *testing.T) {
+		repo := setup(t)
+		repoConflicts, err := parseConflictsFromRepository(repo, RepositoryStateInfo{Repo: repo, State: StateConflictsExist}, "parent")
+		require.NoError(t, err)
+
+		hidden := filterBranchConflicts(io.Discard, repoConflicts, "parent")
+
+		assert.Equal(t, 1, hidden)
+		require.Len(t, repoConflicts.Files, 1)
+		assert.Equal(t, "a.txt", repoConflicts.Files[0].FilePath)
+	})
+
+	t.Run("leaves files unmarked and unfiltered when the base ref does not exist", func(t *testing.T) {
+		repo := setup(t)
+		repoConflicts, err := parseConflictsFromRepository(repo, RepositoryStateInfo{Repo: repo, State: StateConflictsExist}, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]bool{"a.txt": false, "b.txt": false}, modifiedByBranch(repoConflicts.Files))
+		assert.Empty(t, repoConflicts.BranchBase)
+		out, _ := captureStdout(func() error {
+			assert.Equal(t, 0, filterBranchConflicts(os.Stdout, repoConflicts, "origin/main"))
+			return nil
+		})
+		assert.Contains(t, out, "could not list the files changed since origin/main")
+		assert.Len(t, repoConflicts.Files, 2)
+	})
+}
+
+func TestProcessRepositoryUpdate_submodules(t *testing.T) {
+	// setupRepo creates a clone of a repository pushed to a bare remote. With a submodule, the
+	// clone has the .gitmodules file but the submodule is not checked out yet.
+	setupRepo := func(t *testing.T, withSubmodule bool) string {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		// Submodules are cloned from local paths, which git only allows when configured
+		t.Setenv("GIT_CONFIG_COUNT", "1")
+		t.Setenv("GIT_CONFIG_KEY_0", "protocol.file.allow")
+		t.Setenv("GIT_CONFIG_VALUE_0", "always")
+		commitFile := func(dir, name string) {
+			runGit(t, dir, "init", "-b", "main")
+			runGit(t, dir, "config", "user.email", "test@example.com")
+			runGit(t, dir, "config", "user.name", "Test User")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o600))
+			runGit(t, dir, "add", name)
+			runGit(t, dir, "commit", "-m", name)
+		}
+
+		sourceDir := t.TempDir()
+		commitFile(sourceDir, "base")
+		if withSubmodule {
+			libDir := t.TempDir()
+			commitFile(libDir, "lib.txt")
+			runGit(t, sourceDir, "submodule", "add", libDir, "lib")
+			runGit(t, sourceDir, "commit", "-m", "add lib")
+		}
+		remoteDir := t.TempDir()
+		runGit(t, sourceDir, "init", "--bare", remoteDir)
+		runGit(t, sourceDir, "remote", "add", "origin", remoteDir)
+		runGit(t, sourceDir, "push", "-u", "origin", "main")
+
+		cloneDir := filepath.Join(t.TempDir(), "clone")
+		runGit(t, sourceDir, "clone", "-b", "main", remoteDir, cloneDir)
+		return cloneDir
+	}
+	update := func(repo RepositoryInfo) RepositoryOperationResult {
+		var mu sync.Mutex
+		var result RepositoryOperationResult
+		_, _ = captureStdout(func() error {
+			result = processRepositoryUpdate(os.Stdout, repo, false, false, &mu)
+			return nil
+		})
+		return result
+	}
+
+	t.Run("updates the submodules after the update", func(t *testing.T) {
+		cloneDir := setupRepo(t, true)
+		assert.NoFileExists(t, filepath.Join(cloneDir, "lib", "lib.txt"))
+
+		result := update(RepositoryInfo{Name: "api", Path: cloneDir, TrunkBranch: "main", Remote: "origin", UpdateSubmodules: true})
+
+		require.NoError(t, result.Error)
+		assert.Contains(t, result.Steps, "submodule-update")
+		assert.FileExists(t, filepath.Join(cloneDir, "lib", "lib.txt"))
+	})
+
+	t.Run("leaves the submodules alone when disabled", func(t *testing.T) {
+		cloneDir := setupRepo(t, true)
+
+		result := update(RepositoryInfo{Name: "api", Path: cloneDir, TrunkBranch: "main", Remote: "origin"})
+
+		require.NoError(t, result.Error)
+		assert.NotContains(t, result.Steps, "submodule-update")
+		assert.NoFileExists(t, filepath.Join(cloneDir, "lib", "lib.txt"))
+	})
+
+	t.Run("skips repositories without submodules", func(t *testing.T) {
+		cloneDir := setupRepo(t, false)
+
+		result := update(RepositoryInfo{Name: "api", Path: cloneDir, TrunkBranch: "main", Remote: "origin", UpdateSubmodules: true})
+
+		require.NoError(t, result.Error)
+		assert.NotContains(t, result.Steps, "submodule-update")
+	})
+}
+
+func TestLatestUnpushedCommits(t *testing.T) {
+	setup := func(t *testing.T) RepositoryInfo {
+		t.Helper()
+		remoteDir := t.TempDir()
+		runGit(t, "", "init", "--bare", remoteDir)
+		repoDir := filepath.Join(t.TempDir(), "repo")
+		runGit(t, "", "clone", remoteDir, repoDir)
+		runGit(t, repoDir, "config", "user.email", "test@example.com")
+		runGit(t, repoDir, "config", "user.name", "Test User")
+		runGit(t, repoDir, "checkout", "-b", "main")
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, "f"), []byte("a"), 0o600))
+		runGit(t, repoDir, "add", "f")
+		runGit(t, repoDir, "commit", "-m", "A")
+		runGit(t, repoDir, "push", "-u", "origin", "main")
+		return RepositoryInfo{Name: "api", Path: repoDir, TrunkBranch: "main", Remote: "origin"}
+	}
+	commit := func(t *testing.T, repo RepositoryInfo, content string) {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(repo.Path, "f"), []byte(content), 0o600))
+		runGit(t, repo.Path, "commit", "-am", content)
+	}
+
+	t.Run("reports a clean repository with unpushed commits as ready and ahead", func(t *testing.T) {
+		repo := setup(t)
+		commit(t, repo, "b")
+		commit(t, repo, "c")
+
+		stateInfo, err := checkRepositoryState(repo)
+		require.NoError(t, err)
+		assert.Equal(t, StateReadyForUpdate, stateInfo.State)
+		assert.Equal(t, 2, stateInfo.Unpushed)
+		assert.Equal(t, "origin/main", stateInfo.Upstream)
+
+		aggregated := aggregateRepositoryStates([]RepositoryStateInfo{stateInfo})
+		assert.Equal(t, StateReadyForUpdate, aggregated.OverallState)
+		assert.Equal(t, []string{"api"}, aggregated.UnpushedRepos)
+
+		out, err := captureStdout(func() error {
+			displayStateSummary(os.Stdout, []RepositoryStateInfo{stateInfo}, aggregated)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "[2 unpushed commits on origin/main]")
+		assert.Contains(t, out, "Repositories with unpushed commits: api")
+	})
+
+	t.Run("counts nothing when the branch is pushed", func(t *testing.T) {
+		repo := setup(t)
+
+		stateInfo, err := checkRepositoryState(repo)
+		require.NoError(t, err)
+		assert.Equal(t, 0, stateInfo.Unpushed)
+		assert.Empty(t, aggregateRepositoryStates([]RepositoryStateInfo{stateInfo}).UnpushedRepos)
+	})
+
+	t.Run("stops before updating only with --warn-unpushed", func(t *testing.T) {
+		repo := setup(t)
+		commit(t, repo, "b")
+		stateInfo, err := checkRepositoryState(repo)
+		require.NoError(t, err)
+		aggregated := aggregateRepositoryStates([]RepositoryStateInfo{stateInfo})
+
+		require.NoError(t, validateLatestPreflight(aggregated, latestCmd))
+
+		require.NoError(t, latestCmd.Flags().Set("warn-unpushed", "true"))
+		t.Cleanup(func() {
+			flag := latestCmd.Flags().Lookup("warn-unpushed")
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		})
+		err = validateLatestPreflight(aggregated, latestCmd)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "api: 1 unpushed commit on origin/main")
+	})
+
+	t.Run("reminds about the commits left to push after updating", func(t *testing.T) {
+		repo := setup(t)
+		commit(t, repo, "b")
+
+		out, err := captureStdout(func() error {
+			displayUnpushedAfterUpdate(os.Stdout, []RepositoryOperationResult{{Repo: repo}})
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "Note: api has 1 unpushed commit on origin/main")
+	})
+}
@@ -0,0 +1,88 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// countUnpushedCommits returns how many commits of the current branch are not on its upstream
+// (git rev-list --count @{upstream}..HEAD), and the upstream. A branch without an upstream, or a
+// detached HEAD, has nothing to compare against and counts 0.
+func countUnpushedCommits(ctx context.Context, repo RepositoryInfo) (int, string) {
+	upstream, err := executeCommand(ctx, "git", []string{"rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{upstream}"}, repo.Path, false)
+	if err != nil {
+		return 0, ""
+	}
+	output, err := executeCommand(ctx, "git", []string{"rev-list", "--count", "@{upstream}..HEAD"}, repo.Path, false)
+	if err != nil {
+		return 0, ""
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, ""
+	}
+	return count, strings.TrimSpace(upstream)
+}
+
+// formatUnpushedCommits formats an unpushed commit count, e.g. "2 unpushed commits on origin/feature".
+func formatUnpushedCommits(count int, upstream string) string {
+	noun := "commits"
+	if count == 1 {
+		noun = "commit"
+	}
+	return fmt.Sprintf("%d unpushed %s on %s", count, noun, upstream)
+}
+
+// validateLatestPreflight checks that the repositories can be updated: none is in a blocking
+// state, --onto and --old-base are usable and, with --warn-unpushed, none has commits that are
+// not on its upstream.
+func validateLatestPreflight(aggregated AggregatedState, cmd *cobra.Command) error {
+	if err := validateAllReposCleanOrDirtyForUpdate(aggregated); err != nil {
+		return err
+	}
+	if err := validateLatestFailureMode(cmd); err != nil {
+		return err
+	}
+	if err := validateLatestOnto(cmd, len(aggregated.StateInfos)); err != nil {
+		return err
+	}
+	warnUnpushed := false
+	if cmd != nil {
+		warnUnpushed, _ = cmd.Flags().GetBool("warn-unpushed")
+	}
+	if !warnUnpushed || len(aggregated.UnpushedRepos) == 0 {
+		return nil
+	}
+
+	var msg strings.Builder
+	msg.WriteString("cannot proceed with update: repositories have unpushed commits (--warn-unpushed):\n")
+	for _, stateInfo := range aggregated.StateInfos {
+		if stateInfo.Unpushed > 0 {
+			fmt.Fprintf(&msg, "  - %s: %s\n", stateInfo.Repo.Name, formatUnpushedCommits(stateInfo.Unpushed, stateInfo.Upstream))
+		}
+	}
+	msg.WriteString("\nPush them first, or run without --warn-unpushed to update anyway")
+	return fmt.Errorf("%s", msg.String())
+}
+
+// displayUnpushedAfterUpdate reminds about the commits each updated repository still has to
+// push. After a rebase they differ from the upstream and need git push --force-with-lease.
+func displayUnpushedAfterUpdate(out io.Writer, results []RepositoryOperationResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	for _, result := range results {
+		if result.Error != nil || result.Skipped || containsString(result.Steps, "cleanup-merged") {
+			continue
+		}
+		if count, upstream := countUnpushedCommits(ctx, result.Repo); count > 0 {
+			_, _ = fmt.Fprintf(out, "Note: %s has %s; push them (git push --force-with-lease after a rebase)\n", result.Repo.Name, formatUnpushedCommits(count, upstream))
+		}
+	}
+}
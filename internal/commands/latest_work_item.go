@@ -0,0 +1,54 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"kira/internal/config"
+)
+
+// latestWorkItem is the work item kira latest updated repositories for: the one whose branch
+// ({id}-{kebab-title}) is checked out.
+type latestWorkItem struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Kind   string `json:"kind"`
+	Branch string `json:"branch"`
+	Path   string `json:"path"`
+}
+
+// currentLatestWorkItem returns the work item whose branch is checked out, or nil when the
+// current branch is not a work item branch or its work item cannot be found or read.
+func currentLatestWorkItem(cfg *config.Config) *latestWorkItem {
+	_, branch, id, err := getCurrentBranchAndWorkItemID(cfg)
+	if err != nil {
+		return nil
+	}
+	path, err := findWorkItemFileInAllStatusFolders(id, cfg)
+	if err != nil {
+		return nil
+	}
+	kind, _, title, status, _, err := extractWorkItemMetadata(path, cfg)
+	if err != nil {
+		return nil
+	}
+	return &latestWorkItem{ID: id, Title: title, Status: status, Kind: kind, Branch: branch, Path: path}
+}
+
+// displayLatestWorkItemSummary writes the closing line tying the repository results to the work
+// item they were for, e.g. `Work item 012 "Add login" (branch 012-add-login): 2 of 3 repositories
+// updated`. Nothing is written without a work item.
+func displayLatestWorkItemSummary(out io.Writer, item *latestWorkItem, results []RepositoryOperationResult) {
+	if item == nil {
+		return
+	}
+	updated := 0
+	for _, result := range results {
+		if result.Error == nil {
+			updated++
+		}
+	}
+	_, _ = fmt.Fprintf(out, "\nWork item %s %q (branch %s): %d of %d repositories updated\n", item.ID, item.Title, item.Branch, updated, len(results))
+}
@@ -0,0 +1,78 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitDirPrefix starts the single line of the .git file of a linked worktree.
+const gitDirPrefix = "gitdir:"
+
+// checkWorktreeRegistration returns an error explaining how to recover when repoPath is a linked
+// worktree whose registration in the main repository is stale: the registration was removed
+// (e.g. by 'git worktree prune' or a re-cloned main repository) or points at another directory
+// because the worktree was moved, which 'git worktree list' reports as prunable. Git commands in
+// such a worktree fail with errors that do not mention the cause. Returns nil for regular
+// repositories and healthy worktrees.
+func checkWorktreeRegistration(repoPath string) error {
+	gitDir, ok := linkedWorktreeGitDir(repoPath)
+	if !ok {
+		return nil
+	}
+
+	if _, err := os.Stat(gitDir); err != nil {
+		return fmt.Errorf("%s is a git worktree whose registration no longer exists (%s is missing); "+
+			"run 'git worktree prune' in the main repository and recreate the worktree with 'git worktree add', "+
+			"or run kira latest from the main repository", repoPath, gitDir)
+	}
+
+	// #nosec G304 - gitDir comes from the worktree's own .git file
+	content, err := os.ReadFile(filepath.Join(gitDir, "gitdir"))
+	if err != nil {
+		return nil
+	}
+	registered := strings.TrimSpace(string(content))
+	if !samePath(registered, filepath.Join(repoPath, ".git")) {
+		return fmt.Errorf("%s is a git worktree registered at %s, so git considers it prunable; "+
+			"run 'git worktree repair' in %s before running kira latest again", repoPath, filepath.Dir(registered), repoPath)
+	}
+	return nil
+}
+
+// linkedWorktreeGitDir returns the git directory named by the .git file of a linked worktree.
+// Reports false when repoPath has a .git directory (or no readable .git file).
+func linkedWorktreeGitDir(repoPath string) (string, bool) {
+	gitFile := filepath.Join(repoPath, ".git")
+	info, err := os.Stat(gitFile)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", false
+	}
+	// #nosec G304 - gitFile is the .git file of a repository discovered by kira latest
+	content, err := os.ReadFile(gitFile)
+	if err != nil {
+		return "", false
+	}
+	line := strings.TrimSpace(strings.SplitN(string(content), "\n", 2)[0])
+	if !strings.HasPrefix(line, gitDirPrefix) {
+		return "", false
+	}
+	gitDir := strings.TrimSpace(strings.TrimPrefix(line, gitDirPrefix))
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(repoPath, gitDir)
+	}
+	return filepath.Clean(gitDir), true
+}
+
+// samePath reports whether a and b name the same location, resolving symlinks where possible.
+func samePath(a, b string) bool {
+	if resolved, err := filepath.EvalSymlinks(a); err == nil {
+		a = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(b); err == nil {
+		b = resolved
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
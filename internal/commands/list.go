@@ -0,0 +1,539 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+	"kira/internal/validation"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List work items",
+	Long: `Lists work items across the configured status folders.
+
+With --wide, created, updated and path columns are added. Timestamps are formatted
+with list.timestamp_format (a Go time layout, default 2006-01-02); missing ones show "-".
+
+Statuses listed in archived_statuses (kira.yml) are left out unless
+--include-archived is given or --status names one.
+
+With --stale, only work items whose last update (the updated field, falling back
+to created) is older than the given duration are listed, oldest first. Work items
+without either timestamp are listed at the end as "unknown age".
+
+With --overdue, only work items whose due date (the due field, e.g. set by kira assign
+--due) is before today and whose status is not a terminal status are listed, most
+overdue first, with a DUE column.
+
+With --format, each work item is rendered with a Go text/template instead of the
+columns, one line per work item. The template sees the front matter fields by name
+(lists comma-joined, dates as 2006-01-02) and .status, the status of the folder the
+work item is in; fields a work item lacks render as "". The template is checked before
+any work item is read.
+
+The ASSIGNEE column shows the assigned field (or the field assignment.field_defaults
+gives the work item's kind). --assignee keeps the work items assigned to a user, by email
+or by the name kira users knows, and --kind the work items of one kind. --sort orders
+by id, title, status, kind, assignee, created, updated (falling back to created) or due;
+work items without the date come last. --output json is the same as --json.
+
+Examples:
+  kira list                            # All work items
+  kira list --status todo              # Only todo work items
+  kira list --stale 30d                # Not updated in the last 30 days
+  kira list --status todo --stale 2w   # Stale todos
+  kira list --wide                     # Add created, updated and path columns
+  kira list --include-archived         # Also list archived_statuses
+  kira list --overdue                  # Open work items past their due date
+  kira list --assignee alice@example.com --kind prd  # Alice's PRDs
+  kira list --sort created             # Oldest first
+  kira list --stale 30d --json         # Machine-readable output
+  kira list --output json              # Same as --json
+  kira list --format '{{.id}} {{.assigned}}'  # Custom line per work item`,
+	Args: cobra.NoArgs,
+	RunE: runList,
+}
+
+func init() {
+	listCmd.Flags().String("status", "", "Only list work items in this status (e.g. todo)")
+	listCmd.Flags().String("stale", "", "Only list work items not updated within this duration (e.g. 30d, 2w, 12h), oldest first")
+	listCmd.Flags().Bool("json", false, "Output as JSON")
+	listCmd.Flags().Bool("wide", false, "Add created, updated and path columns")
+	listCmd.Flags().Bool("include-archived", false, "Also list work items in archived_statuses")
+	listCmd.Flags().Bool("overdue", false, "Only list open work items whose due date is in the past, most overdue first")
+	listCmd.Flags().String("format", "", "Render each work item with a Go template, e.g. '{{.id}} {{.assigned}}'")
+	listCmd.Flags().String("assignee", "", "Only list work items assigned to this user (email or name)")
+	listCmd.Flags().String("kind", "", "Only list work items of this kind (e.g. prd)")
+	listCmd.Flags().String("sort", "", "Sort by id, title, status, kind, assignee, created, updated or due")
+	listCmd.Flags().String("output", outputFormatText, "Output format: text or json")
+}
+
+// defaultListTimestampFormat is the layout for created/updated columns when list.timestamp_format is unset.
+const defaultListTimestampFormat = "2006-01-02"
+
+// listedWorkItem is a single work item as shown by kira list.
+type listedWorkItem struct {
+	ID          string
+	Title       string
+	Status      string
+	Kind        string
+	Assignees   []string
+	Path        string
+	Created     *time.Time // nil when not set or not parseable
+	Updated     *time.Time // nil when not set or not parseable
+	LastUpdated *time.Time // updated, falling back to created; nil when neither is set or parseable
+	Due         *time.Time // nil when not set or not parseable
+	FrontMatter map[string]interface{}
+}
+
+// listColumns selects the optional columns of kira list.
+type listColumns struct {
+	Age             bool
+	Due             bool
+	Wide            bool
+	TimestampFormat string
+}
+
+func runList(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	status, _ := cmd.Flags().GetString("status")
+	stale, _ := cmd.Flags().GetString("stale")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	wide, _ := cmd.Flags().GetBool("wide")
+	includeArchived, _ := cmd.Flags().GetBool("include-archived")
+	overdue, _ := cmd.Flags().GetBool("overdue")
+	format, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+	sortKey, _ := cmd.Flags().GetString("sort")
+	var filters listFilters
+	filters.Assignee, _ = cmd.Flags().GetString("assignee")
+	filters.Kind, _ = cmd.Flags().GetString("kind")
+
+	jsonOutput, err = resolveJSONOutput(output, jsonOutput)
+	if err != nil {
+		return err
+	}
+	if err := validateListSort(sortKey); err != nil {
+		return err
+	}
+	formatTemplate, err := parseListFormat(format, jsonOutput, wide)
+	if err != nil {
+		return err
+	}
+	if err := validateListStatus(status, cfg); err != nil {
+		return err
+	}
+	var staleAfter time.Duration
+	if stale != "" {
+		staleAfter, err = parseAgeDuration(stale)
+		if err != nil {
+			return err
+		}
+	}
+
+	items, err := collectListedWorkItems(cfg, status, includeArchived)
+	if err != nil {
+		return err
+	}
+	items = filterListedWorkItems(items, filters, cfg)
+
+	now := time.Now()
+	if stale != "" {
+		items = filterStaleWorkItems(items, staleAfter, now)
+	}
+	if overdue {
+		items = filterOverdueWorkItems(items, now, cfg)
+	}
+	sortListedWorkItems(items, sortKey, cfg)
+
+	if formatTemplate != nil {
+		return displayListedWorkItemsFormat(os.Stdout, items, formatTemplate)
+	}
+	if jsonOutput {
+		return displayListedWorkItemsJSON(items, now)
+	}
+	return displayListedWorkItems(items, listColumns{Age: stale != "", Due: overdue, Wide: wide, TimestampFormat: listTimestampFormat(cfg)}, now)
+}
+
+// listTimestampFormat returns list.timestamp_format, or the default layout when unset.
+func listTimestampFormat(cfg *config.Config) string {
+	if cfg.List != nil && cfg.List.TimestampFormat != "" {
+		return cfg.List.TimestampFormat
+	}
+	return defaultListTimestampFormat
+}
+
+// validateListStatus checks that a --status value is a configured status.
+func validateListStatus(status string, cfg *config.Config) error {
+	if status == "" {
+		return nil
+	}
+	if _, ok := cfg.StatusFolders[status]; ok {
+		return nil
+	}
+	validStatuses := make([]string, 0, len(cfg.StatusFolders))
+	for s := range cfg.StatusFolders {
+		validStatuses = append(validStatuses, s)
+	}
+	sort.Strings(validStatuses)
+	return fmt.Errorf("invalid status '%s': status must be one of: %s", status, strings.Join(validStatuses, ", "))
+}
+
+// parseAgeDuration parses an age such as 30d, 2w or 12h. Days (d) and weeks (w) are
+// supported in addition to Go duration syntax.
+func parseAgeDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	var d time.Duration
+	switch {
+	case strings.HasSuffix(value, "d"), strings.HasSuffix(value, "w"):
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': use e.g. 30d, 2w or 12h", value)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+		if strings.HasSuffix(value, "w") {
+			d *= 7
+		}
+	default:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': use e.g. 30d, 2w or 12h", value)
+		}
+		d = parsed
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid duration '%s': must be greater than zero", value)
+	}
+	return d, nil
+}
+
+// collectListedWorkItems reads work items from the status folders (optionally only one status),
+// ordered by status folder and then by ID. Statuses in archived_statuses are skipped unless
+// includeArchived is set or status names one explicitly.
+func collectListedWorkItems(cfg *config.Config, status string, includeArchived bool) ([]listedWorkItem, error) {
+	var items []listedWorkItem
+	for _, s := range orderedStatuses(cfg, status) {
+		if status == "" && !includeArchived && config.IsArchivedStatus(cfg, s) {
+			continue
+		}
+		paths, err := statusWorkItemFiles(cfg, s)
+		if err != nil {
+			return nil, err
+		}
+
+		var statusItems []listedWorkItem
+		for _, path := range paths {
+			item, err := readListedWorkItem(path, s, cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", path, err)
+				continue
+			}
+			statusItems = append(statusItems, item)
+		}
+
+		sort.SliceStable(statusItems, func(i, j int) bool {
+			return statusItems[i].ID < statusItems[j].ID
+		})
+		items = append(items, statusItems...)
+	}
+	return items, nil
+}
+
+// orderedStatuses returns the configured statuses (or only status, when set) in status folder order.
+func orderedStatuses(cfg *config.Config, status string) []string {
+	statuses := make([]string, 0, len(cfg.StatusFolders))
+	for s, folder := range cfg.StatusFolders {
+		if folder != "" && (status == "" || s == status) {
+			statuses = append(statuses, s)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return cfg.StatusFolders[statuses[i]] < cfg.StatusFolders[statuses[j]]
+	})
+	return statuses
+}
+
+// statusWorkItemFiles returns the work item files under a status folder, in walk (lexical) order.
+// Subfolders are scanned up to discovery.max_depth levels (default: the status folder only);
+// hidden folders and folders of other statuses nested in this one are skipped. A missing status
+// folder has no work items.
+func statusWorkItemFiles(cfg *config.Config, status string) ([]string, error) {
+	workFolder := config.GetWorkFolderPath(cfg)
+	statusPath := filepath.Join(workFolder, cfg.StatusFolders[status])
+	if _, err := os.Stat(statusPath); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	paths, err := validation.StatusWorkItemFiles(cfg, workFolder, statusPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read work items in %s: %w", statusPath, err)
+	}
+	return paths, nil
+}
+
+// readListedWorkItem reads the list fields of a single work item file.
+func readListedWorkItem(path, status string, cfg *config.Config) (listedWorkItem, error) {
+	frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		return listedWorkItem{}, err
+	}
+
+	item := listedWorkItem{
+		ID:          frontMatterIDString(frontMatter["id"]),
+		Title:       frontMatterString(frontMatter["title"]),
+		Status:      status,
+		Kind:        frontMatterString(frontMatter["kind"]),
+		Assignees:   listAssignees(frontMatter, cfg),
+		Path:        path,
+		FrontMatter: frontMatter,
+	}
+	if t, ok := parseWorkItemTimestamp(frontMatter["created"]); ok {
+		item.Created = &t
+	}
+	if t, ok := parseWorkItemTimestamp(frontMatter["updated"]); ok {
+		item.Updated = &t
+	}
+	item.LastUpdated = item.Updated
+	if item.LastUpdated == nil {
+		item.LastUpdated = item.Created
+	}
+	if t, ok := parseWorkItemTimestamp(frontMatter[dueField]); ok {
+		item.Due = &t
+	}
+	return item, nil
+}
+
+// frontMatterString returns a scalar front matter value as a string ("" when missing).
+func frontMatterString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// frontMatterIDString formats an id value, restoring the zero padding lost by numeric YAML ids.
+// YAML reads 012 as the number 12 but 089 (not octal) as the float 89, so whole floats count too.
+func frontMatterIDString(value interface{}) string {
+	switch n := value.(type) {
+	case int:
+		return fmt.Sprintf("%03d", n)
+	case float64:
+		if n >= 0 && n == math.Trunc(n) {
+			return fmt.Sprintf("%03d", int(n))
+		}
+	}
+	return frontMatterString(value)
+}
+
+// parseWorkItemTimestamp parses a created/updated front matter value. Both full timestamps
+// (as written by kira, e.g. 2024-01-02T15:04:05Z) and plain dates are accepted.
+func parseWorkItemTimestamp(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// filterStaleWorkItems keeps work items last updated more than staleAfter before now, oldest
+// first, followed by work items of unknown age.
+func filterStaleWorkItems(items []listedWorkItem, staleAfter time.Duration, now time.Time) []listedWorkItem {
+	cutoff := now.Add(-staleAfter)
+	var stale, unknown []listedWorkItem
+	for _, item := range items {
+		switch {
+		case item.LastUpdated == nil:
+			unknown = append(unknown, item)
+		case item.LastUpdated.Before(cutoff):
+			stale = append(stale, item)
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].LastUpdated.Before(*stale[j].LastUpdated)
+	})
+	return append(stale, unknown...)
+}
+
+// filterOverdueWorkItems keeps open work items whose due date is before today, most overdue first.
+func filterOverdueWorkItems(items []listedWorkItem, now time.Time, cfg *config.Config) []listedWorkItem {
+	today := now.Format(dueDateLayout)
+	var overdue []listedWorkItem
+	for _, item := range items {
+		if item.Due != nil && item.Due.Format(dueDateLayout) < today && isOpenStatus(item.Status, cfg) {
+			overdue = append(overdue, item)
+		}
+	}
+	sort.SliceStable(overdue, func(i, j int) bool {
+		return overdue[i].Due.Before(*overdue[j].Due)
+	})
+	return overdue
+}
+
+// formatWorkItemAge formats the age of a work item in whole days.
+func formatWorkItemAge(item listedWorkItem, now time.Time) string {
+	if item.LastUpdated == nil {
+		return "unknown age"
+	}
+	return fmt.Sprintf("%dd", int(now.Sub(*item.LastUpdated).Hours()/24))
+}
+
+// formatListTimestamp formats an optional timestamp for a list column ("-" when missing).
+func formatListTimestamp(t *time.Time, layout string) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(layout)
+}
+
+func displayListedWorkItems(items []listedWorkItem, columns listColumns, now time.Time) error {
+	if len(items) == 0 {
+		fmt.Println("No work items found.")
+		return nil
+	}
+
+	header := []string{"ID", "STATUS", "KIND", "ASSIGNEE"}
+	if columns.Age {
+		header = append(header, "AGE")
+	}
+	if columns.Due {
+		header = append(header, "DUE")
+	}
+	if columns.Wide {
+		header = append(header, "CREATED", "UPDATED")
+	}
+	header = append(header, "TITLE")
+	if columns.Wide {
+		header = append(header, "PATH")
+	}
+
+	rows := [][]string{header}
+	for _, item := range items {
+		row := []string{item.ID, item.Status, item.Kind, formatListAssignees(item)}
+		if columns.Age {
+			row = append(row, formatWorkItemAge(item, now))
+		}
+		if columns.Due {
+			row = append(row, formatListTimestamp(item.Due, dueDateLayout))
+		}
+		if columns.Wide {
+			row = append(row, formatListTimestamp(item.Created, columns.TimestampFormat), formatListTimestamp(item.Updated, columns.TimestampFormat))
+		}
+		row = append(row, item.Title)
+		if columns.Wide {
+			row = append(row, item.Path)
+		}
+		rows = append(rows, row)
+	}
+
+	printListRows(rows)
+	return nil
+}
+
+// printListRows prints rows as left-aligned columns separated by two spaces; the last column is not padded.
+func printListRows(rows [][]string) {
+	writeListRows(os.Stdout, rows)
+}
+
+// writeListRows is printListRows writing to out.
+func writeListRows(out io.Writer, rows [][]string) {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	for _, row := range rows {
+		var sb strings.Builder
+		for i, cell := range row {
+			if i == len(row)-1 {
+				sb.WriteString(cell)
+				break
+			}
+			fmt.Fprintf(&sb, "%-*s  ", widths[i], cell)
+		}
+		_, _ = fmt.Fprintln(out, sb.String())
+	}
+}
+
+// formatJSONTimestamp formats an optional timestamp as RFC 3339 (nil stays nil).
+func formatJSONTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+func displayListedWorkItemsJSON(items []listedWorkItem, now time.Time) error {
+	type jsonWorkItem struct {
+		ID          string   `json:"id"`
+		Title       string   `json:"title"`
+		Status      string   `json:"status"`
+		Kind        string   `json:"kind"`
+		Assignees   []string `json:"assignees"`
+		Path        string   `json:"path"`
+		Created     *string  `json:"created"`      // RFC 3339 or null when unknown
+		Updated     *string  `json:"updated"`      // RFC 3339 or null when unknown
+		LastUpdated *string  `json:"last_updated"` // RFC 3339 or null when unknown
+		AgeDays     *int     `json:"age_days"`     // null when unknown
+		Due         *string  `json:"due"`          // RFC 3339 or null when not set
+	}
+
+	jsonItems := make([]jsonWorkItem, len(items))
+	for i, item := range items {
+		jsonItems[i] = jsonWorkItem{
+			ID:        item.ID,
+			Title:     item.Title,
+			Status:    item.Status,
+			Kind:      item.Kind,
+			Assignees: item.Assignees,
+			Path:      item.Path,
+		}
+		if jsonItems[i].Assignees == nil {
+			jsonItems[i].Assignees = []string{}
+		}
+		jsonItems[i].Created = formatJSONTimestamp(item.Created)
+		jsonItems[i].Updated = formatJSONTimestamp(item.Updated)
+		jsonItems[i].Due = formatJSONTimestamp(item.Due)
+		if item.LastUpdated != nil {
+			formatted := item.LastUpdated.Format(time.RFC3339)
+			ageDays := int(now.Sub(*item.LastUpdated).Hours() / 24)
+			jsonItems[i].LastUpdated = &formatted
+			jsonItems[i].AgeDays = &ageDays
+		}
+	}
+
+	output := map[string]interface{}{
+		"work_items": jsonItems,
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
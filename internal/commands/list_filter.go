@@ -0,0 +1,137 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"kira/internal/config"
+)
+
+// listSortKeys are the values of kira list --sort.
+var listSortKeys = []string{"id", "title", "status", "kind", "assignee", "created", "updated", "due"}
+
+// listFilters are the kira list filters on front matter fields.
+type listFilters struct {
+	Assignee string // --assignee: an email or user name, matched case-insensitively
+	Kind     string // --kind
+}
+
+// validateListSort checks a --sort value ("" keeps the default order).
+func validateListSort(key string) error {
+	if key == "" {
+		return nil
+	}
+	for _, valid := range listSortKeys {
+		if key == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid sort key '%s': use one of %s", key, strings.Join(listSortKeys, ", "))
+}
+
+// listAssignees returns the assignees of a work item: the assigned field, or the field
+// assignment.field_defaults gives its kind.
+func listAssignees(frontMatter map[string]interface{}, cfg *config.Config) []string {
+	return workItemAssignees(frontMatter, statsAssigneeField(frontMatter, AssignFlags{Field: "assigned"}, cfg))
+}
+
+// filterListedWorkItems keeps the work items matching --assignee and --kind. An assignee matches
+// by email or by the name kira users knows for that email.
+func filterListedWorkItems(items []listedWorkItem, filters listFilters, cfg *config.Config) []listedWorkItem {
+	if filters.Assignee == "" && filters.Kind == "" {
+		return items
+	}
+	var names map[string]string
+	if filters.Assignee != "" {
+		names = assigneeNames(cfg)
+	}
+	var kept []listedWorkItem
+	for _, item := range items {
+		if filters.Kind != "" && !strings.EqualFold(item.Kind, filters.Kind) {
+			continue
+		}
+		if filters.Assignee != "" && !hasListAssignee(item, filters.Assignee, names) {
+			continue
+		}
+		kept = append(kept, item)
+	}
+	return kept
+}
+
+// hasListAssignee reports whether identifier is one of the work item's assignees.
+func hasListAssignee(item listedWorkItem, identifier string, names map[string]string) bool {
+	for _, assignee := range item.Assignees {
+		if strings.EqualFold(assignee, identifier) || strings.EqualFold(names[strings.ToLower(assignee)], identifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortListedWorkItems sorts work items by a --sort key, keeping the current order for ties.
+// Work items without a created, updated or due date come last.
+func sortListedWorkItems(items []listedWorkItem, key string, cfg *config.Config) {
+	switch key {
+	case "created", "updated", "due":
+		sort.SliceStable(items, func(i, j int) bool {
+			return listTimeBefore(listSortTime(items[i], key), listSortTime(items[j], key))
+		})
+	case "status":
+		sort.SliceStable(items, func(i, j int) bool {
+			return cfg.StatusFolders[items[i].Status] < cfg.StatusFolders[items[j].Status]
+		})
+	case "id", "title", "kind", "assignee":
+		sort.SliceStable(items, func(i, j int) bool {
+			return strings.ToLower(listSortText(items[i], key)) < strings.ToLower(listSortText(items[j], key))
+		})
+	}
+}
+
+// listSortTime returns the timestamp a work item sorts by for key.
+func listSortTime(item listedWorkItem, key string) *time.Time {
+	switch key {
+	case "created":
+		return item.Created
+	case "updated":
+		return item.LastUpdated
+	default:
+		return item.Due
+	}
+}
+
+// listTimeBefore orders timestamps oldest first, with missing ones last.
+func listTimeBefore(a, b *time.Time) bool {
+	switch {
+	case a == nil:
+		return false
+	case b == nil:
+		return true
+	default:
+		return a.Before(*b)
+	}
+}
+
+// listSortText returns the text a work item sorts by for key.
+func listSortText(item listedWorkItem, key string) string {
+	switch key {
+	case "title":
+		return item.Title
+	case "kind":
+		return item.Kind
+	case "assignee":
+		return strings.Join(item.Assignees, ", ")
+	default:
+		return item.ID
+	}
+}
+
+// formatListAssignees formats the assignees of a work item for the ASSIGNEE column ("-" when none).
+func formatListAssignees(item listedWorkItem) string {
+	if len(item.Assignees) == 0 {
+		return "-"
+	}
+	return strings.Join(item.Assignees, ", ")
+}
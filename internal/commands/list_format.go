@@ -0,0 +1,75 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// parseListFormat parses the --format template of kira list, so that a broken template is
+// reported before any work item is read. It returns nil when format is empty.
+func parseListFormat(format string, jsonOutput, wide bool) (*template.Template, error) {
+	if format == "" {
+		return nil, nil
+	}
+	if jsonOutput || wide {
+		return nil, fmt.Errorf("invalid flag combination: --format cannot be used with --json or --wide")
+	}
+	// Fields are strings, so a field a work item does not have renders as ""
+	tmpl, err := template.New("format").Option("missingkey=zero").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// displayListedWorkItemsFormat renders tmpl once per work item, one line each.
+func displayListedWorkItemsFormat(out io.Writer, items []listedWorkItem, tmpl *template.Template) error {
+	for _, item := range items {
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, listFormatFields(item)); err != nil {
+			return fmt.Errorf("failed to render --format for work item %s: %w", item.ID, err)
+		}
+		_, _ = fmt.Fprintln(out, sb.String())
+	}
+	return nil
+}
+
+// listFormatFields returns the fields a --format template sees for a work item: its front matter
+// fields as strings, with lists comma-joined and assignee objects shown by email, and .status
+// set to the status of the folder the work item is in.
+func listFormatFields(item listedWorkItem) map[string]string {
+	fields := make(map[string]string, len(item.FrontMatter)+1)
+	for key, value := range item.FrontMatter {
+		fields[key] = listFormatValue(value)
+	}
+	fields["id"] = item.ID
+	fields["status"] = item.Status
+	return fields
+}
+
+// listFormatValue formats a front matter value for a --format template.
+func listFormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		if v.Equal(v.Truncate(24 * time.Hour)) {
+			return v.Format(dueDateLayout)
+		}
+		return v.Format(time.RFC3339)
+	case []string:
+		return strings.Join(v, ", ")
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, listFormatValue(item))
+		}
+		return strings.Join(values, ", ")
+	default:
+		return assigneeValueString(v)
+	}
+}
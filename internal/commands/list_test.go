@@ -0,0 +1,562 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+// setupListWorkspace creates a kira workspace in a temp dir (and chdirs into it) with the given
+// work item files, keyed by path relative to .work.
+func setupListWorkspace(t *testing.T, files map[string]string) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	origDir, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(tmpDir))
+	t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+	require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\n"), 0o600))
+	for _, folder := range []string{"0_backlog", "1_todo", "2_doing", "3_review", "4_done"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(".work", folder), 0o700))
+	}
+	for path, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(".work", path), []byte(content), 0o600))
+	}
+}
+
+// runListCapture runs kira list with the given flags and returns stdout.
+func runListCapture(t *testing.T, flags map[string]string) string {
+	t.Helper()
+	for name, value := range flags {
+		require.NoError(t, listCmd.Flags().Set(name, value))
+	}
+	t.Cleanup(func() {
+		for name := range flags {
+			flag := listCmd.Flags().Lookup(name)
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+	})
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+	runErr := runList(listCmd, nil)
+	_ = w.Close()
+	os.Stdout = oldStdout
+	require.NoError(t, runErr)
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	require.NoError(t, err)
+	return buf.String()
+}
+
+func listTestWorkItem(id, title, status, extra string) string {
+	return "---\nid: " + id + "\ntitle: " + title + "\nstatus: " + status + "\nkind: task\n" + extra + "---\n\n# " + title + "\n"
+}
+
+func TestParseAgeDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30d": 30 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+		"90m": 90 * time.Minute,
+	}
+	for input, expected := range cases {
+		d, err := parseAgeDuration(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, d, input)
+	}
+
+	for _, input := range []string{"", "abc", "xd", "0d", "-5d"} {
+		_, err := parseAgeDuration(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseWorkItemTimestamp(t *testing.T) {
+	ts, ok := parseWorkItemTimestamp("2024-03-01T10:00:00Z")
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), ts)
+
+	ts, ok = parseWorkItemTimestamp("2024-03-01")
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), ts)
+
+	ts, ok = parseWorkItemTimestamp(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, 2024, ts.Year())
+
+	_, ok = parseWorkItemTimestamp("yesterday")
+	assert.False(t, ok)
+	_, ok = parseWorkItemTimestamp(nil)
+	assert.False(t, ok)
+}
+
+func TestFilterStaleWorkItems(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(days int) *time.Time {
+		ts := now.Add(-time.Duration(days) * 24 * time.Hour)
+		return &ts
+	}
+	items := []listedWorkItem{
+		{ID: "001", LastUpdated: at(40)},
+		{ID: "002", LastUpdated: at(5)},
+		{ID: "003"},
+		{ID: "004", LastUpdated: at(100)},
+	}
+
+	stale := filterStaleWorkItems(items, 30*24*time.Hour, now)
+
+	ids := make([]string, len(stale))
+	for i, item := range stale {
+		ids[i] = item.ID
+	}
+	assert.Equal(t, []string{"004", "001", "003"}, ids, "oldest first, unknown age last")
+	assert.Equal(t, "100d", formatWorkItemAge(stale[0], now))
+	assert.Equal(t, "unknown age", formatWorkItemAge(stale[2], now))
+}
+
+func TestRunList(t *testing.T) {
+	old := time.Now().Add(-60 * 24 * time.Hour).UTC().Format("2006-01-02T15:04:05Z")
+	recent := time.Now().Add(-2 * 24 * time.Hour).UTC().Format("2006-01-02T15:04:05Z")
+	files := map[string]string{
+		"1_todo/001-old-todo.task.md":      listTestWorkItem("001", "Old todo", "todo", "created: 2020-01-01\nupdated: "+old+"\n"),
+		"1_todo/002-fresh-todo.task.md":    listTestWorkItem("002", "Fresh todo", "todo", "created: 2020-01-01\nupdated: "+recent+"\n"),
+		"1_todo/003-no-dates.task.md":      listTestWorkItem("003", "No dates", "todo", ""),
+		"0_backlog/004-ancient.task.md":    listTestWorkItem("004", "Ancient backlog", "backlog", "created: 2019-01-01\n"),
+		"2_doing/005-in-progress.task.md":  listTestWorkItem("005", "In progress", "doing", "updated: "+recent+"\n"),
+		"1_todo/template.task.md":          listTestWorkItem("999", "Template", "todo", ""),
+		"1_todo/006-older-todo.task.md":    listTestWorkItem("006", "Older todo", "todo", "updated: 2021-01-01T00:00:00Z\n"),
+		"0_backlog/007-created-only.md":    listTestWorkItem("007", "Created only", "backlog", "created: "+recent[:10]+"\n"),
+		"3_review/008-review-item.task.md": listTestWorkItem("008", "Review item", "review", ""),
+	}
+
+	t.Run("lists all work items by status folder", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, nil)
+
+		assert.Contains(t, output, "TITLE")
+		assert.NotContains(t, output, "AGE")
+		assert.NotContains(t, output, "Template")
+		assert.Less(t, strings.Index(output, "Ancient backlog"), strings.Index(output, "Old todo"))
+		assert.Less(t, strings.Index(output, "Old todo"), strings.Index(output, "In progress"))
+	})
+
+	t.Run("filters by status", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "doing"})
+
+		assert.Contains(t, output, "In progress")
+		assert.NotContains(t, output, "Old todo")
+	})
+
+	t.Run("stale lists oldest first with unknown age last", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "todo", "stale": "30d"})
+
+		assert.Contains(t, output, "AGE")
+		assert.NotContains(t, output, "Fresh todo")
+		assert.NotContains(t, output, "Ancient backlog")
+		older := strings.Index(output, "Older todo")
+		oldTodo := strings.Index(output, "Old todo")
+		noDates := strings.Index(output, "No dates")
+		require.NotEqual(t, -1, older)
+		require.NotEqual(t, -1, oldTodo)
+		require.NotEqual(t, -1, noDates)
+		assert.Less(t, older, oldTodo)
+		assert.Less(t, oldTodo, noDates)
+		assert.Contains(t, output, "unknown age")
+	})
+
+	t.Run("stale falls back to created", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "backlog", "stale": "30d"})
+
+		assert.Contains(t, output, "Ancient backlog")
+		assert.NotContains(t, output, "Created only")
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "todo", "stale": "30d", "json": "true"})
+
+		var parsed struct {
+			WorkItems []struct {
+				ID          string  `json:"id"`
+				Status      string  `json:"status"`
+				LastUpdated *string `json:"last_updated"`
+				AgeDays     *int    `json:"age_days"`
+			} `json:"work_items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		require.Len(t, parsed.WorkItems, 3)
+		assert.Equal(t, "006", parsed.WorkItems[0].ID)
+		assert.Equal(t, "001", parsed.WorkItems[1].ID)
+		require.NotNil(t, parsed.WorkItems[1].AgeDays)
+		assert.GreaterOrEqual(t, *parsed.WorkItems[1].AgeDays, 59)
+		assert.Equal(t, "003", parsed.WorkItems[2].ID)
+		assert.Nil(t, parsed.WorkItems[2].LastUpdated)
+		assert.Nil(t, parsed.WorkItems[2].AgeDays)
+	})
+
+	t.Run("wide shows created, updated and path columns", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "todo", "wide": "true"})
+
+		assert.Contains(t, output, "CREATED")
+		assert.Contains(t, output, "UPDATED")
+		assert.Contains(t, output, "PATH")
+		assert.Contains(t, output, "2020-01-01")
+		assert.Contains(t, output, "2021-01-01")
+		assert.Contains(t, output, filepath.Join(".work", "1_todo", "003-no-dates.task.md"))
+		for _, line := range strings.Split(output, "\n") {
+			if strings.HasPrefix(line, "003") {
+				assert.Equal(t, 3, strings.Count(line, " - "), "missing assignee and timestamps show as -: %q", line)
+			}
+		}
+	})
+
+	t.Run("json includes created and updated", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"status": "todo", "json": "true"})
+
+		var parsed struct {
+			WorkItems []struct {
+				ID      string  `json:"id"`
+				Created *string `json:"created"`
+				Updated *string `json:"updated"`
+			} `json:"work_items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		byID := map[string]int{}
+		for i, item := range parsed.WorkItems {
+			byID[item.ID] = i
+		}
+		withDates := parsed.WorkItems[byID["001"]]
+		require.NotNil(t, withDates.Created)
+		assert.Equal(t, "2020-01-01T00:00:00Z", *withDates.Created)
+		require.NotNil(t, withDates.Updated)
+		noDates := parsed.WorkItems[byID["003"]]
+		assert.Nil(t, noDates.Created)
+		assert.Nil(t, noDates.Updated)
+	})
+
+	t.Run("wide uses list.timestamp_format", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\nlist:\n  timestamp_format: \"02/01/2006\"\n"), 0o600))
+		output := runListCapture(t, map[string]string{"status": "todo", "wide": "true"})
+
+		assert.Contains(t, output, "01/01/2020")
+		assert.NotContains(t, output, "2020-01-01")
+	})
+
+	t.Run("rejects invalid status and duration", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		require.NoError(t, listCmd.Flags().Set("status", "nope"))
+		err := runList(listCmd, nil)
+		_ = listCmd.Flags().Set("status", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid status 'nope'")
+
+		require.NoError(t, listCmd.Flags().Set("stale", "soon"))
+		err = runList(listCmd, nil)
+		_ = listCmd.Flags().Set("stale", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid duration 'soon'")
+	})
+}
+
+func TestRunListFiltersAndSort(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-login.task.md":   listTestWorkItem("001", "Login", "todo", "assigned: alice@example.com\ncreated: 2024-03-01\n"),
+		"1_todo/002-search.prd.md":   strings.Replace(listTestWorkItem("002", "Search", "todo", "assigned: bob@example.com\ncreated: 2024-01-01\n"), "kind: task", "kind: prd", 1),
+		"2_doing/003-export.prd.md":  strings.Replace(listTestWorkItem("003", "Export", "doing", "assigned: Alice@example.com\ncreated: 2024-02-01\n"), "kind: task", "kind: prd", 1),
+		"2_doing/004-backup.task.md": listTestWorkItem("004", "Backup", "doing", ""),
+	}
+
+	t.Run("shows the assignee column", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, nil)
+
+		assert.Contains(t, output, "ASSIGNEE")
+		assert.Regexp(t, `001\s+todo\s+task\s+alice@example.com\s+Login`, output)
+		assert.Regexp(t, `004\s+doing\s+task\s+-\s+Backup`, output)
+	})
+
+	t.Run("filters by assignee and kind", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"assignee": "alice@example.com"})
+		assert.Contains(t, output, "Login")
+		assert.Contains(t, output, "Export", "emails match case-insensitively")
+		assert.NotContains(t, output, "Search")
+
+		output = runListCapture(t, map[string]string{"assignee": "alice@example.com", "kind": "prd"})
+		assert.Contains(t, output, "Export")
+		assert.NotContains(t, output, "Login")
+	})
+
+	t.Run("matches an assignee by user name", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\nusers:\n  use_git_history: false\n  saved_users:\n    - email: bob@example.com\n      name: Bob Builder\n"), 0o600))
+		output := runListCapture(t, map[string]string{"assignee": "bob builder"})
+		assert.Contains(t, output, "Search")
+		assert.NotContains(t, output, "Login")
+	})
+
+	t.Run("sorts by created with undated work items last", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"sort": "created"})
+		assert.Less(t, strings.Index(output, "Search"), strings.Index(output, "Export"))
+		assert.Less(t, strings.Index(output, "Export"), strings.Index(output, "Login"))
+		assert.Less(t, strings.Index(output, "Login"), strings.Index(output, "Backup"))
+	})
+
+	t.Run("output json is the same as json", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"output": "json", "kind": "prd", "sort": "title"})
+
+		var parsed struct {
+			WorkItems []struct {
+				ID        string   `json:"id"`
+				Assignees []string `json:"assignees"`
+			} `json:"work_items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		require.Len(t, parsed.WorkItems, 2)
+		assert.Equal(t, "003", parsed.WorkItems[0].ID)
+		assert.Equal(t, []string{"Alice@example.com"}, parsed.WorkItems[0].Assignees)
+		assert.Equal(t, "002", parsed.WorkItems[1].ID)
+	})
+
+	t.Run("rejects unknown sort keys and output formats", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		require.NoError(t, listCmd.Flags().Set("sort", "priority"))
+		err := runList(listCmd, nil)
+		_ = listCmd.Flags().Set("sort", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid sort key 'priority'")
+
+		require.NoError(t, listCmd.Flags().Set("output", "yaml"))
+		err = runList(listCmd, nil)
+		_ = listCmd.Flags().Set("output", outputFormatText)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid output format")
+	})
+}
+
+func TestRunListArchivedStatuses(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-open.task.md":     listTestWorkItem("001", "Open item", "todo", ""),
+		"z_archive/002-old.task.md":   listTestWorkItem("002", "Archived item", "archived", ""),
+		"4_done/003-finished.task.md": listTestWorkItem("003", "Finished item", "done", ""),
+		"z_archive/004-older.task.md": listTestWorkItem("004", "Older archived item", "archived", ""),
+	}
+
+	t.Run("leaves archived statuses out by default", func(t *testing.T) {
+		setupListWorkspaceWithArchive(t, files)
+		output := runListCapture(t, nil)
+
+		assert.Contains(t, output, "Open item")
+		assert.Contains(t, output, "Finished item")
+		assert.NotContains(t, output, "Archived item")
+	})
+
+	t.Run("includes them with --include-archived", func(t *testing.T) {
+		setupListWorkspaceWithArchive(t, files)
+		output := runListCapture(t, map[string]string{"include-archived": "true"})
+
+		assert.Contains(t, output, "Open item")
+		assert.Contains(t, output, "Archived item")
+		assert.Contains(t, output, "Older archived item")
+	})
+
+	t.Run("lists them when --status names one", func(t *testing.T) {
+		setupListWorkspaceWithArchive(t, files)
+		output := runListCapture(t, map[string]string{"status": "archived"})
+
+		assert.Contains(t, output, "Archived item")
+		assert.NotContains(t, output, "Open item")
+	})
+}
+
+func TestRunListOverdue(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-late.task.md":      listTestWorkItem("001", "Late item", "todo", "due: 2020-01-10\n"),
+		"2_doing/002-later.task.md":    listTestWorkItem("002", "Very late item", "doing", "due: 2020-01-01\n"),
+		"1_todo/003-future.task.md":    listTestWorkItem("003", "Future item", "todo", "due: 2999-01-01\n"),
+		"4_done/004-shipped.task.md":   listTestWorkItem("004", "Shipped item", "done", "due: 2020-01-01\n"),
+		"0_backlog/005-no-due.task.md": listTestWorkItem("005", "No due item", "backlog", ""),
+	}
+
+	t.Run("lists open items past their due date, most overdue first", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"overdue": "true"})
+
+		lines := strings.Split(strings.TrimSpace(output), "\n")
+		require.Len(t, lines, 3)
+		assert.Contains(t, lines[0], "DUE")
+		assert.Contains(t, lines[1], "Very late item")
+		assert.Contains(t, lines[1], "2020-01-01")
+		assert.Contains(t, lines[2], "Late item")
+	})
+
+	t.Run("includes due in json output", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output := runListCapture(t, map[string]string{"overdue": "true", "json": "true"})
+
+		var result struct {
+			WorkItems []struct {
+				ID  string  `json:"id"`
+				Due *string `json:"due"`
+			} `json:"work_items"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+		require.Len(t, result.WorkItems, 2)
+		assert.Equal(t, "002", result.WorkItems[0].ID)
+		require.NotNil(t, result.WorkItems[0].Due)
+		assert.Equal(t, "2020-01-01T00:00:00Z", *result.WorkItems[0].Due)
+	})
+}
+
+func TestStatusWorkItemFilesDiscoveryDepth(t *testing.T) {
+	setup := func(t *testing.T, kiraYML string) {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{"2_doing/001-top.task.md": listTestWorkItem("001", "Top", "doing", "")})
+		require.NoError(t, os.WriteFile("kira.yml", []byte(kiraYML), 0o600))
+		nested := map[string]string{
+			"2_doing/epic-x/002-nested.task.md":        listTestWorkItem("002", "Nested", "doing", ""),
+			"2_doing/epic-x/part-1/003-deeper.task.md": listTestWorkItem("003", "Deeper", "doing", ""),
+			"2_doing/.drafts/004-hidden.task.md":       listTestWorkItem("004", "Hidden", "doing", ""),
+		}
+		for path, content := range nested {
+			require.NoError(t, os.MkdirAll(filepath.Join(".work", filepath.Dir(path)), 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(".work", path), []byte(content), 0o600))
+		}
+	}
+	titles := func(t *testing.T, cfg *config.Config) []string {
+		t.Helper()
+		items, err := collectListedWorkItems(cfg, "doing", false)
+		require.NoError(t, err)
+		var titles []string
+		for _, item := range items {
+			assert.Equal(t, "doing", item.Status)
+			titles = append(titles, item.Title)
+		}
+		return titles
+	}
+
+	t.Run("scans every level by default but skips hidden folders", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\n")
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Top", "Nested", "Deeper"}, titles(t, cfg))
+	})
+
+	t.Run("only scans the status folder itself with max_depth 1", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\ndiscovery:\n  max_depth: 1\n")
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Top"}, titles(t, cfg))
+
+		_, err = findWorkItemFile("002", cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item with ID 002 not found")
+	})
+
+	t.Run("finds items nested at depth 2 under their top-level status", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\ndiscovery:\n  max_depth: 2\n")
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Top", "Nested"}, titles(t, cfg))
+
+		path, err := findWorkItemFile("002", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "002-nested.task.md", filepath.Base(path))
+		_, err = findWorkItemFile("003", cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("goes deeper with a higher max_depth but skips hidden folders", func(t *testing.T) {
+		setup(t, "version: \"1.0\"\ndiscovery:\n  max_depth: 3\n")
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Top", "Nested", "Deeper"}, titles(t, cfg))
+	})
+
+	t.Run("does not list a nested status folder under its parent status", func(t *testing.T) {
+		setupListWorkspace(t, nil)
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\ndiscovery:\n  max_depth: 2\nstatus_folders:\n  done: 4_done\n  archived: 4_done/archived\n"), 0o600))
+		require.NoError(t, os.MkdirAll(filepath.Join(".work", "4_done", "archived"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(".work", "4_done", "archived", "005-old.task.md"), []byte(listTestWorkItem("005", "Old", "archived", "")), 0o600))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		done, err := statusWorkItemFiles(cfg, "done")
+		require.NoError(t, err)
+		assert.Empty(t, done)
+		archived, err := statusWorkItemFiles(cfg, "archived")
+		require.NoError(t, err)
+		assert.Len(t, archived, 1)
+	})
+}
+
+// setupListWorkspaceWithArchive is setupListWorkspace with a z_archive folder whose status is
+// listed in archived_statuses.
+func setupListWorkspaceWithArchive(t *testing.T, files map[string]string) {
+	t.Helper()
+	setupListWorkspace(t, nil)
+	require.NoError(t, os.MkdirAll(filepath.Join(".work", "z_archive"), 0o700))
+	require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\narchived_statuses: [archived]\n"), 0o600))
+	for path, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(".work", path), []byte(content), 0o600))
+	}
+}
+
+func TestRunListFormat(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-login.task.md":   listTestWorkItem("001", "Login", "todo", "assigned: alice@example.com\ncreated: 2024-01-02\n"),
+		"2_doing/002-search.task.md": listTestWorkItem("002", "Search", "todo", "assigned: [alice@example.com, bob@example.com]\n"),
+	}
+
+	t.Run("renders a line per work item", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		output := runListCapture(t, map[string]string{"format": "{{.id}} {{.status}} {{.assigned}} {{.created}}|{{.missing}}|"})
+
+		assert.Equal(t, "001 todo alice@example.com 2024-01-02||\n002 doing alice@example.com, bob@example.com ||\n", output)
+	})
+
+	t.Run("reports template errors before listing", func(t *testing.T) {
+		setupListWorkspace(t, files)
+
+		require.NoError(t, listCmd.Flags().Set("format", "{{.id"))
+		err := runList(listCmd, nil)
+		_ = listCmd.Flags().Set("format", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --format template")
+	})
+
+	t.Run("rejects --format with --json", func(t *testing.T) {
+		_, err := parseListFormat("{{.id}}", true, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--format cannot be used with --json or --wide")
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -15,13 +16,33 @@ import (
 	yaml "gopkg.in/yaml.v3"
 
 	"kira/internal/config"
+	"kira/internal/validation"
 )
 
 var moveCmd = &cobra.Command{
-	Use:   "move <work-item-id> [target-status]",
-	Short: "Move a work item to a different status folder",
-	Long:  `Moves the work item to the target status folder. Will display options if target status not provided.`,
-	Args:  cobra.RangeArgs(1, 2),
+	Use:   "move <work-item-id>... [target-status]",
+	Short: "Move work items to a different status folder",
+	Long: `Moves the work item to the target status folder. Will display options if target status not provided.
+
+The status and updated fields are set and the file is moved in one step: the new content is
+written to a temporary file in the target folder and renamed into place before the original is
+removed, so a failed move leaves the work item unchanged.
+
+Several work items can be moved at once by listing their IDs before the target status
+(kira move 001 002 done); the last argument is then always the target status. Every ID
+must name a work item, and no file may already have the name a work item gets in the target
+folder, before any is moved. With --commit each move is committed on its own.
+
+When moving to a terminal status (terminal_statuses in kira.yml, default done, released
+and abandoned), --ready-pr marks the pull request of the work item's branch ({id}-*)
+ready for review and --close-pr closes it (requires KIRA_GITHUB_TOKEN).
+If the remote is not GitHub, no token is set or no pull request is found, the
+work item is still moved and a note is printed.
+
+With naming.per_folder_ids in kira.yml, IDs are numbered per status folder: the moved work
+item takes the next free ID of the target folder, and its file and id field are renamed.`,
+	Args:              cobra.MinimumNArgs(1),
+	ValidArgsFunction: completeFirstWorkItemID,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cfg, err := config.LoadConfig()
 		if err != nil {
@@ -31,21 +52,161 @@ var moveCmd = &cobra.Command{
 			return err
 		}
 
-		workItemID := args[0]
-		var targetStatus string
-		if len(args) > 1 {
-			targetStatus = args[1]
-		}
+		workItemIDs, targetStatus := parseMoveArgs(args)
 
 		commitFlag, _ := cmd.Flags().GetBool("commit")
 		dryRunFlag, _ := cmd.Flags().GetBool("dry-run")
-		return moveWorkItem(cfg, workItemID, targetStatus, commitFlag, dryRunFlag, nil)
+		startFlag, _ := cmd.Flags().GetBool("start")
+		prAction, err := movePRActionFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		if prAction != movePRActionNone && targetStatus == "" {
+			return fmt.Errorf("target status must be provided when using --%s-pr", prAction)
+		}
+		if err := validateMovePRAction(prAction, targetStatus, cfg); err != nil {
+			return err
+		}
+		if startFlag {
+			if len(workItemIDs) > 1 {
+				return fmt.Errorf("invalid flag combination: --start moves a single work item")
+			}
+			return moveAndStartWorkItem(cfg, workItemIDs[0], targetStatus, commitFlag, dryRunFlag)
+		}
+		return moveWorkItems(cfg, workItemIDs, targetStatus, commitFlag, dryRunFlag, prAction, cmd.OutOrStdout())
 	},
 }
 
+// parseMoveArgs splits the arguments of kira move into work item IDs and the target status: the
+// last argument is the target status when there are two or more. Repeated IDs are moved once.
+func parseMoveArgs(args []string) (workItemIDs []string, targetStatus string) {
+	if len(args) == 1 {
+		return args, ""
+	}
+	seen := make(map[string]bool)
+	for _, id := range args[:len(args)-1] {
+		if !seen[id] {
+			seen[id] = true
+			workItemIDs = append(workItemIDs, id)
+		}
+	}
+	return workItemIDs, args[len(args)-1]
+}
+
+// moveWorkItems moves each work item to targetStatus in turn, applying the --ready-pr or
+// --close-pr action after each move. All IDs are resolved and every target checked first, so a
+// typo or a taken file name moves nothing; a move that still fails stops the ones after it.
+func moveWorkItems(cfg *config.Config, workItemIDs []string, targetStatus string, commitFlag, dryRun bool, prAction movePRAction, out io.Writer) error {
+	if len(workItemIDs) > 1 {
+		paths := make([]string, 0, len(workItemIDs))
+		for _, id := range workItemIDs {
+			path, err := findWorkItemFile(id, cfg)
+			if err != nil {
+				return fmt.Errorf("no work items were moved: %w", err)
+			}
+			paths = append(paths, path)
+		}
+		if err := checkMoveTargets(cfg, paths, targetStatus); err != nil {
+			return fmt.Errorf("no work items were moved: %w", err)
+		}
+	}
+	for i, id := range workItemIDs {
+		prWorkItemID, err := movePRWorkItemID(cfg, id, targetStatus, prAction)
+		if err != nil {
+			return err
+		}
+		if err := moveWorkItem(cfg, id, targetStatus, commitFlag, dryRun, nil); err != nil {
+			if i > 0 {
+				return fmt.Errorf("moved %d of %d work items, stopped at %s: %w", i, len(workItemIDs), id, err)
+			}
+			return err
+		}
+		if err := applyMovePRAction(cfg, prWorkItemID, prAction, dryRun, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// movePRWorkItemID returns the ID the work item has once moved to targetStatus, which the
+// --ready-pr and --close-pr actions look up the pull request by. It is resolved before the move
+// so the dry run and the real move agree; with naming.per_folder_ids it is the new ID.
+func movePRWorkItemID(cfg *config.Config, workItemID, targetStatus string, prAction movePRAction) (string, error) {
+	if prAction == movePRActionNone {
+		return workItemID, nil
+	}
+	workItemPath, err := findWorkItemFile(workItemID, cfg)
+	if err != nil {
+		return "", err
+	}
+	return movedWorkItemID(cfg, workItemPath, targetStatus, workItemID)
+}
+
+// checkMoveTargets checks that each work item in paths can move to targetStatus: the status
+// must be a status folder, and no file may already have the name a work item gets there. With
+// naming.per_folder_ids the work items take the next free IDs of the target folder in order.
+func checkMoveTargets(cfg *config.Config, paths []string, targetStatus string) error {
+	folder, exists := cfg.StatusFolders[targetStatus]
+	if !exists {
+		return fmt.Errorf("invalid target status: %s", targetStatus)
+	}
+	targetFolder := filepath.Join(config.GetWorkFolderPath(cfg), folder)
+	nextID, err := firstFolderID(cfg, targetFolder)
+	if err != nil {
+		return err
+	}
+
+	taken := make(map[string]string)
+	for _, path := range paths {
+		if sameFolder(filepath.Dir(path), targetFolder) {
+			continue
+		}
+		filename := filepath.Base(path)
+		if nextID != nil {
+			filename = renumberedFilename(filename, fmt.Sprintf("%0*d", nextID.width, nextID.value))
+			nextID.value++
+		}
+		targetPath := filepath.Join(targetFolder, filename)
+		if other, ok := taken[targetPath]; ok {
+			return fmt.Errorf("%s and %s would both move to %s", other, path, targetPath)
+		}
+		if _, err := os.Stat(targetPath); err == nil {
+			return fmt.Errorf("cannot move %s: %s already exists", path, targetPath)
+		}
+		taken[targetPath] = path
+	}
+	return nil
+}
+
+// folderID is the next free ID of a status folder and the number of digits it is written with.
+type folderID struct {
+	value int
+	width int
+}
+
+// firstFolderID returns the next free ID of targetFolder with naming.per_folder_ids, or nil when
+// IDs are global and moves keep them.
+func firstFolderID(cfg *config.Config, targetFolder string) (*folderID, error) {
+	if !config.PerFolderIDs(cfg) {
+		return nil, nil
+	}
+	nextID, err := validation.GetNextIDInFolder(cfg, targetFolder)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.Atoi(nextID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid next id %q for %s: %w", nextID, targetFolder, err)
+	}
+	return &folderID{value: value, width: len(nextID)}, nil
+}
+
 func init() {
 	moveCmd.Flags().BoolP("commit", "c", false, "Commit the move to git")
 	moveCmd.Flags().Bool("dry-run", false, "Show what would be done without making changes")
+	moveCmd.Flags().Bool("start", false, "After moving, create the worktree as 'kira start' would (target status must be the start status)")
+	moveCmd.Flags().Bool("ready-pr", false, "When moving to a terminal status (e.g. done), mark the work item's pull request ready for review")
+	moveCmd.Flags().Bool("close-pr", false, "When moving to a terminal status (e.g. done), close the work item's pull request")
 }
 
 const unknownValue = "unknown"
@@ -87,6 +248,9 @@ func extractWorkItemMetadata(filePath string, cfg *config.Config) (workItemType,
 	fields := &workItemFields{}
 	if len(yamlLines) > 0 {
 		if err := yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), fields); err != nil {
+			if tabErr := validation.FrontMatterTabError(yamlLines); tabErr != nil {
+				err = tabErr
+			}
 			return unknownValue, "", "", unknownValue, nil, fmt.Errorf("failed to parse front matter: %w", err)
 		}
 	}
@@ -396,11 +560,11 @@ func commitMove(oldPath, newPath, subject, body string, dryRun bool) error {
 	return nil
 }
 
-// moveWorkItemDryRun shows what would happen without making changes
-func moveWorkItemDryRun(cfg *config.Config, workItemPath, targetPath, targetStatus string, commitFlag bool, metadata workItemMetadata) error {
-	fmt.Println("[DRY RUN] Would perform the following operations:")
-	fmt.Printf("[DRY RUN] Move file: %s -> %s\n", workItemPath, targetPath)
-	fmt.Printf("[DRY RUN] Update status field: %s -> %s\n", metadata.currentStatus, targetStatus)
+// moveWorkItemDryRun shows on out what would happen without making changes
+func moveWorkItemDryRun(cfg *config.Config, workItemPath, targetPath, targetStatus string, commitFlag bool, metadata workItemMetadata, out io.Writer) error {
+	_, _ = fmt.Fprintln(out, "[DRY RUN] Would perform the following operations:")
+	_, _ = fmt.Fprintf(out, "[DRY RUN] Move file: %s -> %s\n", workItemPath, targetPath)
+	_, _ = fmt.Fprintf(out, "[DRY RUN] Update status field: %s -> %s\n", metadata.currentStatus, targetStatus)
 
 	if !commitFlag {
 		return nil
@@ -410,9 +574,9 @@ func moveWorkItemDryRun(cfg *config.Config, workItemPath, targetPath, targetStat
 	if err != nil {
 		return fmt.Errorf("failed to build commit message: %w", err)
 	}
-	fmt.Printf("[DRY RUN] Commit message subject: %s\n", subject)
+	_, _ = fmt.Fprintf(out, "[DRY RUN] Commit message subject: %s\n", subject)
 	if body != "" {
-		fmt.Printf("[DRY RUN] Commit message body: %s\n", body)
+		_, _ = fmt.Fprintf(out, "[DRY RUN] Commit message body: %s\n", body)
 	}
 	// Show git commands that would be executed
 	return commitMove(workItemPath, targetPath, subject, body, true)
@@ -443,20 +607,29 @@ func moveWorkItem(cfg *config.Config, workItemID, targetStatus string, commitFla
 		}
 	}
 
+	_, err = moveResolvedWorkItem(cfg, workItemID, workItemPath, targetStatus, commitFlag, dryRun, metadata, additionalFields, os.Stdout)
+	return err
+}
+
+// moveResolvedWorkItem moves an already located work item, reporting the move on out, and returns
+// the path it ends up at (the would-be path when dryRun is set).
+func moveResolvedWorkItem(cfg *config.Config, workItemID, workItemPath, targetStatus string, commitFlag, dryRun bool, metadata workItemMetadata, additionalFields map[string]interface{}, out io.Writer) (string, error) {
+	var err error
+
 	// Get target status if not provided
 	if targetStatus == "" {
 		if dryRun {
-			return fmt.Errorf("target status must be provided when using --dry-run")
+			return "", fmt.Errorf("target status must be provided when using --dry-run")
 		}
 		targetStatus, err = selectTargetStatus(cfg)
 		if err != nil {
-			return err
+			return "", err
 		}
 	}
 
 	// Validate target status
 	if _, exists := cfg.StatusFolders[targetStatus]; !exists {
-		return fmt.Errorf("invalid target status: %s", targetStatus)
+		return "", fmt.Errorf("invalid target status: %s", targetStatus)
 	}
 
 	// Get target folder path
@@ -464,6 +637,15 @@ func moveWorkItem(cfg *config.Config, workItemID, targetStatus string, commitFla
 	filename := filepath.Base(workItemPath)
 	targetPath := filepath.Join(targetFolder, filename)
 
+	// With per-folder IDs the work item takes the next free ID of the target folder
+	renumber, err := perFolderRenumber(cfg, workItemPath, targetFolder)
+	if err != nil {
+		return "", err
+	}
+	if renumber != nil {
+		targetPath = filepath.Join(targetFolder, renumberedFilename(filename, renumber.newID))
+	}
+
 	// Idempotent: already at target path (e.g. file was moved on trunk and we just pulled).
 	// findWorkItemFile may return an absolute path (e.g. when the work folder is absolute) while
 	// targetPath is relative; filepath.Clean alone would miss that and fall through to commitMove,
@@ -471,14 +653,143 @@ func moveWorkItem(cfg *config.Config, workItemID, targetStatus string, commitFla
 	repoRoot, _ := getRepoRoot()
 	if workItemsSamePath(repoRoot, workItemPath, targetPath) {
 		// Use workItemPath (from findWorkItemFile) for I/O so validation matches how the file was found.
-		return moveWorkItemAlreadyAtTarget(cfg, workItemPath, targetStatus, commitFlag, dryRun, metadata, additionalFields)
+		return workItemPath, moveWorkItemAlreadyAtTarget(cfg, workItemPath, targetStatus, commitFlag, dryRun, metadata, additionalFields, out)
+	}
+
+	if dryRun {
+		if err := moveWorkItemDryRun(cfg, workItemPath, targetPath, targetStatus, commitFlag, metadata, out); err != nil {
+			return targetPath, err
+		}
+		renumber.display(out, true)
+		return targetPath, nil
+	}
+
+	if err := executeMoveWorkItem(cfg, workItemID, workItemPath, targetPath, targetStatus, commitFlag, metadata, additionalFields, renumber, out); err != nil {
+		return targetPath, err
+	}
+	renumber.display(out, false)
+	return targetPath, nil
+}
+
+// moveAndStartWorkItem moves a work item to the start status and then runs the start flow on it.
+// The work item is resolved and parsed once and shared by both phases. Because the move has
+// already transitioned the status, the start phase runs with status_action "none".
+func moveAndStartWorkItem(cfg *config.Config, workItemID, targetStatus string, commitFlag, dryRun bool) error {
+	startStatus := startMoveToStatus(cfg)
+	if targetStatus == "" {
+		targetStatus = startStatus
+	}
+	if targetStatus != startStatus {
+		return fmt.Errorf("cannot use --start when moving to '%s': start requires the work item to move to '%s'", targetStatus, startStatus)
 	}
 
+	if err := validateWorkItemID(workItemID, cfg); err != nil {
+		return err
+	}
+	workItemPath, err := findWorkItemFile(workItemID, cfg)
+	if err != nil {
+		return err
+	}
+
+	var metadata workItemMetadata
+	metadata.workItemType, metadata.id, metadata.title, metadata.currentStatus, metadata.repos, err = extractWorkItemMetadata(workItemPath, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to extract work item metadata: %w", err)
+	}
+
+	// With per-folder IDs the move renumbers the work item; start uses the ID it ends up with
+	newID, err := movedWorkItemID(cfg, workItemPath, targetStatus, metadata.id)
+	if err != nil {
+		return err
+	}
+	flags := StartFlags{
+		DryRun:       dryRun,
+		StatusAction: statusActionNone,
+	}
+	if !dryRun {
+		if err := validateMoveStartPreconditions(cfg, newID, workItemPath, metadata, flags); err != nil {
+			return err
+		}
+	}
+	newPath, err := moveResolvedWorkItem(cfg, workItemID, workItemPath, targetStatus, commitFlag, dryRun, metadata, nil, os.Stdout)
+	if err != nil {
+		return err
+	}
 	if dryRun {
-		return moveWorkItemDryRun(cfg, workItemPath, targetPath, targetStatus, commitFlag, metadata)
+		fmt.Println()
+		metadata.id = newID
+	} else {
+		metadata.workItemType, metadata.id, metadata.title, _, metadata.repos, err = extractWorkItemMetadata(newPath, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to extract work item metadata: %w", err)
+		}
 	}
 
-	return executeMoveWorkItem(cfg, workItemID, workItemPath, targetPath, targetStatus, commitFlag, metadata, additionalFields)
+	metadata.currentStatus = targetStatus
+	ctx, err := buildStartContextForWorkItem(cfg, metadata.id, newPath, metadata, flags)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return printDryRunPreview(ctx)
+	}
+	return executeGitOperations(ctx)
+}
+
+// validateMoveStartPreconditions checks what start would refuse before kira move --start moves
+// the work item, so that a start that cannot run does not leave it moved: the repository must be
+// on its trunk branch with no uncommitted changes, and the worktree and (outside polyrepo) the
+// branch of the work item, under the ID it has after the move, must not exist yet.
+func validateMoveStartPreconditions(cfg *config.Config, newID, workItemPath string, metadata workItemMetadata, flags StartFlags) error {
+	metadata.id = newID
+	ctx, err := buildStartContextForWorkItem(cfg, newID, workItemPath, metadata, flags)
+	if err != nil {
+		return err
+	}
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		return fmt.Errorf("not a git repository: current directory is not a git repository. Run this command from within a git repository")
+	}
+	trunkBranch, err := determineTrunkBranch(ctx.Config, flags.TrunkBranch, repoRoot, false)
+	if err != nil {
+		return err
+	}
+	if err := validateOnTrunkBranch(trunkBranch, repoRoot, false); err != nil {
+		return err
+	}
+	dirty, err := checkUncommittedChanges(repoRoot, false)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("cannot move and start work item %s: the repository has uncommitted changes; commit or stash them first", metadata.id)
+	}
+	worktreeStatus, err := checkWorktreeExists(ctx.worktreePath(), newID)
+	if err != nil {
+		return err
+	}
+	if worktreeStatus != WorktreeNotExists {
+		return fmt.Errorf("cannot move and start work item %s: worktree path %s already exists; remove it first, or move the work item and run kira start --override", metadata.id, ctx.worktreePath())
+	}
+	if ctx.Behavior == WorkspaceBehaviorPolyrepo {
+		return nil
+	}
+	branchStatus, err := checkBranchStatus(ctx.BranchName, trunkBranch, repoRoot, false)
+	if err != nil {
+		return fmt.Errorf("failed to check branch status: %w", err)
+	}
+	if branchStatus != BranchNotExists {
+		return fmt.Errorf("cannot move and start work item %s: branch %s already exists; delete it first, or move the work item and run kira start --reuse-branch", metadata.id, ctx.BranchName)
+	}
+	return nil
+}
+
+// startMoveToStatus returns the status the start command moves work items to.
+func startMoveToStatus(cfg *config.Config) string {
+	if cfg.Start != nil && cfg.Start.MoveTo != "" {
+		return cfg.Start.MoveTo
+	}
+	return "doing"
 }
 
 // workItemFileAlreadyReflectsTarget reports whether the file's front matter already matches targetStatus and additionalFields.
@@ -546,7 +857,7 @@ func scalarToInt64(v interface{}) (int64, bool) {
 }
 
 // moveWorkItemAlreadyAtTarget updates frontmatter when the file is already at the target path (idempotent path).
-func moveWorkItemAlreadyAtTarget(cfg *config.Config, targetPath, targetStatus string, commitFlag, dryRun bool, metadata workItemMetadata, additionalFields map[string]interface{}) error {
+func moveWorkItemAlreadyAtTarget(cfg *config.Config, targetPath, targetStatus string, commitFlag, dryRun bool, metadata workItemMetadata, additionalFields map[string]interface{}, out io.Writer) error {
 	// Skip all file I/O when nothing would change (e.g. kira done after trunk already has the item marked done).
 	if !dryRun {
 		match, err := workItemFileAlreadyReflectsTarget(targetPath, cfg, targetStatus, additionalFields)
@@ -554,7 +865,7 @@ func moveWorkItemAlreadyAtTarget(cfg *config.Config, targetPath, targetStatus st
 			return err
 		}
 		if match {
-			fmt.Printf("Work item %s already in '%s' with expected metadata; skipping update\n", metadata.id, targetStatus)
+			_, _ = fmt.Fprintf(out, "Work item %s already in '%s' with expected metadata; skipping update\n", metadata.id, targetStatus)
 			return nil
 		}
 	}
@@ -593,52 +904,36 @@ func moveWorkItemAlreadyAtTarget(cfg *config.Config, targetPath, targetStatus st
 	return commitMetadataUpdateIfChanged(ctx, targetPath, subject, repoRoot)
 }
 
-// executeMoveWorkItem performs the actual move operation
-func executeMoveWorkItem(cfg *config.Config, workItemID, workItemPath, targetPath, targetStatus string, commitFlag bool, metadata workItemMetadata, additionalFields map[string]interface{}) error {
-	if err := os.Rename(workItemPath, targetPath); err != nil {
-		return fmt.Errorf("failed to move work item: %w", err)
-	}
-
-	// Update the status in the file
-	if err := updateWorkItemStatus(targetPath, targetStatus, cfg); err != nil {
-		return fmt.Errorf("failed to update work item status: %w", err)
-	}
-
-	// Apply optional additional frontmatter fields (e.g. merged_at, merge_commit_sha for done)
-	if len(additionalFields) > 0 {
-		frontMatter, bodyLines, err := parseWorkItemFrontMatter(targetPath, cfg)
-		if err != nil {
-			return fmt.Errorf("failed to read front matter for additional fields: %w", err)
-		}
-		if frontMatter == nil {
-			frontMatter = make(map[string]interface{})
-		}
-		for k, v := range additionalFields {
-			frontMatter[k] = v
-		}
-		if err := writeWorkItemFrontMatter(targetPath, frontMatter, bodyLines); err != nil {
-			return fmt.Errorf("failed to write additional front matter fields: %w", err)
-		}
+// executeMoveWorkItem performs the actual move operation and reports it on out
+func executeMoveWorkItem(cfg *config.Config, workItemID, workItemPath, targetPath, targetStatus string, commitFlag bool, metadata workItemMetadata, additionalFields map[string]interface{}, renumber *workItemRenumber, out io.Writer) error {
+	// Write the moved work item (status, updated, a renumbered id and optional additional fields
+	// such as merged_at for done) to the target folder in one step
+	if err := writeMovedWorkItemFile(cfg, workItemPath, targetPath, targetStatus, renumber, additionalFields); err != nil {
+		return fmt.Errorf("failed to move work item %s (work item unchanged): %w", workItemID, err)
 	}
 
 	if !commitFlag {
-		fmt.Printf("Moved work item %s to %s\n", workItemID, targetStatus)
+		_, _ = fmt.Fprintf(out, "Moved work item %s to %s\n", workItemID, targetStatus)
 		return nil
 	}
 
-	// Build and execute commit
-	subject, body, err := buildCommitMessage(cfg, metadata.workItemType, metadata.id, metadata.title, metadata.currentStatus, targetStatus)
+	// Build and execute commit, naming the work item by the ID it has after the move
+	committedID := metadata.id
+	if renumber != nil {
+		committedID = renumber.newID
+	}
+	subject, body, err := buildCommitMessage(cfg, metadata.workItemType, committedID, metadata.title, metadata.currentStatus, targetStatus)
 	if err != nil {
-		fmt.Printf("Moved work item %s to %s\n", workItemID, targetStatus)
+		_, _ = fmt.Fprintf(out, "Moved work item %s to %s\n", workItemID, targetStatus)
 		return fmt.Errorf("failed to build commit message: %w", err)
 	}
 
 	if err := commitMove(workItemPath, targetPath, subject, body, false); err != nil {
-		fmt.Printf("Moved work item %s to %s\n", workItemID, targetStatus)
+		_, _ = fmt.Fprintf(out, "Moved work item %s to %s\n", workItemID, targetStatus)
 		return fmt.Errorf("failed to commit move: %w", err)
 	}
 
-	fmt.Printf("Moved work item %s to %s and committed\n", workItemID, targetStatus)
+	_, _ = fmt.Fprintf(out, "Moved work item %s to %s and committed\n", workItemID, targetStatus)
 	return nil
 }
 
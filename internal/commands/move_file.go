@@ -0,0 +1,103 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"kira/internal/config"
+)
+
+// writeMovedWorkItemFile moves the work item at workItemPath to targetPath in the folder of
+// targetStatus. The status, the updated timestamp, the new id when it was renumbered
+// (naming.per_folder_ids) and additionalFields are set in one pass, the result is written to a
+// temporary file in the target folder and renamed into place, and the original is only removed
+// after that: a failed move leaves the work item where and as it was.
+func writeMovedWorkItemFile(cfg *config.Config, workItemPath, targetPath, targetStatus string, renumber *workItemRenumber, additionalFields map[string]interface{}) error {
+	if _, err := os.Stat(targetPath); err == nil {
+		return fmt.Errorf("%s already exists", targetPath)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(targetPath), "."+filepath.Base(targetPath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	if err := writeMovedWorkItemContent(cfg, workItemPath, tmpPath, targetStatus, renumber, additionalFields); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, targetPath); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to move work item: %w", err)
+	}
+	if err := os.Remove(workItemPath); err != nil {
+		_ = os.Remove(targetPath)
+		return fmt.Errorf("failed to remove %s: %w", workItemPath, err)
+	}
+	return nil
+}
+
+// writeMovedWorkItemContent writes the moved content of the work item at workItemPath to path.
+// Without additionalFields only the status, updated and id lines change and the rest of the file
+// is kept as it is; with them the front matter is rewritten to add those fields.
+func writeMovedWorkItemContent(cfg *config.Config, workItemPath, path, targetStatus string, renumber *workItemRenumber, additionalFields map[string]interface{}) error {
+	updated := time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	if len(additionalFields) > 0 {
+		frontMatter, bodyLines, err := parseWorkItemFrontMatter(workItemPath, cfg)
+		if err != nil {
+			return fmt.Errorf("failed to read front matter for additional fields: %w", err)
+		}
+		frontMatter["status"] = targetStatus
+		frontMatter["updated"] = updated
+		if renumber != nil {
+			frontMatter["id"] = renumber.newID
+		}
+		for k, v := range additionalFields {
+			frontMatter[k] = v
+		}
+		if err := writeWorkItemFrontMatter(path, frontMatter, bodyLines); err != nil {
+			return fmt.Errorf("failed to write additional front matter fields: %w", err)
+		}
+		return nil
+	}
+
+	content, err := safeReadFile(workItemPath, cfg)
+	if err != nil {
+		return err
+	}
+	lines := strings.Split(string(content), "\n")
+	lines = setFrontMatterLine(lines, "status", targetStatus)
+	lines = setFrontMatterLine(lines, "updated", updated)
+	if renumber != nil {
+		lines = setFrontMatterLine(lines, "id", renumber.newID)
+	}
+	return os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600)
+}
+
+// setFrontMatterLine sets key to value in the front matter of a work item split into lines,
+// replacing the line of key or adding one before the closing separator. Content without front
+// matter is returned unchanged.
+func setFrontMatterLine(lines []string, key, value string) []string {
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != yamlSeparator {
+		return lines
+	}
+	line := fmt.Sprintf("%s: %s", key, value)
+	for i := 1; i < len(lines); i++ {
+		switch {
+		case strings.TrimSpace(lines[i]) == yamlSeparator:
+			return append(lines[:i], append([]string{line}, lines[i:]...)...)
+		case strings.HasPrefix(lines[i], key+":"):
+			lines[i] = line
+			return lines
+		}
+	}
+	return lines
+}
@@ -0,0 +1,138 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/go-github/v61/github"
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+	"kira/internal/git"
+)
+
+// movePRAction is what kira move does to the work item's pull request when it moves to a terminal
+// status.
+type movePRAction string
+
+const (
+	movePRActionNone  movePRAction = ""
+	movePRActionReady movePRAction = "ready"
+	movePRActionClose movePRAction = "close"
+)
+
+// movePRActionFromFlags reads --ready-pr and --close-pr, which cannot be combined.
+func movePRActionFromFlags(cmd *cobra.Command) (movePRAction, error) {
+	readyPR, _ := cmd.Flags().GetBool("ready-pr")
+	closePR, _ := cmd.Flags().GetBool("close-pr")
+	switch {
+	case readyPR && closePR:
+		return movePRActionNone, fmt.Errorf("invalid flag combination: --ready-pr cannot be used together with --close-pr")
+	case readyPR:
+		return movePRActionReady, nil
+	case closePR:
+		return movePRActionClose, nil
+	default:
+		return movePRActionNone, nil
+	}
+}
+
+// validateMovePRAction checks that --ready-pr/--close-pr are only used when moving to a terminal
+// status (terminal_statuses in kira.yml, default done, released and abandoned).
+func validateMovePRAction(action movePRAction, targetStatus string, cfg *config.Config) error {
+	if action == movePRActionNone || config.IsTerminalStatus(cfg, targetStatus) {
+		return nil
+	}
+	return fmt.Errorf("--%s-pr can only be used when moving to a terminal status, not '%s'", action, targetStatus)
+}
+
+// applyMovePRAction looks up the pull request of the work item's branch ({id}-*) and marks it
+// ready for review or closes it. When the remote is not GitHub, KIRA_GITHUB_TOKEN is not set or
+// no pull request is found, a note is printed and the move still succeeds.
+func applyMovePRAction(cfg *config.Config, workItemID string, action movePRAction, dryRun bool, out io.Writer) error {
+	if action == movePRActionNone {
+		return nil
+	}
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "Note: skipping pull request update: %v\n", err)
+		return nil
+	}
+	remoteURL, baseURL, err := resolveDoneRemote(cfg, repoRoot)
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "Note: skipping pull request update: %v\n", err)
+		return nil
+	}
+	if !isGitHubRemote(remoteURL, baseURL) {
+		_, _ = fmt.Fprintln(out, "Note: skipping pull request update: remote is not GitHub")
+		return nil
+	}
+	token := os.Getenv("KIRA_GITHUB_TOKEN")
+	if token == "" {
+		_, _ = fmt.Fprintln(out, "Note: skipping pull request update: KIRA_GITHUB_TOKEN is not set")
+		return nil
+	}
+	owner, repoName, err := git.ParseGitHubOwnerRepo(remoteURL)
+	if err != nil {
+		return fmt.Errorf("invalid remote URL: %w", err)
+	}
+
+	apiCtx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	client, err := git.NewClient(apiCtx, token, baseURL)
+	if err != nil {
+		return err
+	}
+	var pr *github.PullRequest
+	err = git.WithRateLimitRetry(apiCtx, 2, func() error {
+		var e error
+		pr, e = git.FindPullRequestByWorkItemID(apiCtx, client, owner, repoName, workItemID)
+		return e
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+	return applyMovePRActionToPR(apiCtx, client, owner, repoName, workItemID, pr, action, dryRun, out)
+}
+
+// applyMovePRActionToPR marks pr ready for review or closes it. PRs that are missing, already
+// closed or merged, or (for ready) already ready are skipped with a note.
+func applyMovePRActionToPR(ctx context.Context, client *github.Client, owner, repo, workItemID string, pr *github.PullRequest, action movePRAction, dryRun bool, out io.Writer) error {
+	if pr == nil {
+		_, _ = fmt.Fprintf(out, "Note: no pull request found for work item %s; skipping pull request update\n", workItemID)
+		return nil
+	}
+	if git.IsPRClosedOrMerged(pr) {
+		_, _ = fmt.Fprintf(out, "Note: PR #%d is already closed or merged; skipping pull request update\n", pr.GetNumber())
+		return nil
+	}
+
+	switch action {
+	case movePRActionReady:
+		if !pr.GetDraft() {
+			_, _ = fmt.Fprintf(out, "Note: PR #%d is already ready for review\n", pr.GetNumber())
+			return nil
+		}
+		if dryRun {
+			_, _ = fmt.Fprintf(out, "[DRY RUN] Would mark PR #%d ready for review: %s\n", pr.GetNumber(), pr.GetHTMLURL())
+			return nil
+		}
+		if err := git.UpdateDraftToReady(ctx, client, pr); err != nil {
+			return fmt.Errorf("failed to mark PR #%d ready for review: %w", pr.GetNumber(), err)
+		}
+		_, _ = fmt.Fprintf(out, "Marked PR #%d ready for review: %s\n", pr.GetNumber(), pr.GetHTMLURL())
+	case movePRActionClose:
+		if dryRun {
+			_, _ = fmt.Fprintf(out, "[DRY RUN] Would close PR #%d: %s\n", pr.GetNumber(), pr.GetHTMLURL())
+			return nil
+		}
+		if err := git.ClosePullRequest(ctx, client, owner, repo, pr.GetNumber()); err != nil {
+			return fmt.Errorf("failed to close PR #%d: %w", pr.GetNumber(), err)
+		}
+		_, _ = fmt.Fprintf(out, "Closed PR #%d: %s\n", pr.GetNumber(), pr.GetHTMLURL())
+	}
+	return nil
+}
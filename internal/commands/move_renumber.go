@@ -0,0 +1,97 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+	"kira/internal/validation"
+)
+
+// workItemRenumber is the ID change of a work item moved to another status folder when IDs are
+// numbered per folder (naming.per_folder_ids).
+type workItemRenumber struct {
+	oldID string
+	newID string
+}
+
+// perFolderRenumber returns the ID change for moving the work item at workItemPath into
+// targetFolder, or nil when IDs are global or the work item stays in its folder. The new ID is
+// the next free ID of the target folder; the move fails if a file already has the new name.
+func perFolderRenumber(cfg *config.Config, workItemPath, targetFolder string) (*workItemRenumber, error) {
+	if !config.PerFolderIDs(cfg) || sameFolder(filepath.Dir(workItemPath), targetFolder) {
+		return nil, nil
+	}
+	frontMatter, _, err := parseWorkItemFrontMatter(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read work item id: %w", err)
+	}
+	newID, err := validation.GetNextIDInFolder(cfg, targetFolder)
+	if err != nil {
+		return nil, err
+	}
+	renamed := filepath.Join(targetFolder, renumberedFilename(filepath.Base(workItemPath), newID))
+	if _, err := os.Stat(renamed); err == nil {
+		return nil, fmt.Errorf("cannot move work item: id %s is already taken in %s (%s exists)", newID, targetFolder, renamed)
+	}
+	return &workItemRenumber{oldID: frontMatterIDString(frontMatter["id"]), newID: newID}, nil
+}
+
+// sameFolder reports whether a and b name the same folder, each either absolute or relative to
+// the working directory.
+func sameFolder(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return samePath(a, b)
+	}
+	return samePath(absA, absB)
+}
+
+// movedWorkItemID returns the ID the work item at workItemPath has after moving to targetStatus:
+// the next free ID of the target folder when it is renumbered, otherwise id.
+func movedWorkItemID(cfg *config.Config, workItemPath, targetStatus, id string) (string, error) {
+	folder, exists := cfg.StatusFolders[targetStatus]
+	if !exists {
+		return "", fmt.Errorf("invalid target status: %s", targetStatus)
+	}
+	renumber, err := perFolderRenumber(cfg, workItemPath, filepath.Join(config.GetWorkFolderPath(cfg), folder))
+	if err != nil || renumber == nil {
+		return id, err
+	}
+	return renumber.newID, nil
+}
+
+// renumberedFilename replaces the ID prefix of a work item file name ("001-fix-login.prd.md"
+// becomes "004-fix-login.prd.md"), or adds one when the name has none.
+func renumberedFilename(filename, newID string) string {
+	prefix, rest, found := strings.Cut(filename, "-")
+	if !found || strings.Trim(prefix, "0123456789") != "" {
+		return newID + "-" + filename
+	}
+	return newID + "-" + rest
+}
+
+// renumberedID returns the new ID, or "" when the work item kept its ID.
+func (r *workItemRenumber) renumberedID() string {
+	if r == nil {
+		return ""
+	}
+	return r.newID
+}
+
+// display reports the ID change of a moved work item to out; nothing when it kept its ID.
+func (r *workItemRenumber) display(out io.Writer, dryRun bool) {
+	if r == nil {
+		return
+	}
+	if dryRun {
+		_, _ = fmt.Fprintf(out, "[DRY RUN] Update id field: %s -> %s (naming.per_folder_ids)\n", r.oldID, r.newID)
+		return
+	}
+	_, _ = fmt.Fprintf(out, "Renumbered work item %s -> %s (IDs are per status folder)\n", r.oldID, r.newID)
+}
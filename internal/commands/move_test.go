@@ -1,13 +1,20 @@
 package commands
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/google/go-github/v61/github"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -330,6 +337,43 @@ func TestMoveWorkItem(t *testing.T) {
 		assert.True(t, os.IsNotExist(err))
 	})
 
+	t.Run("sets the updated timestamp and keeps the rest of the file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+
+		require.NoError(t, moveWorkItem(&config.DefaultConfig, "001", "doing", false, false, nil))
+
+		moved := mustReadFile(t, testTargetPath)
+		assert.Contains(t, moved, "status: doing\n")
+		assert.Regexp(t, `\nupdated: \d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z\n---\n`, moved)
+		assert.Equal(t, strings.Replace(testWorkItemContent, "status: todo", "status: doing", 1), regexp.MustCompile(`updated: .*\n`).ReplaceAllString(moved, ""))
+		entries, err := os.ReadDir(".work/2_doing")
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "no temporary file is left behind")
+	})
+
+	t.Run("leaves the work item unchanged when the move fails", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+		require.NoError(t, os.WriteFile(testTargetPath, []byte("existing"), 0o600))
+
+		err := moveWorkItem(&config.DefaultConfig, "001", "doing", false, false, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item unchanged")
+		assert.Equal(t, testWorkItemContent, mustReadFile(t, testFilePath))
+		assert.Equal(t, "existing", mustReadFile(t, testTargetPath))
+	})
+
 	t.Run("moves work item with commit flag", func(t *testing.T) {
 		tmpDir := t.TempDir()
 		require.NoError(t, os.Chdir(tmpDir))
@@ -625,6 +669,427 @@ func verifyStagedMove(t *testing.T, oldPath, newPath string) {
 		"Addition should be staged. Output: %s", outputStr)
 }
 
+func TestMoveWorkItems(t *testing.T) {
+	const secondPath = ".work/1_todo/002-second-feature.task.md"
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/4_done", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+		require.NoError(t, os.WriteFile(secondPath, []byte("---\nid: 002\ntitle: Second Feature\nstatus: todo\nkind: task\ncreated: 2024-01-01\n---\n\n# Second Feature\n"), 0o600))
+		return &config.DefaultConfig
+	}
+
+	t.Run("the last argument is the target status", func(t *testing.T) {
+		ids, status := parseMoveArgs([]string{"001"})
+		assert.Equal(t, []string{"001"}, ids)
+		assert.Empty(t, status)
+
+		ids, status = parseMoveArgs([]string{"001", "002", "001", "done"})
+		assert.Equal(t, []string{"001", "002"}, ids)
+		assert.Equal(t, "done", status)
+	})
+
+	t.Run("moves every work item to the target folder", func(t *testing.T) {
+		cfg := setup(t)
+
+		require.NoError(t, moveWorkItems(cfg, []string{"001", "002"}, "done", false, false, movePRActionNone, io.Discard))
+
+		for oldPath, newPath := range map[string]string{
+			testFilePath: testDoneFilePath,
+			secondPath:   ".work/4_done/002-second-feature.task.md",
+		} {
+			_, err := os.Stat(oldPath)
+			assert.True(t, os.IsNotExist(err), "%s should no longer exist", oldPath)
+			assert.Contains(t, mustReadFile(t, newPath), "status: done")
+		}
+	})
+
+	t.Run("moves nothing when an id is unknown", func(t *testing.T) {
+		cfg := setup(t)
+
+		err := moveWorkItems(cfg, []string{"001", "099"}, "done", false, false, movePRActionNone, io.Discard)
+		require.Error(t, err)
+		assert.Equal(t, "no work items were moved: work item with ID 099 not found", err.Error())
+
+		_, err = os.Stat(testFilePath)
+		require.NoError(t, err)
+		_, err = os.Stat(testDoneFilePath)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("moves nothing when a target file name is taken", func(t *testing.T) {
+		cfg := setup(t)
+		require.NoError(t, os.WriteFile(".work/4_done/002-second-feature.task.md", []byte("existing"), 0o600))
+
+		err := moveWorkItems(cfg, []string{"001", "002"}, "done", false, false, movePRActionNone, io.Discard)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no work items were moved: cannot move .work/1_todo/002-second-feature.task.md: .work/4_done/002-second-feature.task.md already exists")
+		assert.FileExists(t, testFilePath)
+		assert.NoFileExists(t, testDoneFilePath)
+	})
+
+	t.Run("moves nothing when a per-folder id would be taken", func(t *testing.T) {
+		cfg := setup(t)
+		perFolder := *cfg
+		perFolder.Naming = &config.NamingConfig{PerFolderIDs: true}
+		require.NoError(t, os.WriteFile(".work/4_done/002-second-feature.task.md", []byte("no front matter"), 0o600))
+
+		err := moveWorkItems(&perFolder, []string{"001", "002"}, "done", false, false, movePRActionNone, io.Discard)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), ".work/4_done/002-second-feature.task.md already exists")
+		assert.FileExists(t, testFilePath)
+		assert.NoFileExists(t, ".work/4_done/001-test-feature.prd.md")
+	})
+
+	t.Run("dry run moves nothing", func(t *testing.T) {
+		cfg := setup(t)
+
+		require.NoError(t, moveWorkItems(cfg, []string{"001", "002"}, "done", false, true, movePRActionNone, io.Discard))
+
+		_, err := os.Stat(testFilePath)
+		require.NoError(t, err)
+		_, err = os.Stat(secondPath)
+		require.NoError(t, err)
+	})
+}
+
+func TestMoveWorkItemPerFolderIDs(t *testing.T) {
+	workItem := func(id, status string) string {
+		return "---\nid: " + id + "\ntitle: Item " + id + "\nstatus: " + status + "\nkind: prd\ncreated: 2024-01-01\n---\n\n# Item\n"
+	}
+	// setup creates a workspace with per-folder IDs: 005 in todo, 001 and 002 in doing.
+	setup := func(t *testing.T) *config.Config {
+		t.Helper()
+		setupListWorkspace(t, map[string]string{
+			"1_todo/005-fix-login.prd.md":   workItem("005", "todo"),
+			"2_doing/001-add-search.prd.md": workItem("001", "doing"),
+			"2_doing/002-tune-cache.prd.md": workItem("002", "doing"),
+		})
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\nnaming:\n  per_folder_ids: true\n"), 0o600))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		return cfg
+	}
+
+	t.Run("renames the file and updates the id to the next id of the target folder", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := captureStdout(func() error {
+			return moveWorkItem(cfg, "005", "doing", false, false, nil)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Renumbered work item 005 -> 003")
+
+		assert.NoFileExists(t, ".work/1_todo/005-fix-login.prd.md")
+		content := mustReadFile(t, ".work/2_doing/003-fix-login.prd.md")
+		assert.Contains(t, content, "id: 003\n")
+		assert.Contains(t, content, "created: 2024-01-01\n")
+		assert.Contains(t, content, "status: doing")
+	})
+
+	t.Run("dry run shows the rename without changing files", func(t *testing.T) {
+		cfg := setup(t)
+
+		output, err := captureStdout(func() error {
+			return moveWorkItem(cfg, "005", "doing", false, true, nil)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "-> .work/2_doing/003-fix-login.prd.md")
+		assert.Contains(t, output, "[DRY RUN] Update id field: 005 -> 003")
+		assert.FileExists(t, ".work/1_todo/005-fix-login.prd.md")
+		assert.NoFileExists(t, ".work/2_doing/003-fix-login.prd.md")
+	})
+
+	t.Run("pull request actions look up the new id", func(t *testing.T) {
+		cfg := setup(t)
+
+		id, err := movePRWorkItemID(cfg, "005", "doing", movePRActionClose)
+		require.NoError(t, err)
+		assert.Equal(t, "003", id)
+
+		id, err = movePRWorkItemID(cfg, "001", "doing", movePRActionClose)
+		require.NoError(t, err)
+		assert.Equal(t, "001", id, "a work item already in the target folder keeps its id")
+
+		id, err = movePRWorkItemID(cfg, "005", "doing", movePRActionNone)
+		require.NoError(t, err)
+		assert.Equal(t, "005", id)
+	})
+
+	t.Run("move --start uses the new id for the branch", func(t *testing.T) {
+		cfg := setup(t)
+		cfg.Start = &config.StartConfig{MoveTo: "doing"}
+
+		output, err := captureStdout(func() error {
+			return moveAndStartWorkItem(cfg, "005", "", false, true)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "[DRY RUN] Update id field: 005 -> 003")
+		assert.Contains(t, output, "ID: 003")
+		assert.Contains(t, output, "Branch Name: 003-item-005")
+		assert.FileExists(t, ".work/1_todo/005-fix-login.prd.md")
+	})
+
+	t.Run("start dry run names the branch by the new id", func(t *testing.T) {
+		cfg := setup(t)
+		ctx, err := buildStartContext(cfg, "005", StartFlags{DryRun: true, StatusAction: statusActionCommitOnlyBranch})
+		require.NoError(t, err)
+
+		output, err := captureStdout(func() error {
+			return printDryRunPreview(ctx)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "ID: 005 (renumbered to 003 in 'doing', naming.per_folder_ids)")
+		assert.Contains(t, output, "Branch Name: 003-item-005")
+		assert.FileExists(t, ".work/1_todo/005-fix-login.prd.md")
+	})
+
+	t.Run("start moves the work item under the new id", func(t *testing.T) {
+		cfg := setup(t)
+		ctx, err := buildStartContext(cfg, "005", StartFlags{StatusAction: "commit_only"})
+		require.NoError(t, err)
+
+		var newPath string
+		output, err := captureStdout(func() error {
+			var moveErr error
+			newPath, moveErr = moveStartWorkItem(ctx, "doing")
+			return moveErr
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Renumbered work item 005 -> 003")
+		assert.Equal(t, filepath.Join(".work", "2_doing", "003-fix-login.prd.md"), newPath)
+		assert.Equal(t, newPath, ctx.WorkItemPath)
+		assert.Equal(t, "003", ctx.WorkItemID)
+		assert.Equal(t, "003-item-005", ctx.BranchName)
+		assert.NoFileExists(t, ".work/1_todo/005-fix-login.prd.md")
+		content := mustReadFile(t, newPath)
+		assert.Contains(t, content, "id: 003\n")
+		assert.Contains(t, content, "status: doing")
+
+		commitMsg, err := buildStatusCommitMessage(ctx, "doing")
+		require.NoError(t, err)
+		assert.Contains(t, commitMsg, "003")
+	})
+
+	t.Run("fails when the new file name is already taken", func(t *testing.T) {
+		cfg := setup(t)
+		require.NoError(t, os.WriteFile(".work/2_doing/003-fix-login.prd.md", []byte("no front matter"), 0o600))
+
+		_, err := captureStdout(func() error {
+			return moveWorkItem(cfg, "005", "doing", false, false, nil)
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "id 003 is already taken")
+		assert.FileExists(t, ".work/1_todo/005-fix-login.prd.md")
+	})
+
+	t.Run("keeps the id when ids are global", func(t *testing.T) {
+		cfg := setup(t)
+		cfg.Naming = nil
+
+		_, err := captureStdout(func() error {
+			return moveWorkItem(cfg, "005", "doing", false, false, nil)
+		})
+		require.NoError(t, err)
+		assert.FileExists(t, ".work/2_doing/005-fix-login.prd.md")
+	})
+}
+
+func TestRenumberedFilename(t *testing.T) {
+	assert.Equal(t, "004-fix-login.prd.md", renumberedFilename("001-fix-login.prd.md", "004"))
+	assert.Equal(t, "004-notes.md", renumberedFilename("notes.md", "004"))
+	assert.Equal(t, "004-fix-login.prd.md", renumberedFilename("1-fix-login.prd.md", "004"))
+}
+
+func TestMoveAndStartWorkItem(t *testing.T) {
+	t.Run("rejects target status other than the start status", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		cfg := &config.DefaultConfig
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/3_review", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+
+		err := moveAndStartWorkItem(cfg, "001", "review", false, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use --start when moving to 'review'")
+
+		// Nothing should have moved
+		_, err = os.Stat(testFilePath)
+		require.NoError(t, err)
+	})
+
+	t.Run("dry run previews both phases without changes", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		cfgCopy := config.DefaultConfig
+		cfgCopy.Start = &config.StartConfig{MoveTo: "doing", StatusAction: "commit_and_push"}
+		cfg := &cfgCopy
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+		err := moveAndStartWorkItem(cfg, "001", "", false, true)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		output, _ := io.ReadAll(r)
+		require.NoError(t, err)
+
+		out := string(output)
+		assert.Contains(t, out, "Move file: "+testFilePath+" -> "+testTargetPath)
+		assert.Contains(t, out, "Current Status: doing")
+		assert.Contains(t, out, "Status Change: No change")
+
+		_, err = os.Stat(testFilePath)
+		require.NoError(t, err)
+		_, err = os.Stat(testTargetPath)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	// setupStartRepo creates a git repository on main with the todo work item committed.
+	setupStartRepo := func(t *testing.T) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+
+		initGitRepo(t, tmpDir)
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(testFilePath, []byte(testWorkItemContent), 0o600))
+		for _, args := range [][]string{{"branch", "-M", "main"}, {"add", "."}, {"commit", "-m", "add work item"}} {
+			// #nosec G204 -- test helper, fixed git arguments
+			cmd := exec.Command("git", args...)
+			require.NoError(t, cmd.Run())
+		}
+
+		cfgCopy := config.DefaultConfig
+		cfgCopy.Start = &config.StartConfig{MoveTo: "doing"}
+		return &cfgCopy
+	}
+
+	t.Run("fails before moving when the tree has uncommitted changes", func(t *testing.T) {
+		cfg := setupStartRepo(t)
+		require.NoError(t, os.WriteFile("f.txt", []byte("changed"), 0o600))
+
+		err := moveAndStartWorkItem(cfg, "001", "", false, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "uncommitted changes")
+		assert.FileExists(t, testFilePath)
+		assert.NoFileExists(t, testTargetPath)
+	})
+
+	t.Run("fails before moving when the branch already exists", func(t *testing.T) {
+		cfg := setupStartRepo(t)
+		// #nosec G204 -- test helper, fixed git arguments
+		require.NoError(t, exec.Command("git", "branch", "001-test-feature").Run())
+
+		err := moveAndStartWorkItem(cfg, "001", "", false, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "branch 001-test-feature already exists")
+		assert.FileExists(t, testFilePath)
+		assert.NoFileExists(t, testTargetPath)
+	})
+}
+
+func TestMovePRAction(t *testing.T) {
+	t.Run("only applies when moving to a terminal status", func(t *testing.T) {
+		cfg := &config.Config{}
+		require.NoError(t, validateMovePRAction(movePRActionNone, "doing", cfg))
+		require.NoError(t, validateMovePRAction(movePRActionClose, "done", cfg))
+		require.NoError(t, validateMovePRAction(movePRActionClose, "abandoned", cfg))
+
+		err := validateMovePRAction(movePRActionReady, "review", cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--ready-pr can only be used when moving to a terminal status, not 'review'")
+
+		cfg.TerminalStatuses = []string{"shipped"}
+		require.NoError(t, validateMovePRAction(movePRActionReady, "shipped", cfg))
+		require.Error(t, validateMovePRAction(movePRActionReady, "done", cfg))
+	})
+
+	t.Run("skips with a note when the remote is not GitHub", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+		require.NoError(t, exec.Command("git", "init").Run())
+		require.NoError(t, exec.Command("git", "remote", "add", "origin", "https://gitlab.example.com/owner/repo.git").Run())
+
+		var out bytes.Buffer
+		err := applyMovePRAction(&config.DefaultConfig, "001", movePRActionClose, false, &out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "Note: skipping pull request update: remote is not GitHub")
+	})
+
+	t.Run("skips with a note when KIRA_GITHUB_TOKEN is not set", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+		require.NoError(t, exec.Command("git", "init").Run())
+		require.NoError(t, exec.Command("git", "remote", "add", "origin", "https://github.com/owner/repo.git").Run())
+		t.Setenv("KIRA_GITHUB_TOKEN", "")
+
+		var out bytes.Buffer
+		err := applyMovePRAction(&config.DefaultConfig, "001", movePRActionReady, false, &out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "KIRA_GITHUB_TOKEN is not set")
+	})
+
+	t.Run("skips missing and closed pull requests", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, applyMovePRActionToPR(context.Background(), nil, "owner", "repo", "001", nil, movePRActionClose, false, &out))
+		assert.Contains(t, out.String(), "no pull request found for work item 001")
+
+		out.Reset()
+		closed := &github.PullRequest{Number: github.Int(3), State: github.String("closed")}
+		require.NoError(t, applyMovePRActionToPR(context.Background(), nil, "owner", "repo", "001", closed, movePRActionClose, false, &out))
+		assert.Contains(t, out.String(), "PR #3 is already closed or merged")
+	})
+
+	t.Run("dry run previews without calling the API", func(t *testing.T) {
+		pr := &github.PullRequest{Number: github.Int(4), State: github.String("open"), Draft: github.Bool(true)}
+		var out bytes.Buffer
+		require.NoError(t, applyMovePRActionToPR(context.Background(), nil, "owner", "repo", "001", pr, movePRActionReady, true, &out))
+		assert.Contains(t, out.String(), "[DRY RUN] Would mark PR #4 ready for review")
+
+		out.Reset()
+		require.NoError(t, applyMovePRActionToPR(context.Background(), nil, "owner", "repo", "001", pr, movePRActionClose, true, &out))
+		assert.Contains(t, out.String(), "[DRY RUN] Would close PR #4")
+	})
+
+	t.Run("closes the pull request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/repos/owner/repo/pulls/5", r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"number":5,"state":"closed"}`))
+		}))
+		defer server.Close()
+		baseURL, err := url.Parse(server.URL + "/")
+		require.NoError(t, err)
+		client := github.NewClient(server.Client())
+		client.BaseURL = baseURL
+
+		pr := &github.PullRequest{Number: github.Int(5), State: github.String("open")}
+		var out bytes.Buffer
+		require.NoError(t, applyMovePRActionToPR(context.Background(), client, "owner", "repo", "001", pr, movePRActionClose, false, &out))
+		assert.Contains(t, out.String(), "Closed PR #5")
+	})
+}
+
 func TestStageFileChanges(t *testing.T) {
 	t.Run("stages deletion and addition when git rm --cached succeeds", func(t *testing.T) {
 		tmpDir := t.TempDir()
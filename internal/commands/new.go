@@ -75,9 +75,9 @@ func createWorkItem(cfg *config.Config, args []string, interactive bool, inputVa
 		return err
 	}
 
-	nextID, err := validation.GetNextID(cfg)
+	nextID, err := nextCreateID(cfg, filepath.Join(config.GetWorkFolderPath(cfg), cfg.StatusFolders[status]))
 	if err != nil {
-		return fmt.Errorf("failed to get next ID: %w", err)
+		return err
 	}
 
 	inputs, err := collectInputs(cfg, template, nextID, title, status, parsedArgs.description, inputValues, interactive)
@@ -587,9 +587,9 @@ func convertIdeaToWorkItem(cfg *config.Config, ideaNumber int, template, status
 	}
 
 	// Get next work item ID
-	nextID, err := validation.GetNextID(cfg)
+	nextID, err := nextCreateID(cfg, filepath.Join(config.GetWorkFolderPath(cfg), cfg.StatusFolders[resolvedStatus]))
 	if err != nil {
-		return fmt.Errorf("failed to get next ID: %w", err)
+		return err
 	}
 
 	// Collect inputs for template
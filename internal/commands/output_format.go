@@ -0,0 +1,24 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import "fmt"
+
+// outputFormatText and outputFormatJSON are the values of the --output flag of the commands
+// that also have --json.
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+)
+
+// resolveJSONOutput checks an --output value and reports whether JSON was asked for, by
+// --output json or by --json.
+func resolveJSONOutput(output string, jsonFlag bool) (bool, error) {
+	switch output {
+	case outputFormatText:
+		return jsonFlag, nil
+	case outputFormatJSON:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid output format %q: use text or json", output)
+	}
+}
@@ -0,0 +1,264 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove the merged branches and worktrees of finished work items",
+	Long: `Removes the branches and worktrees left behind by finished work items: those in a
+terminal status (terminal_statuses in kira.yml, default done, released and abandoned).
+Each work item's branch is found by the naming kira start uses (<id>-<slug>, with the slug
+possibly shortened by --max-title-length), and its worktree by the branch it has checked
+out. A branch merged into the remote trunk (<remote>/<trunk>, or the local trunk when there
+is no remote-tracking branch) is removed with its worktree (git worktree remove, then git
+branch -D); an unmerged branch is skipped with a warning unless --force is given.
+
+Only the current repository is pruned; polyrepo project repositories are not.
+
+Examples:
+  kira prune --dry-run
+  kira prune
+  kira prune --force`,
+	Args: cobra.NoArgs,
+	RunE: runPrune,
+}
+
+func init() {
+	pruneCmd.SilenceUsage = true
+	pruneCmd.Flags().Bool("force", false, "Also remove branches that are not merged into trunk, and worktrees with local changes")
+	pruneCmd.Flags().Bool("dry-run", false, "List what would be removed without removing anything")
+}
+
+// pruneCandidate is the branch and worktree left behind by a finished work item.
+type pruneCandidate struct {
+	WorkItemID string
+	Status     string
+	Branch     string // "" when the branch no longer exists
+	Worktree   string // "" when no worktree has the branch checked out
+	Merged     bool   // the branch is merged into the trunk ref (true without a branch)
+}
+
+func runPrune(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+	force, _ := cmd.Flags().GetBool("force")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	currentRoot, err := getRepoRoot()
+	if err != nil {
+		return fmt.Errorf("failed to get repository root: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	mainPath, err := mainWorktreePath(ctx, currentRoot)
+	cancel()
+	if err != nil {
+		return err
+	}
+	trunkBranch, err := determineTrunkBranch(cfg, "", mainPath, false)
+	if err != nil {
+		return err
+	}
+	trunkRef := pruneTrunkRef(mainPath, resolveRemoteName(cfg, nil), trunkBranch)
+	candidates, err := collectPruneCandidates(cfg, mainPath, trunkRef)
+	if err != nil {
+		return err
+	}
+	return pruneWorkItems(os.Stdout, candidates, mainPath, currentRoot, trunkRef, force, dryRun)
+}
+
+// pruneTrunkRef returns the ref branches must be merged into to be pruned: <remote>/<trunk>,
+// since merged pull requests land there first, or the local trunk when the repository has no
+// such remote-tracking branch.
+func pruneTrunkRef(repoPath, remote, trunkBranch string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	remoteRef := remote + "/" + trunkBranch
+	if _, err := executeCommand(ctx, "git", []string{"rev-parse", "--verify", "--quiet", "refs/remotes/" + remoteRef}, repoPath, false); err != nil {
+		return trunkBranch
+	}
+	return remoteRef
+}
+
+// collectPruneCandidates returns the finished work items, in status folder order, that still
+// have a branch or a worktree in the repository at repoPath.
+func collectPruneCandidates(cfg *config.Config, repoPath, trunkRef string) ([]pruneCandidate, error) {
+	worktrees, err := worktreesByBranch(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []pruneCandidate
+	for _, status := range orderedStatuses(cfg, "") {
+		if !config.IsTerminalStatus(cfg, status) {
+			continue
+		}
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			candidate, ok := pruneCandidateFor(path, status, cfg, repoPath, trunkRef, worktrees)
+			if ok {
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// pruneCandidateFor finds the branch kira start created for the work item at path, whatever
+// title length it was started with, and its worktree, and reports whether the branch still exists.
+func pruneCandidateFor(path, status string, cfg *config.Config, repoPath, trunkRef string, worktrees map[string]string) (pruneCandidate, bool) {
+	_, id, title, _, _, err := extractWorkItemMetadata(path, cfg)
+	if err != nil || id == "" {
+		return pruneCandidate{}, false
+	}
+	branch, err := findStartBranch(repoPath, id, title)
+	if err != nil || branch == "" {
+		return pruneCandidate{}, false
+	}
+	return pruneCandidate{
+		WorkItemID: id,
+		Status:     status,
+		Branch:     branch,
+		Worktree:   worktrees[branch],
+		Merged:     isBranchMergedInto(branch, trunkRef, repoPath),
+	}, true
+}
+
+// worktreesByBranch maps each branch checked out in a linked worktree of the repository at
+// repoPath to that worktree's path. The main worktree is left out: it is never removed.
+func worktreesByBranch(repoPath string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	output, err := executeCommand(ctx, "git", []string{"worktree", "list", "--porcelain"}, repoPath, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worktrees: %w", err)
+	}
+	worktrees := make(map[string]string)
+	var path string
+	first := true
+	for _, line := range strings.Split(output, "\n") {
+		if value, ok := strings.CutPrefix(line, "worktree "); ok {
+			path = strings.TrimSpace(value)
+			continue
+		}
+		if line == "" && path != "" {
+			first = false
+			path = ""
+			continue
+		}
+		if branch, ok := strings.CutPrefix(line, "branch refs/heads/"); ok && !first {
+			worktrees[strings.TrimSpace(branch)] = path
+		}
+	}
+	return worktrees, nil
+}
+
+// isBranchMergedInto reports whether every commit of branch is reachable from trunkRef.
+func isBranchMergedInto(branch, trunkRef, repoPath string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	_, err := executeCommand(ctx, "git", []string{"merge-base", "--is-ancestor", branch, trunkRef}, repoPath, false)
+	return err == nil
+}
+
+// pruneWorkItems removes the worktree and then the branch of each candidate, writing what it
+// did (or, with dryRun, would do) to out. Unmerged branches are skipped with a warning on stderr
+// unless force is set, as is the worktree kira is running in. Failures are reported per work
+// item and returned together at the end.
+func pruneWorkItems(out io.Writer, candidates []pruneCandidate, repoPath, currentRoot, trunkRef string, force, dryRun bool) error {
+	pruned, failed := 0, 0
+	for _, candidate := range candidates {
+		if reason := pruneSkipReason(candidate, currentRoot, trunkRef, force); reason != "" {
+			fmt.Fprintf(os.Stderr, "Warning: skipping work item %s (%s): %s\n", candidate.WorkItemID, candidate.Status, reason)
+			continue
+		}
+		if dryRun {
+			_, _ = fmt.Fprintf(out, "[DRY RUN] Would prune work item %s (%s): %s\n", candidate.WorkItemID, candidate.Status, strings.Join(pruneActions(candidate, "remove worktree", "delete branch"), ", "))
+			continue
+		}
+		if err := pruneWorkItem(candidate, repoPath, force); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to prune work item %s: %v\n", candidate.WorkItemID, err)
+			failed++
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "Pruned work item %s (%s): %s\n", candidate.WorkItemID, candidate.Status, strings.Join(pruneActions(candidate, "removed worktree", "deleted branch"), ", "))
+		pruned++
+	}
+
+	switch {
+	case len(candidates) == 0:
+		_, _ = fmt.Fprintln(out, "Nothing to prune")
+	case !dryRun:
+		_, _ = fmt.Fprintf(out, "Pruned %d work item(s)\n", pruned)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to prune %d work item(s)", failed)
+	}
+	return nil
+}
+
+// pruneSkipReason returns why candidate must be kept, or "" when it can be pruned.
+func pruneSkipReason(candidate pruneCandidate, currentRoot, trunkRef string, force bool) string {
+	if candidate.Worktree != "" && samePath(candidate.Worktree, currentRoot) {
+		return fmt.Sprintf("kira is running in its worktree %s; run kira prune from another worktree", candidate.Worktree)
+	}
+	if !candidate.Merged && !force {
+		return fmt.Sprintf("branch %s is not merged into %s (use --force to remove it anyway)", candidate.Branch, trunkRef)
+	}
+	return ""
+}
+
+// pruneActions describes what pruning candidate removes, using the given verbs.
+func pruneActions(candidate pruneCandidate, worktreeVerb, branchVerb string) []string {
+	var actions []string
+	if candidate.Worktree != "" {
+		actions = append(actions, fmt.Sprintf("%s %s", worktreeVerb, candidate.Worktree))
+	}
+	if candidate.Branch != "" {
+		actions = append(actions, fmt.Sprintf("%s %s", branchVerb, candidate.Branch))
+	}
+	return actions
+}
+
+// pruneWorkItem removes the candidate's worktree and then its branch, since git refuses to delete
+// a branch that is checked out. The branch is deleted with -D: it was checked to be merged into the
+// trunk ref, which git branch -d does not know about. With force, worktrees with local changes and
+// unmerged branches are removed too.
+func pruneWorkItem(candidate pruneCandidate, repoPath string, force bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	if candidate.Worktree != "" {
+		args := []string{"worktree", "remove"}
+		if force {
+			args = append(args, "--force")
+		}
+		if _, err := executeCommand(ctx, "git", append(args, candidate.Worktree), repoPath, false); err != nil {
+			return fmt.Errorf("failed to remove worktree %s: %w", candidate.Worktree, err)
+		}
+	}
+	if candidate.Branch == "" {
+		return nil
+	}
+	if _, err := executeCommand(ctx, "git", []string{"branch", "-D", candidate.Branch}, repoPath, false); err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w", candidate.Branch, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+func TestPrune(t *testing.T) {
+	// setup creates a repository with done work items 001 (branch merged, with a worktree) and
+	// 002 (branch not merged), and 003 in doing whose branch must never be touched.
+	setup := func(t *testing.T) (cfg *config.Config, repoDir, worktree string) {
+		t.Helper()
+		setupGitConfigForCISerial(t)
+		setupListWorkspace(t, map[string]string{
+			"4_done/001-login.task.md":  listTestWorkItem("001", "Login", "done", ""),
+			"4_done/002-search.task.md": listTestWorkItem("002", "Search", "done", ""),
+			"2_doing/003-api.task.md":   listTestWorkItem("003", "API", "doing", ""),
+		})
+		dir, err := os.Getwd()
+		require.NoError(t, err)
+		repoDir, err = filepath.EvalSymlinks(dir)
+		require.NoError(t, err)
+		runGit(t, repoDir, "init", "-b", "main")
+		runGit(t, repoDir, "config", "user.email", "test@example.com")
+		runGit(t, repoDir, "config", "user.name", "Test")
+		runGit(t, repoDir, "add", ".")
+		runGit(t, repoDir, "commit", "-m", "initial")
+
+		worktreeRoot, err := filepath.EvalSymlinks(t.TempDir())
+		require.NoError(t, err)
+		worktree = filepath.Join(worktreeRoot, "001-login")
+		runGit(t, repoDir, "worktree", "add", "-b", "001-login", worktree, "main")
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, "login.txt"), []byte("login\n"), 0o600))
+		runGit(t, worktree, "add", "login.txt")
+		runGit(t, worktree, "commit", "-m", "login")
+		runGit(t, repoDir, "merge", "--no-ff", "-m", "merge login", "001-login")
+
+		for _, branch := range []string{"002-search", "003-api"} {
+			runGit(t, repoDir, "checkout", "-b", branch)
+			require.NoError(t, os.WriteFile(filepath.Join(repoDir, branch+".txt"), []byte(branch+"\n"), 0o600))
+			runGit(t, repoDir, "add", branch+".txt")
+			runGit(t, repoDir, "commit", "-m", branch)
+			runGit(t, repoDir, "checkout", "main")
+		}
+		return testCfgWithDir(repoDir), repoDir, worktree
+	}
+
+	t.Run("finds the branches and worktrees of done work items", func(t *testing.T) {
+		cfg, repoDir, worktree := setup(t)
+
+		candidates, err := collectPruneCandidates(cfg, repoDir, "main")
+		require.NoError(t, err)
+
+		assert.Equal(t, []pruneCandidate{
+			{WorkItemID: "001", Status: "done", Branch: "001-login", Worktree: worktree, Merged: true},
+			{WorkItemID: "002", Status: "done", Branch: "002-search", Merged: false},
+		}, candidates)
+	})
+
+	t.Run("removes merged branches with their worktrees and skips unmerged ones", func(t *testing.T) {
+		cfg, repoDir, worktree := setup(t)
+		candidates, err := collectPruneCandidates(cfg, repoDir, "main")
+		require.NoError(t, err)
+
+		out, err := captureStdout(func() error {
+			return pruneWorkItems(os.Stdout, candidates, repoDir, repoDir, "main", false, false)
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, out, "Pruned work item 001 (done): removed worktree "+worktree+", deleted branch 001-login")
+		assert.Contains(t, out, "Pruned 1 work item(s)")
+		assert.NotContains(t, out, "002")
+		assert.NoDirExists(t, worktree)
+		assertBranchExists(t, repoDir, "001-login", false)
+		assertBranchExists(t, repoDir, "002-search", true)
+		assertBranchExists(t, repoDir, "003-api", true)
+	})
+
+	t.Run("--force also removes unmerged branches", func(t *testing.T) {
+		cfg, repoDir, _ := setup(t)
+		candidates, err := collectPruneCandidates(cfg, repoDir, "main")
+		require.NoError(t, err)
+
+		out, err := captureStdout(func() error {
+			return pruneWorkItems(os.Stdout, candidates, repoDir, repoDir, "main", true, false)
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, out, "Pruned work item 002 (done): deleted branch 002-search")
+		assert.Contains(t, out, "Pruned 2 work item(s)")
+		assertBranchExists(t, repoDir, "002-search", false)
+		assertBranchExists(t, repoDir, "003-api", true)
+	})
+
+	t.Run("--dry-run lists candidates without removing them", func(t *testing.T) {
+		cfg, repoDir, worktree := setup(t)
+		candidates, err := collectPruneCandidates(cfg, repoDir, "main")
+		require.NoError(t, err)
+
+		out, err := captureStdout(func() error {
+			return pruneWorkItems(os.Stdout, candidates, repoDir, repoDir, "main", false, true)
+		})
+		require.NoError(t, err)
+
+		assert.Contains(t, out, "[DRY RUN] Would prune work item 001 (done): remove worktree "+worktree+", delete branch 001-login")
+		assert.NotContains(t, out, "Pruned")
+		assert.DirExists(t, worktree)
+		assertBranchExists(t, repoDir, "001-login", true)
+	})
+
+	t.Run("finds branches started with a shorter --max-title-length", func(t *testing.T) {
+		cfg, repoDir, _ := setup(t)
+		require.NoError(t, os.WriteFile(".work/4_done/004-report.task.md", []byte(listTestWorkItem("004", "Quarterly revenue report", "done", "")), 0o600))
+		slug, err := sanitizeTitle("Quarterly revenue report", "004", 16)
+		require.NoError(t, err)
+		runGit(t, repoDir, "branch", "004-"+slug)
+
+		candidates, err := collectPruneCandidates(cfg, repoDir, "main")
+		require.NoError(t, err)
+
+		require.Len(t, candidates, 3)
+		assert.Equal(t, pruneCandidate{WorkItemID: "004", Status: "done", Branch: "004-" + slug, Merged: true}, candidates[2])
+	})
+
+	t.Run("checks merges against the remote trunk", func(t *testing.T) {
+		cfg, repoDir, _ := setup(t)
+		assert.Equal(t, "main", pruneTrunkRef(repoDir, "origin", "main"), "no remote-tracking branch")
+
+		runGit(t, repoDir, "update-ref", "refs/remotes/origin/main", "002-search")
+		trunkRef := pruneTrunkRef(repoDir, "origin", "main")
+		require.Equal(t, "origin/main", trunkRef)
+
+		candidates, err := collectPruneCandidates(cfg, repoDir, trunkRef)
+		require.NoError(t, err)
+		require.Len(t, candidates, 2)
+		assert.True(t, candidates[1].Merged, "002-search is merged into origin/main, not main")
+	})
+
+	t.Run("keeps the worktree kira is running in", func(t *testing.T) {
+		candidate := pruneCandidate{WorkItemID: "001", Status: "done", Branch: "001-login", Worktree: "/tmp/wt/001-login", Merged: true}
+
+		reason := pruneSkipReason(candidate, "/tmp/wt/001-login", "main", true)
+
+		assert.Contains(t, reason, "kira is running in its worktree")
+	})
+}
+
+func assertBranchExists(t *testing.T, repoDir, branch string, want bool) {
+	t.Helper()
+	exists, err := branchExists(branch, repoDir, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, exists, "branch %s exists", branch)
+}
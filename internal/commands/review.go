@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
@@ -152,14 +151,14 @@ func runReviewMoveToReview(ctx *reviewContext, cfg *config.Config) (bool, error)
 		}
 	}
 	fmt.Println("Moving work item to review...")
-	if err := moveWorkItemWithoutCommit(cfg, ctx.WorkItemID, "review"); err != nil {
+	newPath, renumber, err := moveWorkItemWithoutCommit(cfg, ctx.WorkItemPath, "review")
+	if err != nil {
 		return false, fmt.Errorf("failed to move work item to review: %w", err)
 	}
-	reviewFolder := cfg.StatusFolders["review"]
-	if reviewFolder == "" {
-		reviewFolder = "3_review"
+	renumber.display(os.Stdout, false)
+	if renumber != nil {
+		moveMetadata.id = renumber.newID
 	}
-	newPath := filepath.Join(config.GetWorkFolderPath(cfg), reviewFolder, filepath.Base(ctx.WorkItemPath))
 	if reviewCommitMove(cfg) {
 		subject, body, err := buildCommitMessage(cfg, moveMetadata.workItemType, moveMetadata.id, moveMetadata.title, moveMetadata.currentStatus, "review")
 		if err != nil {
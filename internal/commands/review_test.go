@@ -104,6 +104,26 @@ func TestStatusFromWorkItemPath(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "", status)
 	})
+
+	t.Run("nested status folders resolve to the deepest folder", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		require.NoError(t, os.WriteFile("kira.yml", []byte("version: \"1.0\"\nstatus_folders:\n  archived: 4_done/archived\n"), 0o600))
+		require.NoError(t, os.MkdirAll(".work/4_done/archived", 0o700))
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			status, err := statusFromWorkItemPath(filepath.Join(".work", "4_done", "archived", "012-foo.prd.md"), cfg)
+			require.NoError(t, err)
+			assert.Equal(t, "archived", status)
+		}
+		status, err := statusFromWorkItemPath(filepath.Join(".work", "4_done", "012-foo.prd.md"), cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "done", status)
+	})
 }
 
 const reviewTestWorkItemPath = ".work/2_doing/012-foo.prd.md"
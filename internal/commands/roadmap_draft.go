@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"os/exec"
@@ -48,7 +47,6 @@ func init() {
 	roadmapDraftCmd.Flags().String("workstream", "", "Only include items in this workstream")
 	roadmapDraftCmd.Flags().String("status", "", "Only include items with these statuses (comma-separated)")
 	roadmapDraftCmd.Flags().Bool("include-all", false, "Include all items regardless of status")
-	roadmapPromoteCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
 }
 
 func runRoadmapDraft(cmd *cobra.Command, args []string) error {
@@ -221,8 +219,11 @@ func runRoadmapPromote(cmd *cobra.Command, args []string) error {
 		}
 		return fmt.Errorf("draft file: %w", err)
 	}
-	yes, _ := cmd.Flags().GetBool("yes")
-	if !yes && !promoteConfirm() {
+	confirmed, err := confirm("Promote draft to current roadmap? This will archive the current ROADMAP.yml.")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
 		return fmt.Errorf("aborted")
 	}
 
@@ -253,15 +254,6 @@ func preparePromotePaths(cfg *config.Config, name string) (roadmapDir, draftPath
 	return roadmapDir, draftPath, archivePath, nil
 }
 
-func promoteConfirm() bool {
-	fmt.Print("Promote draft to current roadmap? This will archive the current ROADMAP.yml. [y/N]: ")
-	scanner := bufio.NewScanner(os.Stdin)
-	if !scanner.Scan() {
-		return false
-	}
-	return strings.TrimSpace(strings.ToLower(scanner.Text())) == "y"
-}
-
 func archiveCurrentAndWriteDraft(roadmapDir, archivePath, draftPath string) error {
 	baseDir := filepath.Dir(roadmapDir)
 	if _, err := os.Stat(roadmapDir); err == nil {
@@ -16,16 +16,38 @@ var rootCmd = &cobra.Command{
 	Long: `Kira is a git-based, plaintext productivity tool designed with both
 clankers (LLMs) and meatbags (people) in mind. It uses markdown files, git,
 and a lightweight CLI to manage and coordinate work.`,
+	PersistentPreRunE: startCommandLog,
 }
 
-// Execute runs the root command and returns any error encountered.
+// ExitCodeError is an error after which kira should exit with Code instead of 1, e.g. the exit
+// code of the command run by kira start --exec.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// Execute runs the root command and returns any error encountered. With a log file configured,
+// the invocation is appended to it before returning.
 func Execute() error {
-	return rootCmd.Execute()
+	err := rootCmd.Execute()
+	if logErr := finishCommandLog(err); logErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to write log file: %v\n", logErr)
+	}
+	return err
 }
 
 func init() {
 	rootCmd.AddCommand(initCmd)
 	rootCmd.AddCommand(newCmd)
+	rootCmd.AddCommand(createCmd)
 	rootCmd.AddCommand(moveCmd)
 	rootCmd.AddCommand(ideaCmd)
 	rootCmd.AddCommand(assignCmd)
@@ -47,6 +69,18 @@ func init() {
 	rootCmd.AddCommand(currentCmd)
 	rootCmd.AddCommand(roadmapCmd)
 	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(showCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(pruneCmd)
+	rootCmd.AddCommand(fieldCmd)
+	rootCmd.AddCommand(blockedCmd)
+	rootCmd.AddCommand(searchCmd)
+
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Answer yes to all confirmation prompts (required to confirm when stdin is not a terminal)")
+	rootCmd.PersistentFlags().BoolVar(&assumeYes, "assume-yes", false, "Same as --yes")
+	rootCmd.PersistentFlags().StringVar(&commandLogFile, "log-file", "", "Append a JSON line describing this invocation to the given file (default: logging.file in kira.yml)")
 }
 
 func checkWorkDir(cfg *config.Config) error {
@@ -0,0 +1,230 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+// searchContextLines is the number of lines kira search prints before and after each match.
+const searchContextLines = 2
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Search the text of work items",
+	Long: `Searches every work item in .work/ (archived ones included) for query, case-insensitively,
+in the body and in the values of the front matter. Front matter keys are not searched, so
+"status" finds work items that mention a status, not every work item.
+
+Matches are printed like grep -C 2: the matching line as path:line:text, the two lines
+around it as path-line-text, and -- between groups. Files are read one line at a time,
+so large workspaces are not loaded into memory.
+
+--field restricts the search to the value of one front matter field (e.g. title); the
+body is not searched then. --regex treats query as a Go regular expression, matched as
+written: add (?i) for a case-insensitive match.
+
+Examples:
+  kira search "payment gateway"
+  kira search login --field title
+  kira search --regex 'TODO|FIXME'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	searchCmd.Flags().String("field", "", "Only search the value of this front matter field (e.g. title)")
+	searchCmd.Flags().Bool("regex", false, "Treat the query as a Go regular expression")
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+	field, _ := cmd.Flags().GetString("field")
+	regex, _ := cmd.Flags().GetBool("regex")
+
+	match, err := newSearchMatcher(args[0], regex)
+	if err != nil {
+		return err
+	}
+	matches, err := searchWorkItems(os.Stdout, cfg, match, field)
+	if err != nil {
+		return err
+	}
+	if matches == 0 {
+		fmt.Println("No matches.")
+	}
+	return nil
+}
+
+// newSearchMatcher returns the function that reports whether a text matches query.
+func newSearchMatcher(query string, regex bool) (func(string) bool, error) {
+	if regex {
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --regex query '%s': %w", query, err)
+		}
+		return re.MatchString, nil
+	}
+	query = strings.ToLower(query)
+	return func(text string) bool { return strings.Contains(strings.ToLower(text), query) }, nil
+}
+
+// searchWorkItems prints the matches in every work item file under the work folder and returns
+// how many lines matched. Templates and IDEAS.md are skipped.
+func searchWorkItems(out io.Writer, cfg *config.Config, match func(string) bool, field string) (int, error) {
+	printer := &searchPrinter{out: out}
+	err := filepath.WalkDir(config.GetWorkFolderPath(cfg), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".md") || strings.Contains(path, "template") || strings.HasSuffix(path, "IDEAS.md") {
+			return nil
+		}
+		return searchWorkItemFile(printer, path, cfg, match, field)
+	})
+	if err != nil {
+		return printer.matches, fmt.Errorf("failed to search work items: %w", err)
+	}
+	return printer.matches, nil
+}
+
+// searchWorkItemFile streams one work item through printer.
+func searchWorkItemFile(printer *searchPrinter, path string, cfg *config.Config, match func(string) bool, field string) error {
+	if err := validateWorkPath(path, cfg); err != nil {
+		return err
+	}
+	// #nosec G304 - path has been validated by validateWorkPath above
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	printer.startFile(path)
+	var scope searchScope
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		text, searched := scope.next(line, field)
+		printer.add(scope.lineNo, line, searched && match(text))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
+
+// searchScope tracks where the lines of a work item are: in the front matter, and under which
+// top-level field, or in the body.
+type searchScope struct {
+	lineNo        int
+	inFrontMatter bool
+	key           string // the front matter field the current line belongs to
+}
+
+// next advances to line and returns the text of it to search, and whether it is searched at all
+// (separators are not, and with field only that field's value is).
+func (s *searchScope) next(line, field string) (string, bool) {
+	s.lineNo++
+	trimmed := strings.TrimSpace(line)
+	if trimmed == yamlSeparator && (s.lineNo == 1 || s.inFrontMatter) {
+		s.inFrontMatter = s.lineNo == 1
+		return "", false
+	}
+	if !s.inFrontMatter {
+		return line, field == ""
+	}
+
+	value := strings.TrimSpace(strings.TrimPrefix(trimmed, "- "))
+	if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "-") {
+		if key, rest, ok := strings.Cut(trimmed, ":"); ok {
+			s.key = strings.TrimSpace(key)
+			value = strings.TrimSpace(rest)
+		}
+	}
+	if field != "" && s.key != field {
+		return "", false
+	}
+	value = strings.Trim(value, `"'`)
+	return value, value != ""
+}
+
+// searchLine is a line of a work item held back as context for a match that may follow.
+type searchLine struct {
+	number int
+	text   string
+}
+
+// searchPrinter prints matching lines with searchContextLines of context around them, in grep's
+// format, holding back only the lines that may precede the next match.
+type searchPrinter struct {
+	out         io.Writer
+	path        string
+	before      []searchLine // lines not printed yet that precede the current one
+	after       int          // context lines still to print after the last match
+	lastPrinted int          // number of the last printed line of the current file; 0 when none
+	printedAny  bool
+	matches     int
+}
+
+// startFile starts the lines of another file.
+func (p *searchPrinter) startFile(path string) {
+	p.path = path
+	p.before = p.before[:0]
+	p.after = 0
+	p.lastPrinted = 0
+}
+
+// add takes the next line of the current file.
+func (p *searchPrinter) add(number int, text string, matched bool) {
+	if !matched {
+		if p.after > 0 {
+			p.print(number, text, "-")
+			p.after--
+			return
+		}
+		p.before = append(p.before, searchLine{number: number, text: text})
+		if len(p.before) > searchContextLines {
+			p.before = p.before[1:]
+		}
+		return
+	}
+
+	first := number
+	if len(p.before) > 0 {
+		first = p.before[0].number
+	}
+	if p.printedAny && (p.lastPrinted == 0 || first > p.lastPrinted+1) {
+		_, _ = fmt.Fprintln(p.out, "--")
+	}
+	for _, line := range p.before {
+		p.print(line.number, line.text, "-")
+	}
+	p.before = p.before[:0]
+	p.print(number, text, ":")
+	p.after = searchContextLines
+	p.matches++
+}
+
+func (p *searchPrinter) print(number int, text, sep string) {
+	_, _ = fmt.Fprintf(p.out, "%s%s%d%s%s\n", p.path, sep, number, sep, text)
+	p.lastPrinted = number
+	p.printedAny = true
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+func TestSearch(t *testing.T) {
+	files := map[string]string{
+		"1_todo/001-payments.task.md": "---\nid: 001\ntitle: Payment gateway\nstatus: todo\nkind: task\ntags:\n  - billing\n---\n\n# Payment gateway\n\nOne\nTwo\nWe call the Payment Gateway here.\nThree\nFour\nFive\nSix\nThe gateway retries.\n",
+		"2_doing/002-login.task.md":   listTestWorkItem("002", "Login", "doing", "notes: needs billing review\n"),
+	}
+	search := func(t *testing.T, query, field string, regex bool) (string, int) {
+		t.Helper()
+		setupListWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		match, err := newSearchMatcher(query, regex)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		matches, err := searchWorkItems(&out, cfg, match, field)
+		require.NoError(t, err)
+		return out.String(), matches
+	}
+
+	t.Run("finds the query case-insensitively with two lines of context", func(t *testing.T) {
+		output, matches := search(t, "payment gateway", "", false)
+
+		assert.Equal(t, 3, matches)
+		assert.Contains(t, output, ".work/1_todo/001-payments.task.md:3:title: Payment gateway\n")
+		assert.Contains(t, output, ".work/1_todo/001-payments.task.md-11-\n"+
+			".work/1_todo/001-payments.task.md-12-One\n"+
+			".work/1_todo/001-payments.task.md-13-Two\n"+
+			".work/1_todo/001-payments.task.md:14:We call the Payment Gateway here.\n"+
+			".work/1_todo/001-payments.task.md-15-Three\n"+
+			".work/1_todo/001-payments.task.md-16-Four\n")
+		assert.NotContains(t, output, "002-login")
+	})
+
+	t.Run("separates groups that are not adjacent", func(t *testing.T) {
+		output, matches := search(t, "gateway retries", "", false)
+
+		assert.Equal(t, 1, matches)
+		assert.Equal(t, ".work/1_todo/001-payments.task.md-17-Five\n"+
+			".work/1_todo/001-payments.task.md-18-Six\n"+
+			".work/1_todo/001-payments.task.md:19:The gateway retries.\n", output)
+
+		output, _ = search(t, "Two|retries", "", true)
+		assert.Contains(t, output, "-15-Three\n--\n.work/1_todo/001-payments.task.md-17-Five\n")
+
+		output, _ = search(t, "Four|retries", "", true)
+		assert.NotContains(t, output, "--", "overlapping context forms one group")
+		assert.Contains(t, output, ":16:Four\n")
+	})
+
+	t.Run("searches front matter values, not keys", func(t *testing.T) {
+		output, matches := search(t, "billing", "", false)
+
+		assert.Equal(t, 2, matches)
+		assert.Contains(t, output, ".work/1_todo/001-payments.task.md:7:  - billing\n")
+		assert.Contains(t, output, "\n--\n")
+		assert.Contains(t, output, ".work/2_doing/002-login.task.md:6:notes: needs billing review\n")
+
+		_, matches = search(t, "kind", "", false)
+		assert.Equal(t, 0, matches)
+	})
+
+	t.Run("restricts the search to one field", func(t *testing.T) {
+		output, matches := search(t, "gateway", "title", false)
+
+		assert.Equal(t, 1, matches)
+		assert.Contains(t, output, ":3:title: Payment gateway\n")
+
+		_, matches = search(t, "billing", "tags", false)
+		assert.Equal(t, 1, matches)
+	})
+
+	t.Run("regex queries are matched as written", func(t *testing.T) {
+		_, matches := search(t, "payment gateway", "", true)
+		assert.Equal(t, 0, matches)
+
+		_, matches = search(t, "(?i)payment gateway", "", true)
+		assert.Equal(t, 3, matches)
+
+		_, err := newSearchMatcher("(", true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid --regex query '('")
+	})
+}
@@ -0,0 +1,375 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show <work-item-id>",
+	Short: "Show a work item",
+	Long: `Shows a work item's front matter fields followed by its markdown body.
+
+The work item can be given by ID (e.g. 001) or by path under the .work/ directory.
+--field and --section select parts of the work item: only the selected fields and/or
+body section are shown. --raw prints the file exactly as it is on disk, without parsing,
+which helps when the front matter does not parse as expected. --path prints only the
+work item's current file path. --context appends the work items the body references as
+#NNN or [[NNN]] (ID, title and status), noting references that do not resolve.
+
+--output json (the same as --json) prints {"id", "title", "path", "fields", "body"} for
+scripts and agents. id is always a string, even when the front matter has id: 1, and
+body is "" for a work item without one.
+
+IDs, not paths, are the stable handle for a work item: renaming its title changes the
+file name and moving it changes the folder, but the ID stays. A path that no longer
+exists is re-resolved by the ID its file name starts with; scripts can run
+kira show <id> --path to look up the current path.
+
+Examples:
+  kira show 001                        # Front matter fields and body
+  kira show 001 --no-body              # Front matter fields only
+  kira show 001 --body-only            # Body only
+  kira show 001 --field status --field assigned
+  kira show 001 --section "Requirements"
+  kira show 001 --json                 # {"id", "title", "path", "fields", "body"}
+  kira show 001 --output json          # Same as --json
+  kira show 001 --raw                  # The file's bytes, unmodified
+  kira show 001 --path                 # The current path of the work item file
+  kira show 001 --context              # Also list the work items referenced in the body`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstWorkItemID,
+	RunE:              runShow,
+}
+
+func init() {
+	showCmd.Flags().Bool("json", false, "Output as JSON")
+	showCmd.Flags().Bool("no-body", false, "Only show the front matter fields (with --json, omit the body key)")
+	showCmd.Flags().Bool("body-only", false, "Only show the body, without front matter")
+	showCmd.Flags().StringSlice("field", nil, "Only show this front matter field (repeatable; parent.child for nested fields)")
+	showCmd.Flags().String("section", "", "Only show the body section under this markdown heading")
+	showCmd.Flags().Bool("raw", false, "Print the work item file verbatim, without parsing or formatting")
+	showCmd.Flags().Bool("path", false, "Print only the current path of the work item file")
+	showCmd.Flags().Bool("context", false, "Append the work items referenced in the body as #NNN or [[NNN]] (with --json, under related)")
+	showCmd.Flags().String("output", outputFormatText, "Output format: text or json (same as --json)")
+}
+
+// showOptions holds the presentation switches of kira show.
+type showOptions struct {
+	JSON     bool
+	NoBody   bool
+	BodyOnly bool
+	Fields   []string
+	Section  string
+	Raw      bool
+	Path     bool
+	Context  bool
+}
+
+// showView is the part of a work item selected for display.
+type showView struct {
+	ID         string
+	Title      string
+	Path       string
+	Fields     map[string]interface{}
+	FieldOrder []string
+	Body       string
+	ShowFields bool
+	ShowBody   bool
+	// Related are the work items referenced in the body, with --context (nil otherwise)
+	Related []relatedWorkItem
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	opts, err := parseShowOptions(cmd)
+	if err != nil {
+		return err
+	}
+	if err := validateShowOptions(opts); err != nil {
+		return err
+	}
+
+	path, err := resolveWorkItemPath(args[0], cfg)
+	if err != nil {
+		return err
+	}
+	if err := validateWorkItemFile(path, cfg); err != nil {
+		return err
+	}
+	if opts.Path {
+		fmt.Println(path)
+		return nil
+	}
+	if opts.Raw {
+		return displayRawWorkItem(os.Stdout, path, cfg)
+	}
+	frontMatter, bodyLines, err := parseWorkItemFrontMatter(path, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to parse work item %s: %w", args[0], err)
+	}
+
+	view, err := buildShowView(path, frontMatter, bodyLines, opts)
+	if err != nil {
+		return err
+	}
+	if opts.Context {
+		view.Related = resolveRelatedWorkItems(workItemReferences(bodyLines, view.ID), cfg)
+	}
+	if opts.JSON {
+		return displayShowViewJSON(os.Stdout, view)
+	}
+	return displayShowView(os.Stdout, view)
+}
+
+func parseShowOptions(cmd *cobra.Command) (showOptions, error) {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	output, _ := cmd.Flags().GetString("output")
+	jsonOutput, err := resolveJSONOutput(output, jsonOutput)
+	if err != nil {
+		return showOptions{}, err
+	}
+	noBody, _ := cmd.Flags().GetBool("no-body")
+	bodyOnly, _ := cmd.Flags().GetBool("body-only")
+	fields, _ := cmd.Flags().GetStringSlice("field")
+	section, _ := cmd.Flags().GetString("section")
+	raw, _ := cmd.Flags().GetBool("raw")
+	pathOnly, _ := cmd.Flags().GetBool("path")
+	related, _ := cmd.Flags().GetBool("context")
+	return showOptions{
+		JSON:     jsonOutput,
+		NoBody:   noBody,
+		BodyOnly: bodyOnly,
+		Fields:   fields,
+		Section:  section,
+		Raw:      raw,
+		Path:     pathOnly,
+		Context:  related,
+	}, nil
+}
+
+func validateShowOptions(opts showOptions) error {
+	if opts.Path && (opts.Raw || showSelectsParts(opts)) {
+		return fmt.Errorf("invalid flag combination: --path cannot be used together with --raw, --json, --no-body, --body-only, --field, --section or --context")
+	}
+	if opts.Raw && showSelectsParts(opts) {
+		return fmt.Errorf("invalid flag combination: --raw cannot be used together with --json, --no-body, --body-only, --field, --section or --context")
+	}
+	if opts.NoBody && opts.BodyOnly {
+		return fmt.Errorf("invalid flag combination: --no-body cannot be used together with --body-only")
+	}
+	if opts.NoBody && opts.Section != "" {
+		return fmt.Errorf("invalid flag combination: --no-body cannot be used together with --section")
+	}
+	if opts.BodyOnly && len(opts.Fields) > 0 {
+		return fmt.Errorf("invalid flag combination: --body-only cannot be used together with --field")
+	}
+	return nil
+}
+
+// showSelectsParts reports whether opts format or select parts of the parsed work item, which
+// --raw and --path cannot be combined with.
+func showSelectsParts(opts showOptions) bool {
+	return opts.JSON || opts.NoBody || opts.BodyOnly || len(opts.Fields) > 0 || opts.Section != "" || opts.Context
+}
+
+// displayRawWorkItem writes the work item file to out byte for byte.
+func displayRawWorkItem(out io.Writer, path string, cfg *config.Config) error {
+	content, err := safeReadFile(path, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to read work item file: %w", err)
+	}
+	_, err = out.Write(content)
+	return err
+}
+
+// buildShowView selects the fields and body to show. Without --field or --section the whole
+// work item is shown, minus the body (--no-body) or the fields (--body-only).
+func buildShowView(path string, frontMatter map[string]interface{}, bodyLines []string, opts showOptions) (showView, error) {
+	id := frontMatterIDString(frontMatter["id"])
+	view := showView{
+		ID:     id,
+		Title:  frontMatterString(frontMatter["title"]),
+		Path:   path,
+		Fields: frontMatter,
+		Body:   strings.Trim(strings.Join(bodyLines, "\n"), "\n"),
+	}
+
+	selecting := len(opts.Fields) > 0 || opts.Section != ""
+	view.ShowFields = !opts.BodyOnly && (!selecting || len(opts.Fields) > 0)
+	view.ShowBody = !opts.NoBody && (!selecting || opts.Section != "")
+	view.FieldOrder = orderedFrontMatterKeys(frontMatter)
+
+	if len(opts.Fields) > 0 {
+		view.Fields = make(map[string]interface{}, len(opts.Fields))
+		view.FieldOrder = nil
+		for _, name := range opts.Fields {
+			value, ok := lookupFrontMatterField(frontMatter, name)
+			if !ok {
+				return showView{}, fmt.Errorf("field '%s' not found in work item %s", name, id)
+			}
+			view.Fields[name] = value
+			view.FieldOrder = append(view.FieldOrder, name)
+		}
+	}
+	if opts.Section != "" {
+		section, ok := extractMarkdownSection(bodyLines, opts.Section)
+		if !ok {
+			return showView{}, fmt.Errorf("section '%s' not found in work item %s", opts.Section, id)
+		}
+		view.Body = section
+	}
+	return view, nil
+}
+
+// lookupFrontMatterField returns a front matter field, supporting parent.child for nested maps.
+func lookupFrontMatterField(frontMatter map[string]interface{}, name string) (interface{}, bool) {
+	if value, ok := frontMatter[name]; ok {
+		return value, true
+	}
+	parentName, childName, nested := strings.Cut(name, ".")
+	if !nested {
+		return nil, false
+	}
+	parent, ok := frontMatter[parentName].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	return lookupFrontMatterField(parent, childName)
+}
+
+// extractMarkdownSection returns the body section under the given heading (matched case-insensitively,
+// any heading level), up to the next heading of the same or a higher level. Headings inside code
+// fences are ignored.
+func extractMarkdownSection(bodyLines []string, heading string) (string, bool) {
+	want := strings.ToLower(strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(heading), "#")))
+	level := 0
+	var section []string
+	inCodeBlock := false
+
+	for _, line := range bodyLines {
+		trimmed := strings.TrimSpace(line)
+		inCodeBlock = updateCodeBlockState(inCodeBlock, trimmed)
+		lineLevel, title := markdownHeading(trimmed)
+		if !inCodeBlock && lineLevel > 0 {
+			if level > 0 && lineLevel <= level {
+				break
+			}
+			if level == 0 && strings.ToLower(title) == want {
+				level = lineLevel
+			}
+		}
+		if level > 0 {
+			section = append(section, line)
+		}
+	}
+	if level == 0 {
+		return "", false
+	}
+	return strings.Trim(strings.Join(section, "\n"), "\n"), true
+}
+
+// markdownHeading returns the level and text of an ATX heading line, or 0 when the line is not a heading.
+func markdownHeading(trimmed string) (int, string) {
+	level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+	if level == 0 || level > 6 || (len(trimmed) > level && trimmed[level] != ' ') {
+		return 0, ""
+	}
+	return level, strings.TrimSpace(trimmed[level:])
+}
+
+func displayShowView(w io.Writer, view showView) error {
+	if view.ShowFields {
+		var sb strings.Builder
+		for _, key := range view.FieldOrder {
+			if err := writeYAMLFieldValue(&sb, key, view.Fields[key]); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, sb.String()); err != nil {
+			return err
+		}
+	}
+	if view.ShowBody && view.Body != "" {
+		if view.ShowFields {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w, view.Body); err != nil {
+			return err
+		}
+	}
+	if view.Related != nil {
+		return displayRelatedWorkItems(w, view.Related)
+	}
+	return nil
+}
+
+func displayShowViewJSON(w io.Writer, view showView) error {
+	output := map[string]interface{}{
+		"id":    view.ID,
+		"title": view.Title,
+		"path":  view.Path,
+	}
+	if view.ShowFields {
+		fields, _ := jsonSafeValue(view.Fields).(map[string]interface{})
+		if _, ok := fields["id"]; ok {
+			// id: 001 parses as the number 1; report it as the same string as the top-level id
+			fields["id"] = view.ID
+		}
+		output["fields"] = fields
+	}
+	if view.ShowBody {
+		output["body"] = view.Body
+	}
+	if view.Related != nil {
+		output["related"] = view.Related
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+// jsonSafeValue converts a front matter value into one encoding/json can encode: maps with
+// non-string keys (e.g. {1: a}) get their keys formatted as strings, recursively.
+func jsonSafeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[key] = jsonSafeValue(item)
+		}
+		return converted
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = jsonSafeValue(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = jsonSafeValue(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,87 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// workItemReferenceRegexp matches references to other work items in a body: #012 (not inside
+// a word or an HTML entity such as &#123;) and [[012]].
+var workItemReferenceRegexp = regexp.MustCompile(`(?:^|[^\w&#])#(\d+)\b|\[\[(\d+)\]\]`)
+
+// relatedWorkItem is a work item referenced from the body of the one shown (--context).
+type relatedWorkItem struct {
+	ID     string `json:"id"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// workItemReferences returns the IDs referenced in bodyLines as #NNN or [[NNN]], in order of
+// first mention and without selfID. References in fenced code blocks are ignored.
+func workItemReferences(bodyLines []string, selfID string) []string {
+	seen := map[string]bool{selfID: true}
+	var ids []string
+	inCodeBlock := false
+	for _, line := range bodyLines {
+		inCodeBlock = updateCodeBlockState(inCodeBlock, strings.TrimSpace(line))
+		if inCodeBlock {
+			continue
+		}
+		for _, match := range workItemReferenceRegexp.FindAllStringSubmatch(line, -1) {
+			id := match[1] + match[2]
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// resolveRelatedWorkItems looks up each referenced ID. IDs that do not resolve to a readable
+// work item are kept with the reason in Error.
+func resolveRelatedWorkItems(ids []string, cfg *config.Config) []relatedWorkItem {
+	related := make([]relatedWorkItem, 0, len(ids))
+	for _, id := range ids {
+		path, err := resolveWorkItemPath(id, cfg)
+		if err != nil {
+			related = append(related, relatedWorkItem{ID: id, Error: err.Error()})
+			continue
+		}
+		_, _, title, status, _, err := extractWorkItemMetadata(path, cfg)
+		if err != nil {
+			related = append(related, relatedWorkItem{ID: id, Path: path, Error: err.Error()})
+			continue
+		}
+		related = append(related, relatedWorkItem{ID: id, Title: title, Status: status, Path: path})
+	}
+	return related
+}
+
+// displayRelatedWorkItems writes the "Related:" list kira show --context appends to its output.
+func displayRelatedWorkItems(w io.Writer, related []relatedWorkItem) error {
+	if _, err := fmt.Fprintln(w, "\nRelated:"); err != nil {
+		return err
+	}
+	if len(related) == 0 {
+		_, err := fmt.Fprintln(w, "  (no referenced work items)")
+		return err
+	}
+	for _, item := range related {
+		line := fmt.Sprintf("  %s  %s (%s)", item.ID, item.Title, item.Status)
+		if item.Error != "" {
+			line = fmt.Sprintf("  %s  (unresolved: %s)", item.ID, item.Error)
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const showTestWorkItem = `---
+id: 001
+title: Show me
+status: todo
+kind: prd
+created: 2024-01-01
+assigned: alice@example.com
+metadata:
+  owner: bob@example.com
+---
+
+# Show me
+
+## Context
+Some context.
+
+` + "```" + `
+## Not a heading
+` + "```" + `
+
+### Detail
+Nested detail.
+
+## Requirements
+- one
+`
+
+// runShowCapture runs kira show for the given work item with flags and returns stdout.
+func runShowCapture(t *testing.T, id string, flags map[string][]string) (string, error) {
+	t.Helper()
+	for name, values := range flags {
+		for _, value := range values {
+			require.NoError(t, showCmd.Flags().Set(name, value))
+		}
+	}
+	t.Cleanup(func() {
+		for name := range flags {
+			flag := showCmd.Flags().Lookup(name)
+			if slice, ok := flag.Value.(interface{ Replace([]string) error }); ok {
+				_ = slice.Replace(nil)
+			} else {
+				_ = flag.Value.Set(flag.DefValue)
+			}
+			flag.Changed = false
+		}
+	})
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+	runErr := runShow(showCmd, []string{id})
+	_ = w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	require.NoError(t, err)
+	return buf.String(), runErr
+}
+
+func TestRunShow(t *testing.T) {
+	files := map[string]string{"1_todo/001-show-me.prd.md": showTestWorkItem}
+
+	t.Run("shows fields and body", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, output, "id: 001\ntitle: Show me\n")
+		assert.Contains(t, output, "assigned: alice@example.com")
+		assert.Contains(t, output, "## Requirements")
+	})
+
+	t.Run("no-body shows only fields", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"no-body": {"true"}})
+		require.NoError(t, err)
+
+		assert.Contains(t, output, "status: todo")
+		assert.NotContains(t, output, "Some context")
+	})
+
+	t.Run("body-only shows only the body", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"body-only": {"true"}})
+		require.NoError(t, err)
+
+		assert.NotContains(t, output, "status: todo")
+		assert.True(t, len(output) > 0 && output[0] == '#', "body should start at the title heading: %q", output)
+		assert.Contains(t, output, "Some context")
+	})
+
+	t.Run("json with no-body omits the body key", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"json": {"true"}, "no-body": {"true"}})
+		require.NoError(t, err)
+
+		var parsed map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		assert.Equal(t, "001", parsed["id"])
+		assert.NotContains(t, parsed, "body")
+		fields, ok := parsed["fields"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "todo", fields["status"])
+	})
+
+	t.Run("json with field and section", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{
+			"json":    {"true"},
+			"field":   {"status", "metadata.owner"},
+			"section": {"context"},
+		})
+		require.NoError(t, err)
+
+		var parsed struct {
+			Fields map[string]interface{} `json:"fields"`
+			Body   string                 `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		assert.Equal(t, map[string]interface{}{"status": "todo", "metadata.owner": "bob@example.com"}, parsed.Fields)
+		assert.Contains(t, parsed.Body, "## Context")
+		assert.Contains(t, parsed.Body, "## Not a heading", "headings in code fences do not end the section")
+		assert.Contains(t, parsed.Body, "Nested detail")
+		assert.NotContains(t, parsed.Body, "Requirements")
+	})
+
+	t.Run("field alone shows only that field", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"field": {"assigned"}})
+		require.NoError(t, err)
+		assert.Equal(t, "assigned: alice@example.com\n", output)
+	})
+
+	t.Run("errors on missing field", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		_, err := runShowCapture(t, "001", map[string][]string{"field": {"reviewer"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'reviewer' not found in work item 001")
+	})
+
+	t.Run("errors on missing section", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		_, err := runShowCapture(t, "001", map[string][]string{"section": {"Nope"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "section 'Nope' not found")
+	})
+
+	t.Run("raw prints the file verbatim", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"raw": {"true"}})
+		require.NoError(t, err)
+		assert.Equal(t, showTestWorkItem, output)
+	})
+
+	t.Run("raw prints a file whose front matter does not parse", func(t *testing.T) {
+		broken := "---\r\nid: 002\r\ntitle: [unclosed\r\n---\r\n\tBody\r\n"
+		setupListWorkspace(t, map[string]string{"1_todo/002-broken.prd.md": broken})
+		output, err := runShowCapture(t, ".work/1_todo/002-broken.prd.md", map[string][]string{"raw": {"true"}})
+		require.NoError(t, err)
+		assert.Equal(t, broken, output)
+	})
+
+	t.Run("raw errors when the file does not exist", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		_, err := runShowCapture(t, ".work/1_todo/404-missing.prd.md", map[string][]string{"raw": {"true"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item file does not exist")
+	})
+
+	t.Run("path prints the current path of the work item", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "001", map[string][]string{"path": {"true"}})
+		require.NoError(t, err)
+		expected, err := filepath.Abs(filepath.Join(".work", "1_todo", "001-show-me.prd.md"))
+		require.NoError(t, err)
+		assert.Equal(t, expected+"\n", output)
+	})
+
+	t.Run("prefers the id over a path that now holds another work item", func(t *testing.T) {
+		renumbered := strings.Replace(showTestWorkItem, "id: 001", "id: 002", 1)
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-show-me.prd.md":  renumbered,
+			"2_doing/001-show-me.prd.md": showTestWorkItem,
+		})
+		output, err := runShowCapture(t, ".work/1_todo/001-show-me.prd.md", map[string][]string{"path": {"true"}})
+		require.NoError(t, err)
+		expected, err := filepath.Abs(filepath.Join(".work", "2_doing", "001-show-me.prd.md"))
+		require.NoError(t, err)
+		assert.Equal(t, expected+"\n", output)
+	})
+
+	t.Run("re-resolves a path whose title or folder changed by its id", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{"2_doing/001-show-me-renamed.prd.md": showTestWorkItem})
+		output, err := runShowCapture(t, ".work/1_todo/001-show-me.prd.md", map[string][]string{"path": {"true"}})
+		require.NoError(t, err)
+		expected, err := filepath.Abs(filepath.Join(".work", "2_doing", "001-show-me-renamed.prd.md"))
+		require.NoError(t, err)
+		assert.Equal(t, expected+"\n", output)
+	})
+
+	t.Run("rejects conflicting flags", func(t *testing.T) {
+		assert.Error(t, validateShowOptions(showOptions{Path: true, Raw: true}))
+		assert.Error(t, validateShowOptions(showOptions{Path: true, JSON: true}))
+		assert.Error(t, validateShowOptions(showOptions{Raw: true, JSON: true}))
+		assert.Error(t, validateShowOptions(showOptions{Raw: true, Section: "Context"}))
+		assert.Error(t, validateShowOptions(showOptions{NoBody: true, BodyOnly: true}))
+		assert.Error(t, validateShowOptions(showOptions{NoBody: true, Section: "Context"}))
+		assert.Error(t, validateShowOptions(showOptions{BodyOnly: true, Fields: []string{"status"}}))
+		assert.NoError(t, validateShowOptions(showOptions{JSON: true, Fields: []string{"status"}, Section: "Context"}))
+	})
+}
+
+func TestRunShowOutputJSON(t *testing.T) {
+	parse := func(t *testing.T, output string) map[string]interface{} {
+		t.Helper()
+		var parsed map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed), output)
+		return parsed
+	}
+
+	t.Run("output json matches json", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{"1_todo/001-show-me.prd.md": showTestWorkItem})
+		viaOutput, err := runShowCapture(t, "001", map[string][]string{"output": {"json"}})
+		require.NoError(t, err)
+		viaJSON, err := runShowCapture(t, "001", map[string][]string{"json": {"true"}})
+		require.NoError(t, err)
+		assert.Equal(t, viaJSON, viaOutput)
+
+		parsed := parse(t, viaOutput)
+		assert.Equal(t, "001", parsed["id"])
+		assert.Equal(t, "Show me", parsed["title"])
+		assert.Contains(t, parsed["body"], "## Requirements")
+	})
+
+	t.Run("integer and string ids are both strings", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{
+			"1_todo/001-int.task.md":    "---\nid: 001\ntitle: Int\n---\n",
+			"1_todo/002-string.task.md": "---\nid: \"002\"\ntitle: String\n---\n",
+		})
+		for _, id := range []string{"001", "002"} {
+			output, err := runShowCapture(t, id, map[string][]string{"output": {"json"}})
+			require.NoError(t, err)
+			parsed := parse(t, output)
+			assert.Equal(t, id, parsed["id"])
+			assert.Equal(t, id, parsed["fields"].(map[string]interface{})["id"], "fields.id has the same type as id")
+		}
+	})
+
+	t.Run("a work item without a body has an empty body", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{"1_todo/003-empty.task.md": "---\nid: \"003\"\ntitle: Empty\n---\n"})
+		output, err := runShowCapture(t, "003", map[string][]string{"output": {"json"}})
+		require.NoError(t, err)
+		assert.Equal(t, "", parse(t, output)["body"])
+	})
+
+	t.Run("binary values and non-string map keys still encode", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{
+			"1_todo/004-odd.task.md": "---\nid: \"004\"\ntitle: Odd\nblob: !!binary gIGC\nestimates:\n  1: small\n  2: large\n---\nBody\n",
+		})
+		output, err := runShowCapture(t, "004", map[string][]string{"output": {"json"}})
+		require.NoError(t, err)
+		fields, ok := parse(t, output)["fields"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"1": "small", "2": "large"}, fields["estimates"])
+		assert.NotEmpty(t, fields["blob"])
+	})
+
+	t.Run("rejects other output formats", func(t *testing.T) {
+		setupListWorkspace(t, map[string]string{"1_todo/001-show-me.prd.md": showTestWorkItem})
+		_, err := runShowCapture(t, "001", map[string][]string{"output": {"yaml"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid output format")
+	})
+}
+
+func TestRunShowContext(t *testing.T) {
+	parent := "---\nid: 010\ntitle: Parent\nstatus: doing\nkind: prd\n---\n\n# Parent\n\n" +
+		"Split into #011 and [[012]], see also #099 and #011 again.\n" +
+		"Not references: &#013; issue#014 #010\n\n" +
+		"```\n#015\n```\n"
+	files := map[string]string{
+		"2_doing/010-parent.prd.md":    parent,
+		"1_todo/011-child-one.task.md": listTestWorkItem("011", "Child one", "todo", ""),
+		"4_done/012-child-two.task.md": listTestWorkItem("012", "Child two", "done", ""),
+		"1_todo/013-entity.task.md":    listTestWorkItem("013", "Entity", "todo", ""),
+		"1_todo/015-fenced.task.md":    listTestWorkItem("015", "Fenced", "todo", ""),
+	}
+
+	t.Run("appends the referenced work items", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "010", map[string][]string{"context": {"true"}})
+		require.NoError(t, err)
+
+		assert.Contains(t, output, "# Parent")
+		assert.Contains(t, output, "\nRelated:\n  011  Child one (todo)\n  012  Child two (done)\n  099  (unresolved: work item 099 not found)\n")
+		assert.NotContains(t, output, "013  Entity")
+		assert.NotContains(t, output, "015  Fenced")
+	})
+
+	t.Run("nests the related work items under related with --json", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "010", map[string][]string{"context": {"true"}, "json": {"true"}})
+		require.NoError(t, err)
+
+		var parsed struct {
+			ID      string            `json:"id"`
+			Related []relatedWorkItem `json:"related"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		assert.Equal(t, "010", parsed.ID)
+		require.Len(t, parsed.Related, 3)
+		assert.Equal(t, "Child one", parsed.Related[0].Title)
+		assert.Equal(t, "done", parsed.Related[1].Status)
+		assert.Equal(t, "099", parsed.Related[2].ID)
+		assert.Equal(t, "work item 099 not found", parsed.Related[2].Error)
+	})
+
+	t.Run("notes a body without references", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		output, err := runShowCapture(t, "011", map[string][]string{"context": {"true"}})
+		require.NoError(t, err)
+		assert.Contains(t, output, "\nRelated:\n  (no referenced work items)\n")
+
+		output, err = runShowCapture(t, "011", map[string][]string{"context": {"true"}, "json": {"true"}})
+		require.NoError(t, err)
+		assert.Contains(t, output, `"related": []`)
+	})
+
+	t.Run("rejects --context with --raw", func(t *testing.T) {
+		setupListWorkspace(t, files)
+		_, err := runShowCapture(t, "010", map[string][]string{"context": {"true"}, "raw": {"true"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--raw cannot be used together with")
+	})
+}
@@ -170,10 +170,8 @@ func init() {
 
 	sliceAddCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
 	sliceRemoveCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
-	sliceRemoveCmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
 	sliceTaskAddCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
 	sliceTaskRemoveCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
-	sliceTaskRemoveCmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
 	sliceTaskEditCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
 	sliceTaskNoteCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
 	sliceTaskDoneCurrentCmd.Flags().BoolP("commit", "c", false, "Commit the work item change (default: no commit)")
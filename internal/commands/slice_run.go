@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -416,14 +415,12 @@ func runSliceRemove(cmd *cobra.Command, args []string) error {
 			newSlices = append(newSlices, slices[i])
 		}
 	}
-	yes, _ := cmd.Flags().GetBool("yes")
-	if !yes {
-		fmt.Printf("Remove slice %q and all its tasks? [y/N] ", sliceName)
-		reader := bufio.NewReader(os.Stdin)
-		line, _ := reader.ReadString('\n')
-		if strings.TrimSpace(strings.ToLower(line)) != "y" {
-			return fmt.Errorf("aborted")
-		}
+	confirmed, err := confirm(fmt.Sprintf("Remove slice %q and all its tasks?", sliceName))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("aborted")
 	}
 	if err := writeSlicesToFile(path, content, newSlices, cfg); err != nil {
 		return err
@@ -529,14 +526,12 @@ func runSliceTaskRemove(cmd *cobra.Command, args []string) error {
 	if si < 0 {
 		return fmt.Errorf("task %s not found", taskID)
 	}
-	yes, _ := cmd.Flags().GetBool("yes")
-	if !yes {
-		fmt.Printf("Remove task %s? [y/N] ", taskID)
-		reader := bufio.NewReader(os.Stdin)
-		line, _ := reader.ReadString('\n')
-		if strings.TrimSpace(strings.ToLower(line)) != "y" {
-			return fmt.Errorf("aborted")
-		}
+	confirmed, err := confirm(fmt.Sprintf("Remove task %s?", taskID))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("aborted")
 	}
 	tasks := slices[si].Tasks
 	slices[si].Tasks = append(tasks[:ti], tasks[ti+1:]...)
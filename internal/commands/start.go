@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"log"
+	"math"
 	"net/url"
 	"os"
 	"os/exec"
@@ -94,6 +95,7 @@ type StartFlags struct {
 	DryRun          bool
 	Override        bool
 	SkipStatusCheck bool
+	NoMove          bool // leave the work item's status alone: no status move or status commit
 	ReuseBranch     bool
 	NoIDE           bool
 	NoDraftPR       bool
@@ -101,6 +103,10 @@ type StartFlags struct {
 	IDECommand      string
 	TrunkBranch     string
 	StatusAction    string
+	MaxTitleLength  int    // 0 uses start.max_title_length, then maxTitleLength
+	Remote          string // --remote override for git.remote (must exist)
+	Issue           int    // --issue: GitHub issue the draft PR closes (0 = github_issue front matter field)
+	Exec            string // --exec: command run with sh -c in the new worktree after setup
 }
 
 // StartContext holds all validated inputs for the start command
@@ -111,6 +117,7 @@ type StartContext struct {
 	SanitizedTitle   string
 	BranchName       string
 	WorktreeRoot     string
+	WorktreePath     string   // The work item's worktree; empty = WorktreeRoot/BranchName
 	WorktreePaths    []string // For polyrepo
 	Behavior         WorkspaceBehavior
 	Config           *config.Config
@@ -118,9 +125,30 @@ type StartContext struct {
 	SkipStatusUpdate bool // Set when --skip-status-check is used and status matches target
 }
 
-// Maximum length for sanitized title before truncation
+// worktreePath returns the path of the work item's worktree. For polyrepo this folder holds the
+// main/ worktree and the project worktrees.
+func (ctx *StartContext) worktreePath() string {
+	if ctx.WorktreePath != "" {
+		return ctx.WorktreePath
+	}
+	return filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+}
+
+// Default maximum length (in bytes) for sanitized title before truncation
 const maxTitleLength = 100
 
+// resolveMaxTitleLength returns the title length limit: the flag override, then
+// start.max_title_length, then maxTitleLength.
+func resolveMaxTitleLength(cfg *config.Config, override int) int {
+	if override > 0 {
+		return override
+	}
+	if cfg.Start != nil && cfg.Start.MaxTitleLength > 0 {
+		return cfg.Start.MaxTitleLength
+	}
+	return maxTitleLength
+}
+
 var startCmd = &cobra.Command{
 	Use:   "start <work-item-id>",
 	Short: "Create a git worktree for parallel development work",
@@ -136,18 +164,36 @@ The command will:
 5. Push the branch and create a draft pull request (GitHub only, when KIRA_GITHUB_TOKEN is set)
 6. Open your IDE in the worktree (if configured)
 7. Run setup commands (if configured)
+8. Run the --exec command in the worktree (if given)
 
 Draft PRs are created for GitHub remotes by default. Set KIRA_GITHUB_TOKEN to enable;
 use --no-draft-pr to skip push and draft PR creation. Configure workspace.draft_pr
-or projects[].draft_pr in kira.yml to disable per workspace or project.`,
-	Args: cobra.ExactArgs(1),
-	RunE: runStart,
+or projects[].draft_pr in kira.yml to disable per workspace or project.
+
+The draft PR is titled "<id>: <title>" and its body is the work item's body. When the
+work item has a github_issue field (e.g. 42, "#42" or "owner/repo#42") or --issue is
+given, "Closes #42" is added to the body so GitHub links the PR to the issue and closes
+it on merge.
+
+--no-move creates the worktree, branch, draft PR and IDE session without touching the
+work item's status (e.g. for a spike): step 3 is skipped entirely, with no status commit.
+--skip-status-check is different: it only allows starting a work item that is already
+in the target status; otherwise the work item is still moved.
+
+--exec "<command>" runs the command with sh -c in the new worktree once it is set up, e.g.
+kira start 001 --exec "npm test". Its output is streamed and kira start exits with its exit
+code. In polyrepo it runs in the main project's worktree (<worktree>/main), like
+workspace.setup. --dry-run only prints the command.`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeFirstWorkItemID,
+	RunE:              runStart,
 }
 
 func init() {
 	startCmd.Flags().Bool("dry-run", false, "Preview what would be done without executing")
 	startCmd.Flags().Bool("override", false, "Remove existing worktree if it exists")
 	startCmd.Flags().Bool("skip-status-check", false, "Skip status validation (allow starting work item already in target status)")
+	startCmd.Flags().Bool("no-move", false, "Do not move the work item to the start status or commit a status change; only create the worktree/branch")
 	startCmd.Flags().Bool("reuse-branch", false, "Checkout existing branch in new worktree if branch exists")
 	startCmd.Flags().Bool("no-ide", false, "Skip IDE opening (useful for agents)")
 	startCmd.Flags().Bool("no-draft-pr", false, "Skip pushing branch and creating draft PR")
@@ -155,6 +201,10 @@ func init() {
 	startCmd.Flags().String("ide", "", "Override IDE command (e.g., --ide cursor)")
 	startCmd.Flags().String("trunk-branch", "", "Override trunk branch (e.g., --trunk-branch develop)")
 	startCmd.Flags().String("status-action", "", "Override status action (none|commit_only|commit_and_push|commit_only_branch)")
+	startCmd.Flags().String("remote", "", "Override the git remote for this run (e.g. a fork); must exist")
+	startCmd.Flags().Int("issue", 0, "GitHub issue number the draft PR closes (default: the work item's github_issue field)")
+	startCmd.Flags().String("exec", "", "Run this command (with sh -c) in the new worktree after setup; kira exits with its exit code")
+	startCmd.Flags().Int("max-title-length", 0, "Maximum length of the title part of branch/worktree names (default: start.max_title_length or 100)")
 }
 
 func runStart(cmd *cobra.Command, args []string) error {
@@ -165,6 +215,9 @@ func runStart(cmd *cobra.Command, args []string) error {
 	if err := checkWorkDir(cfg); err != nil {
 		return err
 	}
+	if err := checkGitAvailable(); err != nil {
+		return err
+	}
 
 	workItemID := args[0]
 
@@ -173,6 +226,7 @@ func runStart(cmd *cobra.Command, args []string) error {
 	flags.DryRun, _ = cmd.Flags().GetBool("dry-run")
 	flags.Override, _ = cmd.Flags().GetBool("override")
 	flags.SkipStatusCheck, _ = cmd.Flags().GetBool("skip-status-check")
+	flags.NoMove, _ = cmd.Flags().GetBool("no-move")
 	flags.ReuseBranch, _ = cmd.Flags().GetBool("reuse-branch")
 	flags.NoIDE, _ = cmd.Flags().GetBool("no-ide")
 	flags.NoDraftPR, _ = cmd.Flags().GetBool("no-draft-pr")
@@ -180,6 +234,19 @@ func runStart(cmd *cobra.Command, args []string) error {
 	flags.IDECommand, _ = cmd.Flags().GetString("ide")
 	flags.TrunkBranch, _ = cmd.Flags().GetString("trunk-branch")
 	flags.StatusAction, _ = cmd.Flags().GetString("status-action")
+	flags.MaxTitleLength, _ = cmd.Flags().GetInt("max-title-length")
+	flags.Remote, _ = cmd.Flags().GetString("remote")
+	flags.Issue, _ = cmd.Flags().GetInt("issue")
+	flags.Exec, _ = cmd.Flags().GetString("exec")
+	cfg = withRemoteOverride(cfg, flags.Remote)
+
+	if flags.MaxTitleLength != 0 && flags.MaxTitleLength < config.MinMaxTitleLength {
+		return fmt.Errorf("invalid --max-title-length %d: must be at least %d", flags.MaxTitleLength, config.MinMaxTitleLength)
+	}
+
+	if err := validateStartFlagCombinations(flags); err != nil {
+		return err
+	}
 
 	// Validate status-action flag if provided
 	if flags.StatusAction != "" {
@@ -230,8 +297,17 @@ func executeGitOperations(ctx *StartContext) error {
 		return err
 	}
 
-	// Step 3: Resolve remote name
+	// Step 3: Resolve remote name (an explicit --remote must exist)
 	remoteName := resolveRemoteName(ctx.Config, nil)
+	if ctx.Flags.Remote != "" {
+		exists, err := checkRemoteExists(remoteName, repoRoot, ctx.Flags.DryRun)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("remote '%s' does not exist for repository %s", remoteName, filepath.Base(repoRoot))
+		}
+	}
 
 	// Step 4: Check for uncommitted changes and pull latest
 	if err := validateAndPullLatest(ctx, repoRoot, trunkBranch, remoteName); err != nil {
@@ -242,6 +318,9 @@ func executeGitOperations(ctx *StartContext) error {
 	if err := performStatusCheck(ctx); err != nil {
 		return err
 	}
+	if err := renumberStartContext(ctx); err != nil {
+		return err
+	}
 
 	// Step 6: Status update for commit_only/commit_and_push (before worktree creation)
 	if err := performStatusUpdate(ctx, repoRoot, trunkBranch, remoteName); err != nil {
@@ -295,7 +374,7 @@ func createWorktreesAndFinalize(ctx *StartContext, trunkBranch string) error {
 		return fmt.Errorf("failed to create worktree root directory: %w", err)
 	}
 
-	worktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	worktreePath := ctx.worktreePath()
 	if ctx.Behavior == WorkspaceBehaviorPolyrepo {
 		if err := executePolyrepoStart(ctx, trunkBranch); err != nil {
 			return err
@@ -319,7 +398,7 @@ func createWorktreesAndFinalize(ctx *StartContext, trunkBranch string) error {
 		}
 	}
 
-	displayPath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	displayPath := ctx.worktreePath()
 	fmt.Printf("\nSuccessfully started work on %s\n", ctx.WorkItemID)
 	fmt.Printf("  Worktree: %s\n", displayPath)
 	fmt.Printf("  Branch: %s\n", ctx.BranchName)
@@ -333,17 +412,12 @@ func createWorktreesAndFinalize(ctx *StartContext, trunkBranch string) error {
 		return err
 	}
 
-	return nil
+	// Step 11: Run the --exec command in the set-up worktree
+	return runStartExec(ctx)
 }
 
 // buildStartContext validates all inputs and builds a StartContext
 func buildStartContext(cfg *config.Config, workItemID string, flags StartFlags) (*StartContext, error) {
-	ctx := &StartContext{
-		WorkItemID: workItemID,
-		Config:     cfg,
-		Flags:      flags,
-	}
-
 	// Step 1: Validate work item ID format
 	if err := validateWorkItemID(workItemID, cfg); err != nil {
 		return nil, err
@@ -354,14 +428,13 @@ func buildStartContext(cfg *config.Config, workItemID string, flags StartFlags)
 	if err != nil {
 		return nil, fmt.Errorf("work item '%s' not found: no work item file exists with that ID", workItemID)
 	}
-	ctx.WorkItemPath = workItemPath
 
 	// Step 3: Extract work item metadata
 	workItemType, id, title, currentStatus, repos, err := extractWorkItemMetadata(workItemPath, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read work item '%s': could not extract metadata: %w", workItemID, err)
 	}
-	ctx.Metadata = workItemMetadata{
+	metadata := workItemMetadata{
 		workItemType:  workItemType,
 		id:            id,
 		title:         title,
@@ -369,25 +442,41 @@ func buildStartContext(cfg *config.Config, workItemID string, flags StartFlags)
 		repos:         repos,
 	}
 
+	return buildStartContextForWorkItem(cfg, workItemID, workItemPath, metadata, flags)
+}
+
+// buildStartContextForWorkItem builds a StartContext for a work item that has already been
+// located and parsed (e.g. by 'kira move --start').
+func buildStartContextForWorkItem(cfg *config.Config, workItemID, workItemPath string, metadata workItemMetadata, flags StartFlags) (*StartContext, error) {
+	// Per-work-item overrides (the kira: block of its front matter) apply over kira.yml; --remote still wins
+	override, err := loadWorkItemConfigOverride(workItemPath, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config override for work item %s: %w", workItemID, err)
+	}
+	cfg = withRemoteOverride(withWorkItemConfigOverride(cfg, override), flags.Remote)
+
+	ctx := &StartContext{
+		WorkItemPath: workItemPath,
+		Metadata:     metadata,
+		Config:       cfg,
+		Flags:        flags,
+	}
+	title := metadata.title
+
 	// Step 4: Sanitize title for branch/worktree name
-	sanitizedTitle, err := sanitizeTitle(title, workItemID)
+	sanitizedTitle, err := sanitizeTitle(title, workItemID, resolveMaxTitleLength(cfg, flags.MaxTitleLength))
 	if err != nil {
 		return nil, err
 	}
 	ctx.SanitizedTitle = sanitizedTitle
 
-	// Step 5: Build branch name
-	ctx.BranchName = fmt.Sprintf("%s-%s", workItemID, sanitizedTitle)
-
-	// Step 6: Infer workspace behavior
+	// Step 5: Infer workspace behavior
 	ctx.Behavior = inferWorkspaceBehavior(cfg)
 
-	// Step 7: Derive worktree root
-	worktreeRoot, err := deriveWorktreeRoot(cfg, ctx.Behavior)
-	if err != nil {
+	// Step 6: Build branch name and derive worktree root and path (worktree.path_template when set)
+	if err := ctx.setWorkItemID(workItemID); err != nil {
 		return nil, err
 	}
-	ctx.WorktreeRoot = worktreeRoot
 
 	// Note: Status check is performed in executeGitOperations after git pull (step 5)
 	// to ensure we're checking against the most up-to-date status
@@ -395,6 +484,36 @@ func buildStartContext(cfg *config.Config, workItemID string, flags StartFlags)
 	return ctx, nil
 }
 
+// setWorkItemID names the work item by id, and its branch and worktree after id and the sanitized
+// title. Called again when start renumbers the work item for its new folder (naming.per_folder_ids).
+func (ctx *StartContext) setWorkItemID(id string) error {
+	branchName := fmt.Sprintf("%s-%s", id, ctx.SanitizedTitle)
+	worktreeRoot, worktreePath, err := resolveWorkItemWorktree(ctx.Config, ctx.Behavior, id, ctx.SanitizedTitle, branchName)
+	if err != nil {
+		return err
+	}
+	ctx.WorkItemID = id
+	ctx.Metadata.id = id
+	ctx.BranchName = branchName
+	ctx.WorktreeRoot = worktreeRoot
+	ctx.WorktreePath = worktreePath
+	return nil
+}
+
+// renumberStartContext names ctx by the ID its work item gets when start moves it into the
+// start.move_to folder: with naming.per_folder_ids, the next free ID of that folder. The branch,
+// worktree and status commit then use that ID.
+func renumberStartContext(ctx *StartContext) error {
+	if !config.PerFolderIDs(ctx.Config) || ctx.Flags.NoMove || ctx.SkipStatusUpdate || getEffectiveStatusAction(ctx) == statusActionNone {
+		return nil
+	}
+	newID, err := movedWorkItemID(ctx.Config, ctx.WorkItemPath, ctx.Config.Start.MoveTo, ctx.WorkItemID)
+	if err != nil {
+		return err
+	}
+	return ctx.setWorkItemID(newID)
+}
+
 // validateWorkItemID validates the work item ID format and protects against path traversal
 func validateWorkItemID(id string, cfg *config.Config) error {
 	// Check for path traversal attempts
@@ -414,8 +533,9 @@ func validateWorkItemID(id string, cfg *config.Config) error {
 	return nil
 }
 
-// sanitizeTitle sanitizes a work item title for use in branch/directory names
-func sanitizeTitle(title, workItemID string) (string, error) {
+// sanitizeTitle sanitizes a work item title for use in branch/directory names.
+// Titles longer than maxLen bytes are cut at a rune boundary and given a hash suffix.
+func sanitizeTitle(title, workItemID string, maxLen int) (string, error) {
 	// Handle missing or empty title
 	if title == "" || title == unknownValue {
 		fmt.Printf("Warning: Work item %s has no title field. Using work item ID '%s' for worktree directory and branch name.\n", workItemID, workItemID)
@@ -439,18 +559,63 @@ func sanitizeTitle(title, workItemID string) (string, error) {
 	}
 
 	// Truncate if too long, add hash for uniqueness
-	if len(sanitized) > maxTitleLength {
-		// Calculate hash of full sanitized title for uniqueness
-		hash := sha256.Sum256([]byte(sanitized))
-		hashSuffix := fmt.Sprintf("-%x", hash[:3]) // 6 hex chars
+	if len(sanitized) > maxLen {
+		hashSuffix := titleHashSuffix(sanitized)
 
-		// Truncate and append hash
-		sanitized = sanitized[:maxTitleLength-len(hashSuffix)] + hashSuffix
+		// Truncate (never splitting a multibyte rune) and append hash
+		sanitized = truncateAtRuneBoundary(sanitized, maxLen-len(hashSuffix)) + hashSuffix
 	}
 
 	return sanitized, nil
 }
 
+// titleHashSuffix returns the suffix a truncated title gets for uniqueness: "-" and 6 hex chars
+// of the hash of the full sanitized title.
+func titleHashSuffix(sanitized string) string {
+	hash := sha256.Sum256([]byte(sanitized))
+	return fmt.Sprintf("-%x", hash[:3])
+}
+
+// isStartBranchFor reports whether branch is named the way kira start names the branch of a
+// work item, under any title length limit: <id>-<slug>, or <id>-<start of slug>-<hash> when
+// --max-title-length or start.max_title_length truncated it. slug is the untruncated sanitized
+// title, empty for a work item without a title.
+func isStartBranchFor(branch, workItemID, slug string) bool {
+	rest, ok := strings.CutPrefix(branch, workItemID+"-")
+	if !ok {
+		return false
+	}
+	if rest == slug {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(rest, titleHashSuffix(slug))
+	return ok && strings.HasPrefix(slug, prefix)
+}
+
+// findStartBranch returns the local branch in repoPath that kira start created for the work item
+// with the given title, whatever title length limit it was started with, or "" when there is none.
+func findStartBranch(repoPath, workItemID, title string) (string, error) {
+	slug := ""
+	if title != "" && title != unknownValue {
+		var err error
+		if slug, err = sanitizeTitle(title, workItemID, math.MaxInt); err != nil {
+			return "", err
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+	output, err := executeCommand(ctx, "git", []string{"for-each-ref", "--format=%(refname:short)", "refs/heads/"}, repoPath, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to list branches: %w", err)
+	}
+	for _, branch := range strings.Split(output, "\n") {
+		if branch = strings.TrimSpace(branch); isStartBranchFor(branch, workItemID, slug) {
+			return branch, nil
+		}
+	}
+	return "", nil
+}
+
 // inferWorkspaceBehavior determines the workspace type from configuration
 func inferWorkspaceBehavior(cfg *config.Config) WorkspaceBehavior {
 	// No workspace config = standalone
@@ -740,15 +905,19 @@ func checkWorkItemStatus(currentStatus, targetStatus string, skipCheck bool) err
 
 // printDryRunPreview prints a preview of what the start command would do
 func printDryRunPreview(ctx *StartContext) error {
+	oldID := ctx.WorkItemID
+	if err := renumberStartContext(ctx); err != nil {
+		return err
+	}
 	fmt.Println("[DRY RUN] Would perform the following operations:")
 	fmt.Println()
 
-	printDryRunWorkItem(ctx)
+	printDryRunWorkItem(ctx, oldID)
 	printDryRunWorkspace(ctx)
 
 	trunkBranch := determineDryRunTrunkBranch(ctx)
 	remoteName := resolveRemoteName(ctx.Config, nil)
-	worktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	worktreePath := ctx.worktreePath()
 
 	printDryRunGitOps(ctx, trunkBranch, remoteName, worktreePath)
 	printDryRunDraftPR(ctx, worktreePath)
@@ -756,13 +925,18 @@ func printDryRunPreview(ctx *StartContext) error {
 	printDryRunStatus(ctx)
 	printDryRunIDE(ctx)
 	printDryRunSetup(ctx)
+	printDryRunExec(ctx)
 
 	return nil
 }
 
-func printDryRunWorkItem(ctx *StartContext) {
+func printDryRunWorkItem(ctx *StartContext, oldID string) {
 	fmt.Printf("Work Item:\n")
-	fmt.Printf("  ID: %s\n", ctx.WorkItemID)
+	if oldID != ctx.WorkItemID {
+		fmt.Printf("  ID: %s (renumbered to %s in '%s', naming.per_folder_ids)\n", oldID, ctx.WorkItemID, ctx.Config.Start.MoveTo)
+	} else {
+		fmt.Printf("  ID: %s\n", ctx.WorkItemID)
+	}
 	fmt.Printf("  Title: %s\n", ctx.Metadata.title)
 	fmt.Printf("  Current Status: %s\n", ctx.Metadata.currentStatus)
 	fmt.Println()
@@ -807,7 +981,11 @@ func printDryRunDraftPR(ctx *StartContext, worktreePath string) {
 		return
 	}
 	if wouldCreateDraftPRForAnyTarget(ctx, worktreePath) {
-		fmt.Printf("Draft PR: Would push branch and create draft PR\n")
+		if issue := startGitHubIssue(ctx); issue != "" {
+			fmt.Printf("Draft PR: Would push branch and create draft PR (Closes %s)\n", issue)
+		} else {
+			fmt.Printf("Draft PR: Would push branch and create draft PR\n")
+		}
 		fmt.Println()
 	}
 }
@@ -827,7 +1005,7 @@ func wouldCreateDraftPRForAnyTarget(ctx *StartContext, worktreePath string) bool
 }
 
 func wouldCreateDraftPRForAnyTargetPolyrepo(ctx *StartContext, baseURL string) bool {
-	baseWorktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	baseWorktreePath := ctx.worktreePath()
 	mainWorktreePath := filepath.Join(baseWorktreePath, "main")
 	remoteName := resolveRemoteName(ctx.Config, nil)
 	mainRemoteURL, err := getRemoteURL(remoteName, mainWorktreePath)
@@ -894,7 +1072,9 @@ func printDryRunStatus(ctx *StartContext) {
 	}
 
 	fmt.Printf("Status Management:\n")
-	if statusAction == statusActionNone || ctx.Flags.SkipStatusCheck {
+	if ctx.Flags.NoMove {
+		fmt.Println("  Status Change: No change (--no-move)")
+	} else if statusAction == statusActionNone || ctx.Flags.SkipStatusCheck {
 		fmt.Println("  Status Change: No change")
 	} else {
 		fmt.Printf("  Status Change: %s -> %s\n", ctx.Metadata.currentStatus, ctx.Config.Start.MoveTo)
@@ -910,6 +1090,13 @@ func printDryRunIDE(ctx *StartContext) {
 		fmt.Println("  Action: Skip (--no-ide flag)")
 	case ctx.Flags.IDECommand != "":
 		fmt.Printf("  Command: %s\n", ctx.Flags.IDECommand)
+	case len(ideCommandChain(ctx.Config)) > 0:
+		chain := ideCommandChain(ctx.Config)
+		commands := make([]string, 0, len(chain))
+		for _, candidate := range chain {
+			commands = append(commands, candidate.Command)
+		}
+		fmt.Printf("  Commands: %s (first installed)\n", strings.Join(commands, ", "))
 	case ctx.Config.IDE != nil && ctx.Config.IDE.Command != "":
 		fmt.Printf("  Command: %s\n", ctx.Config.IDE.Command)
 		if len(ctx.Config.IDE.Args) > 0 {
@@ -1088,6 +1275,35 @@ func resolveRemoteName(cfg *config.Config, project *config.ProjectConfig) string
 	return defaultRemoteName
 }
 
+// withRemoteOverride returns a copy of cfg whose git.remote is set to remote (the --remote flag),
+// so every resolveRemoteName call for the run honors the override. The explicit flag also wins
+// over the remote of polyrepo projects that configure their own. Returns cfg unchanged when
+// remote is empty.
+func withRemoteOverride(cfg *config.Config, remote string) *config.Config {
+	if remote == "" {
+		return cfg
+	}
+	cfgCopy := *cfg
+	gitCopy := config.GitConfig{}
+	if cfg.Git != nil {
+		gitCopy = *cfg.Git
+	}
+	gitCopy.Remote = remote
+	cfgCopy.Git = &gitCopy
+	if cfg.Workspace != nil {
+		workspaceCopy := *cfg.Workspace
+		workspaceCopy.Projects = make([]config.ProjectConfig, len(cfg.Workspace.Projects))
+		copy(workspaceCopy.Projects, cfg.Workspace.Projects)
+		for i := range workspaceCopy.Projects {
+			if workspaceCopy.Projects[i].Remote != "" {
+				workspaceCopy.Projects[i].Remote = remote
+			}
+		}
+		cfgCopy.Workspace = &workspaceCopy
+	}
+	return &cfgCopy
+}
+
 // checkRemoteExists checks if a remote exists in the repository
 func checkRemoteExists(remoteName, dir string, dryRun bool) (bool, error) {
 	if dryRun {
@@ -1426,7 +1642,7 @@ func handleExistingWorktree(worktreePath, workItemID string, override, dryRun bo
 
 // executeStandaloneStart executes the start command for standalone/monorepo workspaces
 func executeStandaloneStart(ctx *StartContext, trunkBranch string) error {
-	worktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	worktreePath := ctx.worktreePath()
 
 	// Handle existing worktree
 	if err := handleExistingWorktree(worktreePath, ctx.WorkItemID, ctx.Flags.Override, ctx.Flags.DryRun); err != nil {
@@ -1714,7 +1930,7 @@ func executePolyrepoStart(ctx *StartContext, trunkBranch string) error {
 	}
 
 	// Build worktree paths
-	baseWorktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	baseWorktreePath := ctx.worktreePath()
 	mainWorktreePath := filepath.Join(baseWorktreePath, "main")
 	worktreePaths := buildPolyrepoWorktreePaths(projects, baseWorktreePath, mainWorktreePath)
 
@@ -2022,8 +2238,14 @@ func getEffectiveStatusAction(ctx *StartContext) string {
 
 // performStatusCheck checks if the work item status matches the target status.
 // Returns error if already in target status (unless --skip-status-check).
-// Sets ctx.SkipStatusUpdate if --skip-status-check is used with matching status.
+// Sets ctx.SkipStatusUpdate if --skip-status-check is used with matching status, or with --no-move.
 func performStatusCheck(ctx *StartContext) error {
+	if ctx.Flags.NoMove {
+		ctx.SkipStatusUpdate = true
+		fmt.Printf("Skipping status change (--no-move): work item stays in '%s' status\n", ctx.Metadata.currentStatus)
+		return nil
+	}
+
 	statusAction := getEffectiveStatusAction(ctx)
 
 	// Skip check entirely if status_action is "none"
@@ -2068,16 +2290,11 @@ func performStatusUpdate(ctx *StartContext, repoRoot, trunkBranch, remoteName st
 
 	// Move the work item file and update status field
 	// Use moveWorkItem with commitFlag=false since we handle commit separately
-	if err := moveWorkItemWithoutCommit(ctx.Config, ctx.WorkItemID, targetStatus); err != nil {
-		return fmt.Errorf("failed to move work item to '%s' status: %w", targetStatus, err)
+	newPath, err := moveStartWorkItem(ctx, targetStatus)
+	if err != nil {
+		return err
 	}
 
-	// Get the new path after moving
-	newPath := filepath.Join(config.GetWorkFolderPath(ctx.Config), ctx.Config.StatusFolders[targetStatus], filepath.Base(oldPath))
-
-	// Update ctx.WorkItemPath to the new location
-	ctx.WorkItemPath = newPath
-
 	// Build commit message
 	commitMsg, err := buildStatusCommitMessage(ctx, targetStatus)
 	if err != nil {
@@ -2134,16 +2351,11 @@ func performStatusUpdateOnBranch(ctx *StartContext, worktreePath string) error {
 	oldPath := ctx.WorkItemPath
 
 	// Move the work item file and update status field
-	if err := moveWorkItemWithoutCommit(ctx.Config, ctx.WorkItemID, targetStatus); err != nil {
-		return fmt.Errorf("failed to move work item to '%s' status: %w", targetStatus, err)
+	newPath, err := moveStartWorkItem(ctx, targetStatus)
+	if err != nil {
+		return err
 	}
 
-	// Get the new path after moving
-	newPath := filepath.Join(config.GetWorkFolderPath(ctx.Config), ctx.Config.StatusFolders[targetStatus], filepath.Base(oldPath))
-
-	// Update ctx.WorkItemPath to the new location
-	ctx.WorkItemPath = newPath
-
 	// Build commit message
 	commitMsg, err := buildStatusCommitMessage(ctx, targetStatus)
 	if err != nil {
@@ -2165,36 +2377,53 @@ func performStatusUpdateOnBranch(ctx *StartContext, worktreePath string) error {
 	return nil
 }
 
-// moveWorkItemWithoutCommit moves a work item to target status without committing.
-// This mirrors the logic in moveWorkItem but without the commit step.
-func moveWorkItemWithoutCommit(cfg *config.Config, workItemID, targetStatus string) error {
-	// Find the work item file
-	workItemPath, err := findWorkItemFile(workItemID, cfg)
+// moveStartWorkItem moves the work item of ctx to targetStatus and points ctx at the moved file,
+// named by the ID it has after the move.
+func moveStartWorkItem(ctx *StartContext, targetStatus string) (string, error) {
+	newPath, renumber, err := moveWorkItemWithoutCommit(ctx.Config, ctx.WorkItemPath, targetStatus)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("failed to move work item to '%s' status: %w", targetStatus, err)
+	}
+	ctx.WorkItemPath = newPath
+	if renumber == nil {
+		return newPath, nil
 	}
+	renumber.display(os.Stdout, false)
+	if renumber.newID != ctx.WorkItemID {
+		if err := ctx.setWorkItemID(renumber.newID); err != nil {
+			return "", err
+		}
+	}
+	return newPath, nil
+}
 
+// moveWorkItemWithoutCommit moves the work item at workItemPath to target status without
+// committing, and returns the path it ends up at and its ID change (nil unless it was renumbered
+// for naming.per_folder_ids). This mirrors the logic in moveWorkItem but without the commit step.
+func moveWorkItemWithoutCommit(cfg *config.Config, workItemPath, targetStatus string) (string, *workItemRenumber, error) {
 	// Validate target status
 	if _, exists := cfg.StatusFolders[targetStatus]; !exists {
-		return fmt.Errorf("invalid target status: %s", targetStatus)
+		return "", nil, fmt.Errorf("invalid target status: %s", targetStatus)
 	}
 
-	// Get target folder path
+	// Get target folder path, with the next free ID of the target folder for per-folder IDs
 	targetFolder := filepath.Join(config.GetWorkFolderPath(cfg), cfg.StatusFolders[targetStatus])
 	filename := filepath.Base(workItemPath)
-	targetPath := filepath.Join(targetFolder, filename)
-
-	// Move the file
-	if err := os.Rename(workItemPath, targetPath); err != nil {
-		return fmt.Errorf("failed to move work item: %w", err)
+	renumber, err := perFolderRenumber(cfg, workItemPath, targetFolder)
+	if err != nil {
+		return "", nil, err
+	}
+	if renumber != nil {
+		filename = renumberedFilename(filename, renumber.newID)
 	}
+	targetPath := filepath.Join(targetFolder, filename)
 
-	// Update the status in the file
-	if err := updateWorkItemStatus(targetPath, targetStatus, cfg); err != nil {
-		return fmt.Errorf("failed to update work item status: %w", err)
+	// Write the moved work item with its new status, updated timestamp and id to the target folder in one step
+	if err := writeMovedWorkItemFile(cfg, workItemPath, targetPath, targetStatus, renumber, nil); err != nil {
+		return "", nil, err
 	}
 
-	return nil
+	return targetPath, renumber, nil
 }
 
 // buildStatusCommitMessage builds a commit message from the template.
@@ -2398,6 +2627,7 @@ func createDraftPRAfterPush(ctx *StartContext, remoteURL, baseURL, trunkBranch s
 	if err != nil {
 		body = ""
 	}
+	body = draftPRBody(body, draftPRIssue(ctx, owner, repo))
 	prURL, err := git.CreateDraftPR(prCtx, client, owner, repo, trunkBranch, ctx.BranchName, title, body)
 	if err != nil {
 		log.Printf("Warning: failed to create draft PR: %v", err)
@@ -2407,6 +2637,91 @@ func createDraftPRAfterPush(ctx *StartContext, remoteURL, baseURL, trunkBranch s
 	return nil
 }
 
+// githubIssueField is the front matter field holding the GitHub issue a work item mirrors.
+const githubIssueField = "github_issue"
+
+// githubIssueRefPattern matches issue references GitHub closes from a PR body: 42, #42 or owner/repo#42.
+var githubIssueRefPattern = regexp.MustCompile(`^(?:([\w.-]+/[\w.-]+)#|#)?(\d+)$`)
+
+// validateStartFlagCombinations rejects flags that cannot be used together.
+func validateStartFlagCombinations(flags StartFlags) error {
+	if flags.NoMove && flags.StatusAction != "" {
+		return fmt.Errorf("invalid flag combination: --no-move cannot be used together with --status-action")
+	}
+	if flags.Issue < 0 {
+		return fmt.Errorf("invalid --issue %d: must be a positive issue number", flags.Issue)
+	}
+	if flags.Issue > 0 && flags.NoDraftPR {
+		return fmt.Errorf("invalid flag combination: --issue cannot be used together with --no-draft-pr")
+	}
+	return nil
+}
+
+// startGitHubIssue returns the issue reference the draft PR closes (#42 or owner/repo#42): --issue
+// when given, else the work item's github_issue field. Returns "" when there is none; an
+// unrecognized github_issue value is ignored with a warning.
+func startGitHubIssue(ctx *StartContext) string {
+	if ctx.Flags.Issue > 0 {
+		return fmt.Sprintf("#%d", ctx.Flags.Issue)
+	}
+	frontMatter, _, err := parseWorkItemFrontMatter(ctx.WorkItemPath, ctx.Config)
+	if err != nil {
+		return ""
+	}
+	value, ok := frontMatter[githubIssueField]
+	if !ok || value == nil {
+		return ""
+	}
+	ref, ok := parseGitHubIssueRef(fmt.Sprint(value))
+	if !ok {
+		log.Printf("Warning: ignoring %s '%v': use an issue number such as 42, #42 or owner/repo#42", githubIssueField, value)
+	}
+	return ref
+}
+
+// parseGitHubIssueRef normalizes an issue reference to #42 or owner/repo#42.
+func parseGitHubIssueRef(value string) (string, bool) {
+	match := githubIssueRefPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return "", false
+	}
+	if n, err := strconv.Atoi(match[2]); err != nil || n == 0 {
+		return "", false
+	}
+	return match[1] + "#" + match[2], true
+}
+
+// draftPRIssue returns the issue the draft PR of owner/repo closes. In a polyrepo workspace every
+// project gets its own PR, so the issue is only closed from the PR of its own repository, and a
+// bare #42 (which would close issue 42 of each repository) is left out with a warning.
+func draftPRIssue(ctx *StartContext, owner, repo string) string {
+	issue := startGitHubIssue(ctx)
+	if issue == "" || ctx.Behavior != WorkspaceBehaviorPolyrepo {
+		return issue
+	}
+	issueRepo, _, _ := strings.Cut(issue, "#")
+	if issueRepo == "" {
+		log.Printf("Warning: not linking %s to the draft PR of %s/%s: in a polyrepo workspace give the issue as owner/repo#42", issue, owner, repo)
+		return ""
+	}
+	if !strings.EqualFold(issueRepo, owner+"/"+repo) {
+		return ""
+	}
+	return issue
+}
+
+// draftPRBody adds a closing keyword for issue (e.g. "Closes #42") to the end of the PR body.
+func draftPRBody(body, issue string) string {
+	if issue == "" {
+		return body
+	}
+	closing := "Closes " + issue
+	if strings.TrimSpace(body) == "" {
+		return closing
+	}
+	return strings.TrimRight(body, "\n") + "\n\n" + closing
+}
+
 // pushBranchesForDraftPR pushes the branch to GitHub remotes for repos where draft PR is desired,
 // then creates draft PRs when KIRA_GITHUB_TOKEN is set.
 // Returns a clear error before any push if draft PR would be created but KIRA_GITHUB_TOKEN is unset.
@@ -2452,7 +2767,7 @@ func pushBranchesPolyrepo(ctx *StartContext, baseURL, trunkBranch string) error
 	if err != nil {
 		return err
 	}
-	baseWorktreePath := filepath.Join(ctx.WorktreeRoot, ctx.BranchName)
+	baseWorktreePath := ctx.worktreePath()
 	mainWorktreePath := filepath.Join(baseWorktreePath, "main")
 	worktreePaths := buildPolyrepoWorktreePaths(projects, baseWorktreePath, mainWorktreePath)
 
@@ -2523,7 +2838,8 @@ func pushProjectBranchIfNeeded(ctx *StartContext, p PolyrepoProject, worktreePat
 // ============================================================================
 
 // launchIDE opens the IDE for the worktree.
-// Priority order: --no-ide flag (skip) > --ide flag > ide.command config > no IDE
+// Priority order: --no-ide flag (skip) > --ide flag > ide.command config, followed by
+// worktree.ide_commands when set > no IDE
 // IDE launch failures are logged as warnings; worktree creation still succeeds.
 func launchIDE(ctx *StartContext, worktreePath string) {
 	// Priority 1: --no-ide flag (highest priority - silent skip)
@@ -2537,7 +2853,11 @@ func launchIDE(ctx *StartContext, worktreePath string) {
 		return
 	}
 
-	// Priority 3: ide.command from config
+	// Priority 3: ide.command and worktree.ide_commands, first installed one wins
+	if chain := ideCommandChain(ctx.Config); len(chain) > 0 {
+		launchFirstAvailableIDE(chain, worktreePath, ctx.Flags.DryRun)
+		return
+	}
 	if ctx.Config.IDE != nil && ctx.Config.IDE.Command != "" {
 		launchIDECommand(ctx.Config.IDE.Command, ctx.Config.IDE.Args, worktreePath, ctx.Flags.DryRun)
 		return
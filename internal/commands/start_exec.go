@@ -0,0 +1,63 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// startExecDir returns the directory the --exec command runs in: the worktree, or for polyrepo
+// the main project's worktree (<worktree>/main), the same directory workspace.setup runs in.
+func startExecDir(ctx *StartContext) string {
+	if ctx.Behavior == WorkspaceBehaviorPolyrepo {
+		return filepath.Join(ctx.worktreePath(), "main")
+	}
+	return ctx.worktreePath()
+}
+
+// runStartExec runs the --exec command with sh -c in the new worktree once it is set up,
+// streaming its output. A non-zero exit becomes an *ExitCodeError so kira start exits with the
+// command's exit code.
+func runStartExec(ctx *StartContext) error {
+	if ctx.Flags.Exec == "" {
+		return nil
+	}
+	dir := startExecDir(ctx)
+	fmt.Printf("Running %s (in %s)\n", ctx.Flags.Exec, dir)
+
+	cmd, err := newCommand(context.Background(), "sh", "-c", ctx.Flags.Exec)
+	if err != nil {
+		return err
+	}
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err = startAndWait(context.Background(), cmd)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &ExitCodeError{
+			Code: exitErr.ExitCode(),
+			Err:  fmt.Errorf("--exec command failed with exit code %d: %s", exitErr.ExitCode(), ctx.Flags.Exec),
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run --exec command: %w", err)
+	}
+	return nil
+}
+
+// printDryRunExec shows the --exec command a real run would execute.
+func printDryRunExec(ctx *StartContext) {
+	if ctx.Flags.Exec == "" {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("Exec:\n")
+	fmt.Printf("  Would run: %s (in %s)\n", ctx.Flags.Exec, startExecDir(ctx))
+}
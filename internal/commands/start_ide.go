@@ -0,0 +1,50 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// ideCandidate is an IDE command kira start may open the worktree with.
+type ideCandidate struct {
+	Command string
+	Args    []string
+}
+
+// ideCommandChain returns the IDE commands to try in order when worktree.ide_commands is set:
+// ide.command (with ide.args) first, then the ide_commands entries. It returns nil without
+// ide_commands, so a lone ide.command is launched as before, without checking it is installed.
+func ideCommandChain(cfg *config.Config) []ideCandidate {
+	if cfg.Worktree == nil || len(cfg.Worktree.IDECommands) == 0 {
+		return nil
+	}
+	var chain []ideCandidate
+	if cfg.IDE != nil && strings.TrimSpace(cfg.IDE.Command) != "" {
+		chain = append(chain, ideCandidate{Command: strings.TrimSpace(cfg.IDE.Command), Args: cfg.IDE.Args})
+	}
+	for _, command := range cfg.Worktree.IDECommands {
+		if command = strings.TrimSpace(command); command != "" {
+			chain = append(chain, ideCandidate{Command: command})
+		}
+	}
+	return chain
+}
+
+// launchFirstAvailableIDE opens the worktree with the first command of chain found on PATH,
+// warning about each one skipped. When none is installed the worktree path is printed instead;
+// the start still succeeds.
+func launchFirstAvailableIDE(chain []ideCandidate, worktreePath string, dryRun bool) {
+	for _, candidate := range chain {
+		if _, err := exec.LookPath(candidate.Command); err != nil {
+			fmt.Printf("Warning: IDE command '%s' not found, trying the next one.\n", candidate.Command)
+			continue
+		}
+		launchIDECommand(candidate.Command, candidate.Args, worktreePath, dryRun)
+		return
+	}
+	fmt.Printf("Warning: none of the IDE commands in ide.command and worktree.ide_commands is installed. Worktree created at %s; open it manually.\n", worktreePath)
+}
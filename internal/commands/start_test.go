@@ -1,12 +1,16 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -100,50 +104,50 @@ func TestValidateWorkItemID(t *testing.T) {
 
 func TestSanitizeTitle(t *testing.T) {
 	t.Run("converts spaces to hyphens", func(t *testing.T) {
-		result, err := sanitizeTitle("hello world", "001")
+		result, err := sanitizeTitle("hello world", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "hello-world", result)
 	})
 
 	t.Run("converts underscores to hyphens", func(t *testing.T) {
-		result, err := sanitizeTitle("hello_world", "001")
+		result, err := sanitizeTitle("hello_world", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "hello-world", result)
 	})
 
 	t.Run("converts to lowercase", func(t *testing.T) {
-		result, err := sanitizeTitle("Hello World", "001")
+		result, err := sanitizeTitle("Hello World", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "hello-world", result)
 	})
 
 	t.Run("handles mixed input", func(t *testing.T) {
-		result, err := sanitizeTitle("Fix Bug_In Feature", "001")
+		result, err := sanitizeTitle("Fix Bug_In Feature", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "fix-bug-in-feature", result)
 	})
 
 	t.Run("handles empty title by returning empty string", func(t *testing.T) {
-		result, err := sanitizeTitle("", "001")
+		result, err := sanitizeTitle("", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "", result)
 	})
 
 	t.Run("handles unknown title by returning empty string", func(t *testing.T) {
-		result, err := sanitizeTitle("unknown", "001")
+		result, err := sanitizeTitle("unknown", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "", result)
 	})
 
 	t.Run("rejects title that sanitizes to only hyphens", func(t *testing.T) {
-		_, err := sanitizeTitle("---", "001")
+		_, err := sanitizeTitle("---", "001", maxTitleLength)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "sanitization resulted in empty string")
 	})
 
 	t.Run("rejects title that sanitizes to empty", func(t *testing.T) {
 		// A title with only special characters that get removed
-		_, err := sanitizeTitle("   ", "001")
+		_, err := sanitizeTitle("   ", "001", maxTitleLength)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "sanitization resulted in empty string")
 	})
@@ -151,26 +155,66 @@ func TestSanitizeTitle(t *testing.T) {
 	t.Run("truncates long titles with hash suffix", func(t *testing.T) {
 		// Create a title longer than maxTitleLength (100 chars)
 		longTitle := "this-is-a-very-long-title-that-exceeds-the-maximum-allowed-length-for-branch-names-and-worktree-directories-which-should-be-truncated"
-		result, err := sanitizeTitle(longTitle, "001")
+		result, err := sanitizeTitle(longTitle, "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.LessOrEqual(t, len(result), maxTitleLength)
 		// Should end with a hash suffix (6 hex chars)
 		assert.Regexp(t, `-[a-f0-9]{6}$`, result)
 	})
 
+	t.Run("truncates emoji titles at a rune boundary", func(t *testing.T) {
+		// 95 ASCII bytes followed by 4-byte emoji: the cut point (93) and the
+		// following runes straddle multibyte characters near the boundary
+		title := strings.Repeat("a", 91) + "🚀🚀🚀"
+		result, err := sanitizeTitle(title, "001", maxTitleLength)
+		require.NoError(t, err)
+		assert.True(t, utf8.ValidString(result), "result must be valid UTF-8: %q", result)
+		assert.LessOrEqual(t, len(result), maxTitleLength)
+		assert.Regexp(t, `-[a-f0-9]{6}$`, result)
+	})
+
+	t.Run("truncates CJK titles at a rune boundary", func(t *testing.T) {
+		title := strings.Repeat("日本語", 20)
+		result, err := sanitizeTitle(title, "001", maxTitleLength)
+		require.NoError(t, err)
+		assert.True(t, utf8.ValidString(result), "result must be valid UTF-8: %q", result)
+		assert.LessOrEqual(t, len(result), maxTitleLength)
+		assert.True(t, strings.HasPrefix(result, "日本語"))
+	})
+
+	t.Run("respects custom max length", func(t *testing.T) {
+		result, err := sanitizeTitle("a fairly long title for a short limit", "001", 20)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(result), 20)
+		assert.Regexp(t, `^a-fairly-long-[a-f0-9]{6}$`, result)
+	})
+
 	t.Run("preserves unicode characters", func(t *testing.T) {
-		result, err := sanitizeTitle("Café Feature", "001")
+		result, err := sanitizeTitle("Café Feature", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "café-feature", result)
 	})
 
 	t.Run("removes leading and trailing hyphens", func(t *testing.T) {
-		result, err := sanitizeTitle("  hello world  ", "001")
+		result, err := sanitizeTitle("  hello world  ", "001", maxTitleLength)
 		require.NoError(t, err)
 		assert.Equal(t, "hello-world", result)
 	})
 }
 
+func TestIsStartBranchFor(t *testing.T) {
+	slug := "quarterly-revenue-report"
+	truncated, err := sanitizeTitle("Quarterly revenue report", "004", 16)
+	require.NoError(t, err)
+
+	assert.True(t, isStartBranchFor("004-"+slug, "004", slug))
+	assert.True(t, isStartBranchFor("004-"+truncated, "004", slug), "started with a shorter --max-title-length")
+	assert.True(t, isStartBranchFor("004-", "004", ""), "work item without a title")
+	assert.False(t, isStartBranchFor("0040-"+slug, "004", slug))
+	assert.False(t, isStartBranchFor("004-quarterly", "004", slug), "a prefix without the hash is another title")
+	assert.False(t, isStartBranchFor("004-quarterly-abcdef", "004", slug))
+}
+
 func TestInferWorkspaceBehavior(t *testing.T) {
 	t.Run("returns standalone when no workspace config", func(t *testing.T) {
 		cfg := &config.Config{}
@@ -431,6 +475,172 @@ func TestDeriveWorktreeRoot(t *testing.T) {
 	})
 }
 
+func TestStartGitHubIssue(t *testing.T) {
+	setup := func(t *testing.T, issueField string) *StartContext {
+		t.Helper()
+		tmpDir := t.TempDir()
+		origDir, _ := os.Getwd()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir(origDir) })
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		path := ".work/1_todo/014-login.task.md"
+		content := "---\nid: \"014\"\ntitle: Login\nstatus: todo\nkind: task\n" + issueField + "---\n# Login\n\nAdd a login page.\n"
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return &StartContext{
+			WorkItemID:   "014",
+			WorkItemPath: path,
+			Metadata:     workItemMetadata{id: "014", title: "Login"},
+			BranchName:   "014-login",
+			Config:       testCfgWithDir(tmpDir),
+		}
+	}
+
+	t.Run("parses issue references", func(t *testing.T) {
+		for value, want := range map[string]string{"42": "#42", "#42": "#42", " owner/repo#7 ": "owner/repo#7"} {
+			ref, ok := parseGitHubIssueRef(value)
+			assert.True(t, ok, value)
+			assert.Equal(t, want, ref)
+		}
+		for _, value := range []string{"", "#", "0", "abc", "#42x", "owner#42"} {
+			_, ok := parseGitHubIssueRef(value)
+			assert.False(t, ok, value)
+		}
+	})
+
+	t.Run("reads github_issue and lets --issue override it", func(t *testing.T) {
+		ctx := setup(t, "github_issue: 42\n")
+		assert.Equal(t, "#42", startGitHubIssue(ctx))
+
+		ctx.Flags.Issue = 7
+		assert.Equal(t, "#7", startGitHubIssue(ctx))
+
+		assert.Equal(t, "", startGitHubIssue(setup(t, "")))
+	})
+
+	t.Run("adds a closing keyword to the body", func(t *testing.T) {
+		assert.Equal(t, "# Login\n\nCloses #42", draftPRBody("# Login\n", "#42"))
+		assert.Equal(t, "Closes owner/repo#7", draftPRBody("", "owner/repo#7"))
+		assert.Equal(t, "# Login\n", draftPRBody("# Login\n", ""))
+	})
+
+	t.Run("closes the issue only from the PR of its repository in polyrepo", func(t *testing.T) {
+		ctx := setup(t, "github_issue: Owner/API#42\n")
+		assert.Equal(t, "Owner/API#42", draftPRIssue(ctx, "owner", "web"))
+
+		ctx.Behavior = WorkspaceBehaviorPolyrepo
+		assert.Equal(t, "Owner/API#42", draftPRIssue(ctx, "owner", "api"))
+		assert.Equal(t, "", draftPRIssue(ctx, "owner", "web"))
+
+		ctx.Flags.Issue = 7
+		assert.Equal(t, "", draftPRIssue(ctx, "owner", "api"))
+	})
+
+	t.Run("creates the draft PR with the title and linked issue", func(t *testing.T) {
+		var created map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/api/v3/repos/owner/repo/pulls" {
+				_ = json.NewDecoder(r.Body).Decode(&created)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"number": 3, "html_url": "https://github.com/owner/repo/pull/3"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		t.Setenv("KIRA_GITHUB_TOKEN", "test-token")
+
+		ctx := setup(t, "github_issue: \"#42\"\n")
+		require.NoError(t, createDraftPRAfterPush(ctx, "https://github.com/owner/repo.git", server.URL, "main"))
+		require.NotNil(t, created)
+		assert.Equal(t, "014: Login", created["title"])
+		assert.True(t, strings.HasSuffix(created["body"].(string), "\n\nCloses #42"), created["body"])
+		assert.Equal(t, true, created["draft"])
+	})
+
+	t.Run("rejects --issue with --no-draft-pr", func(t *testing.T) {
+		err := validateStartFlagCombinations(StartFlags{Issue: 42, NoDraftPR: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--issue cannot be used together with --no-draft-pr")
+
+		require.Error(t, validateStartFlagCombinations(StartFlags{Issue: -1}))
+		require.NoError(t, validateStartFlagCombinations(StartFlags{Issue: 42}))
+	})
+}
+
+func TestWorktreePathTemplate(t *testing.T) {
+	t.Run("renders placeholders", func(t *testing.T) {
+		path, err := renderWorktreePathTemplate("/worktrees/{repo}/{id}-{slug}", "/src/kira", "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+		assert.Equal(t, "/worktrees/kira/012-add-login", path)
+
+		path, err = renderWorktreePathTemplate("../{repo}_wt/{branch}", "/src/kira", "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+		assert.Equal(t, "/src/kira_wt/012-add-login", path)
+	})
+
+	t.Run("expands ~ to the home directory", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+
+		path, err := renderWorktreePathTemplate("~/worktrees/{repo}/{id}", "/src/kira", "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(home, "worktrees", "kira", "012"), path)
+	})
+
+	t.Run("rejects paths inside the repository", func(t *testing.T) {
+		_, err := renderWorktreePathTemplate("worktrees/{branch}", "/src/kira", "012", "add-login", "012-add-login")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "inside the repository /src/kira")
+
+		_, err = renderWorktreePathTemplate("/src/kira/{id}", "/src/kira", "012", "add-login", "012-add-login")
+		require.Error(t, err)
+	})
+
+	t.Run("sets the start context worktree root and path", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		repoDir := filepath.Join(tmpDir, "kira")
+		require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".git"), 0o700))
+		require.NoError(t, os.Chdir(repoDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		cfg := &config.Config{Worktree: &config.WorktreeConfig{PathTemplate: filepath.Join(tmpDir, "wt", "{repo}", "{id}-{slug}")}}
+		root, path, err := resolveWorkItemWorktree(cfg, WorkspaceBehaviorStandalone, "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(tmpDir, "wt", "kira"), root)
+		assert.Equal(t, filepath.Join(tmpDir, "wt", "kira", "012-add-login"), path)
+
+		ctx := &StartContext{WorktreeRoot: root, WorktreePath: path, BranchName: "012-add-login"}
+		assert.Equal(t, path, ctx.worktreePath())
+	})
+
+	t.Run("expands polyrepo project worktrees under the rendered path", func(t *testing.T) {
+		path, err := renderWorktreePathTemplate("/worktrees/{repo}/{branch}", "/src/workspace", "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+
+		projects := []PolyrepoProject{
+			{Name: "frontend", Path: "/src/frontend", Mount: "frontend"},
+			{Name: "api", Path: "/src/monorepo/api", Mount: "api", RepoRoot: "/src/monorepo"},
+			{Name: "worker", Path: "/src/monorepo/worker", Mount: "worker", RepoRoot: "/src/monorepo"},
+		}
+		paths := buildPolyrepoWorktreePaths(projects, path, filepath.Join(path, "main"))
+
+		assert.Equal(t, "/worktrees/workspace/012-add-login/main", paths["main"])
+		assert.Equal(t, "/worktrees/workspace/012-add-login/frontend", paths["frontend"])
+		assert.Equal(t, "/worktrees/workspace/012-add-login/monorepo", paths["api"])
+		assert.NotContains(t, paths, "worker")
+	})
+
+	t.Run("keeps the derived scheme without a template", func(t *testing.T) {
+		cfg := &config.Config{Workspace: &config.WorkspaceConfig{WorktreeRoot: "/custom/worktrees"}}
+		root, path, err := resolveWorkItemWorktree(cfg, WorkspaceBehaviorStandalone, "012", "add-login", "012-add-login")
+		require.NoError(t, err)
+		assert.Equal(t, "/custom/worktrees", root)
+		assert.Equal(t, "/custom/worktrees/012-add-login", path)
+	})
+}
+
 func TestIsExternalGitRepo(t *testing.T) {
 	t.Run("returns false for non-existent path", func(t *testing.T) {
 		result := isExternalGitRepo("/non/existent/path")
@@ -1348,6 +1558,39 @@ func TestResolveRemoteName(t *testing.T) {
 	})
 }
 
+func TestWithRemoteOverride(t *testing.T) {
+	t.Run("returns cfg unchanged when override is empty", func(t *testing.T) {
+		cfg := &config.Config{Git: &config.GitConfig{Remote: "upstream"}}
+		assert.Same(t, cfg, withRemoteOverride(cfg, ""))
+	})
+
+	t.Run("overrides git.remote without mutating the original", func(t *testing.T) {
+		cfg := &config.Config{Git: &config.GitConfig{Remote: "upstream", TrunkBranch: "develop"}}
+		overridden := withRemoteOverride(cfg, "fork")
+
+		assert.Equal(t, "fork", resolveRemoteName(overridden, nil))
+		assert.Equal(t, "develop", overridden.Git.TrunkBranch)
+		assert.Equal(t, "upstream", cfg.Git.Remote)
+	})
+
+	t.Run("applies when git config is absent", func(t *testing.T) {
+		overridden := withRemoteOverride(&config.Config{}, "fork")
+		assert.Equal(t, "fork", resolveRemoteName(overridden, nil))
+	})
+
+	t.Run("override takes precedence over project remotes", func(t *testing.T) {
+		cfg := &config.Config{Workspace: &config.WorkspaceConfig{Projects: []config.ProjectConfig{
+			{Name: "api", Remote: "github"},
+			{Name: "web"},
+		}}}
+		overridden := withRemoteOverride(cfg, "fork")
+
+		assert.Equal(t, "fork", resolveRemoteName(overridden, &overridden.Workspace.Projects[0]))
+		assert.Equal(t, "fork", resolveRemoteName(overridden, &overridden.Workspace.Projects[1]))
+		assert.Equal(t, "github", cfg.Workspace.Projects[0].Remote)
+	})
+}
+
 func TestDetermineTrunkBranch(t *testing.T) {
 	t.Run("uses flag value when provided in dry-run", func(t *testing.T) {
 		cfg := &config.Config{
@@ -1791,6 +2034,31 @@ func TestPerformStatusCheck(t *testing.T) {
 		assert.False(t, ctx.SkipStatusUpdate)
 	})
 
+	t.Run("no-move skips the status update even when the status differs", func(t *testing.T) {
+		ctx := &StartContext{
+			WorkItemID: "001",
+			Config: &config.Config{
+				Start: &config.StartConfig{
+					StatusAction: "commit_and_push",
+					MoveTo:       "doing",
+				},
+			},
+			Metadata: workItemMetadata{
+				currentStatus: "backlog",
+			},
+			Flags: StartFlags{
+				NoMove: true,
+			},
+		}
+
+		err := performStatusCheck(ctx)
+		assert.NoError(t, err)
+		assert.True(t, ctx.SkipStatusUpdate)
+		// With SkipStatusUpdate set, neither status update path moves or commits the work item
+		assert.NoError(t, performStatusUpdate(ctx, "", "main", "origin"))
+		assert.NoError(t, performStatusUpdateOnBranch(ctx, ""))
+	})
+
 	t.Run("flag status_action overrides config", func(t *testing.T) {
 		ctx := &StartContext{
 			WorkItemID: "001",
@@ -2058,7 +2326,7 @@ func TestMoveWorkItemWithoutCommit(t *testing.T) {
 			},
 		}
 
-		err := moveWorkItemWithoutCommit(cfg, "001", "invalid_status")
+		_, _, err := moveWorkItemWithoutCommit(cfg, ".work/0_backlog/001-test-task.md", "invalid_status")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid target status")
 	})
@@ -2092,8 +2360,10 @@ func TestMoveWorkItemWithoutCommit(t *testing.T) {
 			},
 		}
 
-		err = moveWorkItemWithoutCommit(cfg, "001", "doing")
+		newPath, renumber, err := moveWorkItemWithoutCommit(cfg, ".work/0_backlog/001-test-task.md", "doing")
 		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(".work", "2_doing", "001-test-task.md"), newPath)
+		assert.Nil(t, renumber)
 
 		// Verify file was moved (old location should not exist)
 		_, err = os.Stat(filepath.Join(cwd, ".work", "0_backlog", "001-test-task.md"))
@@ -2307,6 +2577,59 @@ func TestLaunchIDEPriority(t *testing.T) {
 		launchIDE(ctx, "/test/worktree")
 		// Test passes if no panic
 	})
+
+	t.Run("opens the first installed command of worktree.ide_commands", func(t *testing.T) {
+		ctx := &StartContext{
+			Config: &config.Config{
+				IDE:      &config.IDEConfig{Command: "nonexistent-test-ide", Args: []string{"--new-window"}},
+				Worktree: &config.WorktreeConfig{IDECommands: []string{"other-nonexistent-ide", "ls", "echo"}},
+			},
+			Flags: StartFlags{DryRun: true},
+		}
+
+		output, err := captureStdout(func() error {
+			launchIDE(ctx, "/test/path")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Warning: IDE command 'nonexistent-test-ide' not found, trying the next one.")
+		assert.Contains(t, output, "Warning: IDE command 'other-nonexistent-ide' not found, trying the next one.")
+		assert.Contains(t, output, "ls /test/path")
+		assert.NotContains(t, output, "echo")
+	})
+
+	t.Run("prints the worktree path when no command is installed", func(t *testing.T) {
+		ctx := &StartContext{
+			Config: &config.Config{
+				Worktree: &config.WorktreeConfig{IDECommands: []string{"nonexistent-test-ide"}},
+			},
+			Flags: StartFlags{DryRun: true},
+		}
+
+		output, err := captureStdout(func() error {
+			launchIDE(ctx, "/test/worktree")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Warning: IDE command 'nonexistent-test-ide' not found")
+		assert.Contains(t, output, "Worktree created at /test/worktree")
+	})
+
+	t.Run("--ide still takes priority over worktree.ide_commands", func(t *testing.T) {
+		ctx := &StartContext{
+			Config: &config.Config{
+				Worktree: &config.WorktreeConfig{IDECommands: []string{"ls"}},
+			},
+			Flags: StartFlags{IDECommand: "echo", DryRun: true},
+		}
+
+		output, err := captureStdout(func() error {
+			launchIDE(ctx, "/test/path")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "[DRY RUN] echo /test/path\n", output)
+	})
 }
 
 func TestExecuteSetup(t *testing.T) {
@@ -2437,6 +2760,55 @@ func TestExecuteSetupCommands(t *testing.T) {
 	})
 }
 
+func TestRunStartExec(t *testing.T) {
+	t.Run("runs the command in the worktree", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		ctx := &StartContext{
+			Config:       &config.Config{},
+			WorktreePath: tmpDir,
+			Behavior:     WorkspaceBehaviorStandalone,
+			Flags:        StartFlags{Exec: "pwd > exec-ran.txt"},
+		}
+
+		require.NoError(t, runStartExec(ctx))
+		assert.Equal(t, tmpDir, strings.TrimSpace(mustReadFile(t, filepath.Join(tmpDir, "exec-ran.txt"))))
+	})
+
+	t.Run("runs in the main worktree for polyrepo", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "main"), 0o700))
+		ctx := &StartContext{
+			Config:       &config.Config{},
+			WorktreePath: tmpDir,
+			Behavior:     WorkspaceBehaviorPolyrepo,
+			Flags:        StartFlags{Exec: "echo ok > exec-ran.txt"},
+		}
+
+		require.NoError(t, runStartExec(ctx))
+		assert.FileExists(t, filepath.Join(tmpDir, "main", "exec-ran.txt"))
+	})
+
+	t.Run("returns the exit code of a failing command", func(t *testing.T) {
+		ctx := &StartContext{
+			Config:       &config.Config{},
+			WorktreePath: t.TempDir(),
+			Behavior:     WorkspaceBehaviorStandalone,
+			Flags:        StartFlags{Exec: "exit 3"},
+		}
+
+		err := runStartExec(ctx)
+		var exitErr *ExitCodeError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 3, exitErr.Code)
+		assert.EqualError(t, err, "--exec command failed with exit code 3: exit 3")
+	})
+
+	t.Run("does nothing without --exec", func(t *testing.T) {
+		ctx := &StartContext{Config: &config.Config{}, WorktreePath: "/does/not/exist"}
+		assert.NoError(t, runStartExec(ctx))
+	})
+}
+
 func TestExecuteProjectSetups(t *testing.T) {
 	t.Run("does nothing when workspace config is nil", func(t *testing.T) {
 		ctx := &StartContext{
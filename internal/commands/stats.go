@@ -0,0 +1,452 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"kira/internal/config"
+)
+
+// unassignedStatsRow is the assignee shown for open work items without an assignee.
+const unassignedStatsRow = "unassigned"
+
+// unknownKindStatsRow is the kind counted for work items without a kind field.
+const unknownKindStatsRow = "unknown"
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show work item statistics",
+	Long: `Shows statistics about the work items in the workspace.
+
+Without a subcommand, every work item in .work/ (archived statuses included) is counted
+by status, by kind and by assignee, in three compact tables. The assignee is read from
+assigned, or from assignment.field_defaults for the item's kind; work items with several
+assignees count for each of them, and those without one in an "unassigned" row.
+
+--since <date> (YYYY-MM-DD) only counts work items created or updated on or after that
+date. --output json prints the counts as
+{"by_status": {...}, "by_kind": {...}, "by_assignee": {...}}.
+
+Examples:
+  kira stats
+  kira stats --since 2024-06-01
+  kira stats --output json
+  kira stats assignees`,
+	Args: cobra.NoArgs,
+	RunE: runStats,
+}
+
+var statsAssigneesCmd = &cobra.Command{
+	Use:   "assignees",
+	Short: "Show how many open work items each person has",
+	Long: `Counts the open work items per assignee, most loaded first. Open work items are
+those outside the done status and archived_statuses (kira.yml). Emails are shown with
+the user's name when it is known (kira users). Work items with several assignees count
+for each of them; work items without an assignee are counted in an "unassigned" row.
+
+The assignee is read from --field, else from assignment.field_defaults for the item's
+kind, else from assigned.
+
+Examples:
+  kira stats assignees
+  kira stats assignees --by-status
+  kira stats assignees --field reviewer
+  kira stats assignees --json`,
+	Args: cobra.NoArgs,
+	RunE: runStatsAssignees,
+}
+
+func init() {
+	statsCmd.Flags().String("since", "", "Only count work items created or updated on or after this date (YYYY-MM-DD)")
+	statsCmd.Flags().Bool("json", false, "Output as JSON")
+	statsCmd.Flags().String("output", outputFormatText, "Output format: text or json (same as --json)")
+	statsCmd.AddCommand(statsAssigneesCmd)
+	statsAssigneesCmd.Flags().Bool("by-status", false, "Add a column per status")
+	statsAssigneesCmd.Flags().Bool("json", false, "Output as JSON")
+	statsAssigneesCmd.Flags().StringP("field", "f", "assigned", "Front matter field that holds the assignee")
+	statsAssigneesCmd.Flags().String("assignee-display", "", "Show known users as name, email, or both (\"Name <email>\"); default: output.assignee_display or both")
+}
+
+// workItemStats are the work item counts of kira stats.
+type workItemStats struct {
+	Total      int            `json:"-"`
+	Statuses   []string       `json:"-"` // the statuses in ByStatus, in status folder order
+	ByStatus   map[string]int `json:"by_status"`
+	ByKind     map[string]int `json:"by_kind"`
+	ByAssignee map[string]int `json:"by_assignee"`
+}
+
+func runStats(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+	sinceFlag, _ := cmd.Flags().GetString("since")
+	jsonFlag, _ := cmd.Flags().GetBool("json")
+	output, _ := cmd.Flags().GetString("output")
+
+	jsonOutput, err := resolveJSONOutput(output, jsonFlag)
+	if err != nil {
+		return err
+	}
+	var since *time.Time
+	if sinceFlag != "" {
+		parsed, err := time.Parse("2006-01-02", strings.TrimSpace(sinceFlag))
+		if err != nil {
+			return fmt.Errorf("invalid --since date '%s': use YYYY-MM-DD", sinceFlag)
+		}
+		since = &parsed
+	}
+
+	stats, err := collectWorkItemStats(cfg, since)
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	}
+	displayWorkItemStats(os.Stdout, stats, since)
+	return nil
+}
+
+// collectWorkItemStats counts the work items of every status by status, kind and assignee.
+// With since, only work items created or updated on or after it are counted.
+func collectWorkItemStats(cfg *config.Config, since *time.Time) (workItemStats, error) {
+	stats := workItemStats{ByStatus: map[string]int{}, ByKind: map[string]int{}, ByAssignee: map[string]int{}}
+	assigneeFlags := AssignFlags{Field: "assigned"}
+	for _, status := range orderedStatuses(cfg, "") {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return stats, err
+		}
+		for _, path := range paths {
+			frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", path, err)
+				continue
+			}
+			if since != nil && !changedSince(frontMatter, *since) {
+				continue
+			}
+			if stats.ByStatus[status] == 0 {
+				stats.Statuses = append(stats.Statuses, status)
+			}
+			stats.Total++
+			stats.ByStatus[status]++
+			kind := strings.TrimSpace(frontMatterString(frontMatter["kind"]))
+			if kind == "" {
+				kind = unknownKindStatsRow
+			}
+			stats.ByKind[kind]++
+			assignees := workItemAssignees(frontMatter, statsAssigneeField(frontMatter, assigneeFlags, cfg))
+			if len(assignees) == 0 {
+				assignees = []string{unassignedStatsRow}
+			}
+			for _, assignee := range assignees {
+				stats.ByAssignee[assignee]++
+			}
+		}
+	}
+	return stats, nil
+}
+
+// changedSince reports whether a work item was created or updated on or after since.
+func changedSince(frontMatter map[string]interface{}, since time.Time) bool {
+	for _, field := range []string{"created", "updated"} {
+		if t, ok := parseWorkItemTimestamp(frontMatter[field]); ok && !t.Before(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// displayWorkItemStats prints the total and a table per grouping: statuses in status folder
+// order, kinds and assignees by count (highest first).
+func displayWorkItemStats(out io.Writer, stats workItemStats, since *time.Time) {
+	if stats.Total == 0 {
+		_, _ = fmt.Fprintln(out, "No work items found.")
+		return
+	}
+	if since != nil {
+		_, _ = fmt.Fprintf(out, "%d work item(s) created or updated since %s\n", stats.Total, since.Format("2006-01-02"))
+	} else {
+		_, _ = fmt.Fprintf(out, "%d work item(s)\n", stats.Total)
+	}
+	writeStatsTable(out, "STATUS", stats.Statuses, stats.ByStatus)
+	writeStatsTable(out, "KIND", keysByCount(stats.ByKind), stats.ByKind)
+	writeStatsTable(out, "ASSIGNEE", keysByCount(stats.ByAssignee), stats.ByAssignee)
+}
+
+// writeStatsTable prints a blank line and a table of keys and their counts.
+func writeStatsTable(out io.Writer, heading string, keys []string, counts map[string]int) {
+	rows := [][]string{{heading, "COUNT"}}
+	for _, key := range keys {
+		rows = append(rows, []string{key, strconv.Itoa(counts[key])})
+	}
+	_, _ = fmt.Fprintln(out)
+	writeListRows(out, rows)
+}
+
+// keysByCount returns the keys of counts, highest count first and then alphabetically, with the
+// unassigned row last.
+func keysByCount(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == unassignedStatsRow) != (keys[j] == unassignedStatsRow) {
+			return keys[j] == unassignedStatsRow
+		}
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// assigneeLoad is the number of open work items of one assignee.
+type assigneeLoad struct {
+	Assignee string         `json:"assignee"`
+	Email    string         `json:"email,omitempty"`
+	Total    int            `json:"total"`
+	ByStatus map[string]int `json:"by_status,omitempty"`
+}
+
+func runStatsAssignees(cmd *cobra.Command, _ []string) error {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkWorkDir(cfg); err != nil {
+		return err
+	}
+
+	byStatus, _ := cmd.Flags().GetBool("by-status")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	field, _ := cmd.Flags().GetString("field")
+	flags := AssignFlags{Field: field, FieldSet: cmd.Flags().Changed("field")}
+	if err := validateAssignFieldName(field); err != nil {
+		return err
+	}
+	displayFlag, _ := cmd.Flags().GetString("assignee-display")
+	display, err := resolveAssigneeDisplay(strings.TrimSpace(displayFlag), cfg)
+	if err != nil {
+		return err
+	}
+
+	statuses := openStatuses(cfg)
+	loads, err := collectAssigneeLoads(cfg, statuses, flags, assigneeNames(cfg))
+	if err != nil {
+		return err
+	}
+	if jsonOutput {
+		if !byStatus {
+			for i := range loads {
+				loads[i].ByStatus = nil
+			}
+		}
+		return displayAssigneeLoadsJSON(os.Stdout, loads)
+	}
+	if !byStatus {
+		statuses = nil
+	}
+	displayAssigneeLoads(os.Stdout, loads, statuses, display)
+	return nil
+}
+
+// openStatuses returns the statuses counted as open, in status folder order: all statuses
+// except done and archived_statuses.
+func openStatuses(cfg *config.Config) []string {
+	var statuses []string
+	for _, status := range orderedStatuses(cfg, "") {
+		if status != defaultReleaseStatus && !config.IsArchivedStatus(cfg, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// assigneeNames maps lowercase emails to user names, from kira users when available and
+// from users.saved_users otherwise.
+func assigneeNames(cfg *config.Config) map[string]string {
+	names := make(map[string]string)
+	for _, saved := range cfg.Users.SavedUsers {
+		if saved.Email != "" && saved.Name != "" {
+			names[strings.ToLower(saved.Email)] = saved.Name
+		}
+	}
+	users, err := collectUsersForAssignment(cfg)
+	if err != nil {
+		return names
+	}
+	for _, user := range users {
+		if user.Name != "" {
+			names[strings.ToLower(user.Email)] = user.Name
+		}
+	}
+	return names
+}
+
+// collectAssigneeLoads counts the work items in statuses per assignee. Rows are sorted by total
+// (most loaded first) and then by assignee, with the unassigned row last.
+func collectAssigneeLoads(cfg *config.Config, statuses []string, flags AssignFlags, names map[string]string) ([]assigneeLoad, error) {
+	loads := make(map[string]*assigneeLoad)
+	unassigned := &assigneeLoad{Assignee: unassignedStatsRow, ByStatus: map[string]int{}}
+	for _, status := range statuses {
+		paths, err := statusWorkItemFiles(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			frontMatter, _, err := parseWorkItemFrontMatter(path, cfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s: %v\n", path, err)
+				continue
+			}
+			assignees := workItemAssignees(frontMatter, statsAssigneeField(frontMatter, flags, cfg))
+			if len(assignees) == 0 {
+				unassigned.Total++
+				unassigned.ByStatus[status]++
+				continue
+			}
+			for _, assignee := range assignees {
+				load := assigneeLoadFor(loads, assignee, names)
+				load.Total++
+				load.ByStatus[status]++
+			}
+		}
+	}
+
+	rows := make([]assigneeLoad, 0, len(loads)+1)
+	for _, load := range loads {
+		rows = append(rows, *load)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Total != rows[j].Total {
+			return rows[i].Total > rows[j].Total
+		}
+		return strings.ToLower(rows[i].Assignee) < strings.ToLower(rows[j].Assignee)
+	})
+	if unassigned.Total > 0 {
+		rows = append(rows, *unassigned)
+	}
+	return rows, nil
+}
+
+// assigneeLoadFor returns the row of assignee, creating it on first use. Rows are keyed by
+// lowercase email so differently cased emails are counted together.
+func assigneeLoadFor(loads map[string]*assigneeLoad, assignee string, names map[string]string) *assigneeLoad {
+	key := strings.ToLower(assignee)
+	if load, ok := loads[key]; ok {
+		return load
+	}
+	load := &assigneeLoad{Assignee: assignee, ByStatus: map[string]int{}}
+	if strings.Contains(assignee, "@") {
+		load.Email = assignee
+		if name, ok := names[key]; ok {
+			load.Assignee = name
+		}
+	}
+	loads[key] = load
+	return load
+}
+
+// statsAssigneeField is resolveAssignField for already parsed front matter.
+func statsAssigneeField(frontMatter map[string]interface{}, flags AssignFlags, cfg *config.Config) string {
+	if flags.FieldSet || cfg.Assignment == nil {
+		return flags.Field
+	}
+	kind, _ := frontMatter["kind"].(string)
+	if field, ok := cfg.Assignment.FieldDefaults[kind]; ok {
+		return field
+	}
+	return flags.Field
+}
+
+// workItemAssignees returns the non-empty assignees in field, which holds a single value or a list.
+// Assignee objects count by their email.
+func workItemAssignees(frontMatter map[string]interface{}, field string) []string {
+	var values []string
+	switch v := frontMatter[field].(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		for _, item := range v {
+			if object, ok := item.(map[string]interface{}); ok {
+				values = append(values, assigneeValueString(object))
+				continue
+			}
+			values = append(values, frontMatterString(item))
+		}
+	case map[string]interface{}:
+		values = []string{assigneeValueString(v)}
+	default:
+		values = []string{frontMatterString(v)}
+	}
+
+	assignees := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			assignees = append(assignees, value)
+		}
+	}
+	return assignees
+}
+
+// displayAssigneeLoads prints one row per assignee with their total; when statuses is set, a
+// column per status is added before the total. Known users are shown according to display.
+func displayAssigneeLoads(out io.Writer, loads []assigneeLoad, statuses []string, display string) {
+	if len(loads) == 0 {
+		_, _ = fmt.Fprintln(out, "No open work items found.")
+		return
+	}
+
+	header := []string{"ASSIGNEE"}
+	for _, status := range statuses {
+		header = append(header, strings.ToUpper(status))
+	}
+	header = append(header, "TOTAL")
+
+	rows := [][]string{header}
+	for _, load := range loads {
+		row := []string{formatAssigneeLoadName(load, display)}
+		for _, status := range statuses {
+			row = append(row, strconv.Itoa(load.ByStatus[status]))
+		}
+		rows = append(rows, append(row, strconv.Itoa(load.Total)))
+	}
+	writeListRows(out, rows)
+}
+
+// formatAssigneeLoadName shows known users according to display and other assignees as written.
+func formatAssigneeLoadName(load assigneeLoad, display string) string {
+	if load.Email != "" && load.Assignee != load.Email {
+		return formatUserAs(UserInfo{Name: load.Assignee, Email: load.Email}, display)
+	}
+	return load.Assignee
+}
+
+func displayAssigneeLoadsJSON(out io.Writer, loads []assigneeLoad) error {
+	if loads == nil {
+		loads = []assigneeLoad{}
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(loads)
+}
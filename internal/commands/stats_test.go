@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kira/internal/config"
+)
+
+// setupStatsWorkspace creates a workspace with a saved user, an archived status and the given work items.
+func setupStatsWorkspace(t *testing.T, files map[string]string) {
+	t.Helper()
+	setupListWorkspaceWithArchive(t, files)
+	kiraYml := "version: \"1.0\"\narchived_statuses: [archived]\nusers:\n  use_git_history: false\n  saved_users:\n    - email: alice@example.com\n      name: Alice\n"
+	require.NoError(t, os.WriteFile("kira.yml", []byte(kiraYml), 0o600))
+}
+
+// runStatsAssigneesCapture runs kira stats assignees with the given flags and returns stdout.
+func runStatsAssigneesCapture(t *testing.T, flags map[string]string) string {
+	t.Helper()
+	for name, value := range flags {
+		require.NoError(t, statsAssigneesCmd.Flags().Set(name, value))
+	}
+	t.Cleanup(func() {
+		for name := range flags {
+			flag := statsAssigneesCmd.Flags().Lookup(name)
+			_ = flag.Value.Set(flag.DefValue)
+			flag.Changed = false
+		}
+	})
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+	runErr := runStatsAssignees(statsAssigneesCmd, nil)
+	_ = w.Close()
+	os.Stdout = oldStdout
+	require.NoError(t, runErr)
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	require.NoError(t, err)
+	return buf.String()
+}
+
+func statsWorkItem(id, status, assigned string) string {
+	return "---\nid: " + id + "\ntitle: Item " + id + "\nstatus: " + status + "\nkind: task\n" + assigned + "---\n# Item\n"
+}
+
+func TestStatsAssignees(t *testing.T) {
+	files := map[string]string{
+		filepath.Join("1_todo", "001-a.task.md"):    statsWorkItem("001", "todo", "assigned: alice@example.com\n"),
+		filepath.Join("1_todo", "002-b.task.md"):    statsWorkItem("002", "todo", "assigned: [Alice@example.com, bob@example.com]\n"),
+		filepath.Join("2_doing", "003-c.task.md"):   statsWorkItem("003", "doing", "assigned: alice@example.com\n"),
+		filepath.Join("2_doing", "004-d.task.md"):   statsWorkItem("004", "doing", ""),
+		filepath.Join("4_done", "005-e.task.md"):    statsWorkItem("005", "done", "assigned: bob@example.com\n"),
+		filepath.Join("z_archive", "006-f.task.md"): statsWorkItem("006", "archived", "assigned: bob@example.com\n"),
+	}
+
+	t.Run("counts open work items per assignee, most loaded first", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output := runStatsAssigneesCapture(t, nil)
+		assert.Equal(t, "ASSIGNEE                   TOTAL\n"+
+			"Alice <alice@example.com>  3\n"+
+			"bob@example.com            1\n"+
+			"unassigned                 1\n", output)
+	})
+
+	t.Run("adds a column per open status with --by-status", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output := runStatsAssigneesCapture(t, map[string]string{"by-status": "true"})
+		assert.Contains(t, output, "ASSIGNEE                   BACKLOG  TODO  DOING  REVIEW  TOTAL\n")
+		assert.Contains(t, output, "Alice <alice@example.com>  0        2     1      0       3\n")
+		assert.NotContains(t, output, "DONE")
+		assert.NotContains(t, output, "ARCHIVED")
+	})
+
+	t.Run("outputs JSON", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output := runStatsAssigneesCapture(t, map[string]string{"json": "true", "by-status": "true"})
+		var loads []assigneeLoad
+		require.NoError(t, json.Unmarshal([]byte(output), &loads))
+		require.Len(t, loads, 3)
+		assert.Equal(t, assigneeLoad{Assignee: "Alice", Email: "alice@example.com", Total: 3, ByStatus: map[string]int{"todo": 2, "doing": 1}}, loads[0])
+		assert.Equal(t, assigneeLoad{Assignee: "unassigned", Total: 1, ByStatus: map[string]int{"doing": 1}}, loads[2])
+	})
+
+	t.Run("shows known users by name with --assignee-display name", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+
+		output := runStatsAssigneesCapture(t, map[string]string{"assignee-display": "name"})
+		assert.Equal(t, "ASSIGNEE         TOTAL\n"+
+			"Alice            3\n"+
+			"bob@example.com  1\n"+
+			"unassigned       1\n", output)
+	})
+
+	t.Run("shows known users by email with output.assignee_display", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+		kiraYml, err := os.ReadFile("kira.yml")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile("kira.yml", append(kiraYml, []byte("output:\n  assignee_display: email\n")...), 0o600))
+
+		output := runStatsAssigneesCapture(t, nil)
+		assert.Contains(t, output, "\nalice@example.com  3\n")
+	})
+
+	t.Run("reads the assignee from --field", func(t *testing.T) {
+		setupStatsWorkspace(t, map[string]string{
+			filepath.Join("1_todo", "001-a.task.md"): statsWorkItem("001", "todo", "reviewer: carol@example.com\n"),
+		})
+
+		output := runStatsAssigneesCapture(t, map[string]string{"field": "reviewer"})
+		assert.Contains(t, output, "carol@example.com  1\n")
+		assert.NotContains(t, output, "unassigned")
+	})
+
+	t.Run("reports an empty workspace", func(t *testing.T) {
+		setupStatsWorkspace(t, nil)
+
+		assert.Equal(t, "No open work items found.\n", runStatsAssigneesCapture(t, nil))
+	})
+}
+
+func TestStats(t *testing.T) {
+	files := map[string]string{
+		filepath.Join("1_todo", "001-a.task.md"):    statsWorkItem("001", "todo", "assigned: alice@example.com\ncreated: 2024-05-01\n"),
+		filepath.Join("1_todo", "002-b.prd.md"):     "---\nid: 002\ntitle: B\nstatus: todo\nkind: prd\nassigned: [alice@example.com, bob@example.com]\ncreated: 2024-05-01\nupdated: 2024-06-15T10:00:00Z\n---\n",
+		filepath.Join("2_doing", "003-c.task.md"):   statsWorkItem("003", "doing", "created: 2024-06-02\n"),
+		filepath.Join("z_archive", "004-d.task.md"): statsWorkItem("004", "archived", "assigned: bob@example.com\ncreated: 2024-01-01\n"),
+	}
+
+	t.Run("counts every work item by status, kind and assignee", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		stats, err := collectWorkItemStats(cfg, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"todo": 2, "doing": 1, "archived": 1}, stats.ByStatus)
+		assert.Equal(t, map[string]int{"task": 3, "prd": 1}, stats.ByKind)
+		assert.Equal(t, map[string]int{"alice@example.com": 2, "bob@example.com": 2, "unassigned": 1}, stats.ByAssignee)
+
+		var out bytes.Buffer
+		displayWorkItemStats(&out, stats, nil)
+		assert.Equal(t, "4 work item(s)\n\n"+
+			"STATUS    COUNT\ntodo      2\ndoing     1\narchived  1\n\n"+
+			"KIND  COUNT\ntask  3\nprd   1\n\n"+
+			"ASSIGNEE           COUNT\nalice@example.com  2\nbob@example.com    2\nunassigned         1\n", out.String())
+	})
+
+	t.Run("only counts work items created or updated since a date", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+		since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		stats, err := collectWorkItemStats(cfg, &since)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"todo": 1, "doing": 1}, stats.ByStatus)
+
+		var out bytes.Buffer
+		displayWorkItemStats(&out, stats, &since)
+		assert.Contains(t, out.String(), "2 work item(s) created or updated since 2024-06-01\n")
+	})
+
+	t.Run("outputs JSON grouped by status, kind and assignee", func(t *testing.T) {
+		setupStatsWorkspace(t, files)
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		stats, err := collectWorkItemStats(cfg, nil)
+		require.NoError(t, err)
+		encoded, err := json.Marshal(stats)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"by_status":{"todo":2,"doing":1,"archived":1},"by_kind":{"task":3,"prd":1},`+
+			`"by_assignee":{"alice@example.com":2,"bob@example.com":2,"unassigned":1}}`, string(encoded))
+	})
+}
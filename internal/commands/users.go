@@ -305,9 +305,7 @@ func displayUsersTable(users []UserInfo) error {
 		if user.FirstCommit != nil {
 			dateStr = user.FirstCommit.Format("2006-01-02")
 		}
-		if len(display) > maxUserLen {
-			display = display[:maxUserLen-3] + "..."
-		}
+		display = truncateForDisplay(display, maxUserLen)
 		fmt.Printf(rowFormat, user.Number, display, dateStr, user.Source)
 	}
 	return nil
@@ -351,10 +349,32 @@ func displayUsersJSON(users []UserInfo) error {
 }
 
 func formatUserDisplay(user UserInfo) string {
-	if user.Name != "" {
+	return formatUserAs(user, config.AssigneeDisplayBoth)
+}
+
+// formatUserAs renders a user for output according to an assignee display mode: "Name <email>"
+// (both), the name only, or the email only. A missing name or email falls back to the other.
+func formatUserAs(user UserInfo, display string) string {
+	switch {
+	case user.Name == "" || (display == config.AssigneeDisplayEmail && user.Email != ""):
+		return user.Email
+	case user.Email == "" || display == config.AssigneeDisplayName:
+		return user.Name
+	default:
 		return fmt.Sprintf("%s <%s>", user.Name, user.Email)
 	}
-	return user.Email
+}
+
+// resolveAssigneeDisplay returns the --assignee-display value, else output.assignee_display,
+// else both.
+func resolveAssigneeDisplay(flagValue string, cfg *config.Config) (string, error) {
+	if flagValue == "" {
+		return config.AssigneeDisplay(cfg), nil
+	}
+	if err := config.ValidateAssigneeDisplay(flagValue); err != nil {
+		return "", err
+	}
+	return flagValue, nil
 }
 
 func validateUsersArgs(format string, limit int) error {
@@ -33,6 +33,58 @@ func TestFormatUserDisplay(t *testing.T) {
 	})
 }
 
+func TestFormatUserAs(t *testing.T) {
+	user := UserInfo{Email: "user@example.com", Name: "John Doe"}
+
+	t.Run("both shows name and email", func(t *testing.T) {
+		assert.Equal(t, "John Doe <user@example.com>", formatUserAs(user, config.AssigneeDisplayBoth))
+		assert.Equal(t, "John Doe <user@example.com>", formatUserAs(user, ""))
+	})
+
+	t.Run("name shows only the name", func(t *testing.T) {
+		assert.Equal(t, "John Doe", formatUserAs(user, config.AssigneeDisplayName))
+	})
+
+	t.Run("email shows only the email", func(t *testing.T) {
+		assert.Equal(t, "user@example.com", formatUserAs(user, config.AssigneeDisplayEmail))
+	})
+
+	t.Run("falls back to the email when the user has no name", func(t *testing.T) {
+		noName := UserInfo{Email: "user@example.com"}
+		for _, display := range []string{config.AssigneeDisplayBoth, config.AssigneeDisplayName, config.AssigneeDisplayEmail} {
+			assert.Equal(t, "user@example.com", formatUserAs(noName, display), display)
+		}
+	})
+}
+
+func TestResolveAssigneeDisplay(t *testing.T) {
+	t.Run("defaults to both", func(t *testing.T) {
+		display, err := resolveAssigneeDisplay("", &config.Config{})
+		require.NoError(t, err)
+		assert.Equal(t, config.AssigneeDisplayBoth, display)
+	})
+
+	t.Run("uses output.assignee_display", func(t *testing.T) {
+		cfg := &config.Config{Output: &config.OutputConfig{AssigneeDisplay: config.AssigneeDisplayEmail}}
+		display, err := resolveAssigneeDisplay("", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, config.AssigneeDisplayEmail, display)
+	})
+
+	t.Run("the flag overrides the config", func(t *testing.T) {
+		cfg := &config.Config{Output: &config.OutputConfig{AssigneeDisplay: config.AssigneeDisplayEmail}}
+		display, err := resolveAssigneeDisplay(config.AssigneeDisplayName, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, config.AssigneeDisplayName, display)
+	})
+
+	t.Run("rejects unknown modes", func(t *testing.T) {
+		_, err := resolveAssigneeDisplay("initials", &config.Config{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid assignee display 'initials'")
+	})
+}
+
 func TestShouldIgnoreEmail(t *testing.T) {
 	t.Run("ignores exact email match", func(t *testing.T) {
 		cfg := &config.Config{
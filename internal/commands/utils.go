@@ -7,16 +7,67 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"kira/internal/config"
 	"kira/internal/shellutil"
+	"kira/internal/validation"
 )
 
 // gitCommandTimeout is the default timeout for git commands
 const gitCommandTimeout = 30 * time.Second
 
+// minGitMajor and minGitMinor are the oldest git version kira supports (git worktree remove needs 2.17).
+const (
+	minGitMajor = 2
+	minGitMinor = 17
+)
+
+// checkGitAvailable verifies that git can be run and meets the minimum supported version.
+// Commands that use git call this first so a missing git is reported clearly rather than
+// as an exec error from deep in the call stack.
+func checkGitAvailable() error {
+	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
+	defer cancel()
+
+	output, err := executeCommand(ctx, "git", []string{"--version"}, "", false)
+	if err != nil {
+		if isCommandNotFound(err) {
+			return fmt.Errorf("git is required but was not found on PATH. Install git (https://git-scm.com/downloads or your package manager) and make sure it is on PATH")
+		}
+		return fmt.Errorf("git is required but could not be run: %w", err)
+	}
+
+	major, minor, ok := parseGitVersion(output)
+	if ok && (major < minGitMajor || (major == minGitMajor && minor < minGitMinor)) {
+		return fmt.Errorf("git %d.%d or newer is required, found %s. Upgrade git (https://git-scm.com/downloads)", minGitMajor, minGitMinor, strings.TrimSpace(output))
+	}
+	return nil
+}
+
+// parseGitVersion extracts major and minor from `git --version` output
+// (e.g. "git version 2.39.3 (Apple Git-145)").
+func parseGitVersion(output string) (major, minor int, ok bool) {
+	fields := strings.Fields(output)
+	if len(fields) < 3 || fields[0] != "git" || fields[1] != "version" {
+		return 0, 0, false
+	}
+	parts := strings.Split(fields[2], ".")
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, errMajor := strconv.Atoi(parts[0])
+	minor, errMinor := strconv.Atoi(parts[1])
+	if errMajor != nil || errMinor != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
 // getCurrentBranch returns the current branch name
 func getCurrentBranch(dir string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), gitCommandTimeout)
@@ -101,7 +152,7 @@ func safeReadProjectFile(filePath string) ([]byte, error) {
 
 // findWorkItemFile searches for a work item file by ID in the configured work folder.
 func findWorkItemFile(workItemID string, cfg *config.Config) (string, error) {
-	var foundPath string
+	var foundPaths []string
 	workFolder := config.GetWorkFolderPath(cfg)
 	if cfg != nil && cfg.ConfigDir != "" {
 		absWork, err := config.GetWorkFolderAbsPath(cfg)
@@ -110,44 +161,31 @@ func findWorkItemFile(workItemID string, cfg *config.Config) (string, error) {
 		}
 	}
 
-	err := filepath.Walk(workFolder, func(path string, info os.FileInfo, err error) error {
+	files, err := validation.WorkItemFiles(cfg, workFolder)
+	if err != nil {
+		return "", fmt.Errorf("failed to search for work item: %w", err)
+	}
+	for _, path := range files {
+		content, err := safeReadFile(path, cfg)
 		if err != nil {
-			return err
-		}
-
-		if info.IsDir() {
-			return nil
+			return "", fmt.Errorf("failed to search for work item: %w", err)
 		}
-
-		// Check if this is a work item file with the matching ID
-		if strings.HasSuffix(path, ".md") && !strings.Contains(path, "template") && !strings.HasSuffix(path, "IDEAS.md") {
-			// Read the file to check the ID
-			content, err := safeReadFile(path, cfg)
-			if err != nil {
-				return err
-			}
-
-			// Simple check for ID in front matter (unquoted, double-quoted, or single-quoted)
-			s := string(content)
-			if strings.Contains(s, fmt.Sprintf("id: %s", workItemID)) ||
-				strings.Contains(s, fmt.Sprintf("id: %q", workItemID)) ||
-				strings.Contains(s, fmt.Sprintf("id: '%s'", workItemID)) {
-				foundPath = path
-				return filepath.SkipDir
-			}
+		if hasWorkItemID(content, workItemID) {
+			foundPaths = append(foundPaths, path)
 		}
-
-		return nil
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to search for work item: %w", err)
 	}
 
-	if foundPath == "" {
+	switch len(foundPaths) {
+	case 0:
 		return "", fmt.Errorf("work item with ID %s not found", workItemID)
+	case 1:
+	default:
+		// With naming.per_folder_ids the same ID can be used once in every status folder
+		return "", fmt.Errorf("work item ID %s is ambiguous, it is used by %s", workItemID, strings.Join(foundPaths, ", "))
 	}
 
-	return foundPath, nil
+	recordResolvedWorkItem(foundPaths[0])
+	return foundPaths[0], nil
 }
 
 // resolveSliceWorkItem resolves the work item path for slice commands.
@@ -271,6 +309,9 @@ func getDoingWorkItemPaths(cfg *config.Config) ([]string, error) {
 }
 
 // statusFromWorkItemPath returns the status key (e.g. "doing") if path is under a configured status folder; otherwise "".
+// When status folders are nested (e.g. done and done/archived), the deepest folder containing path wins.
+// Statuses are checked in sorted order so the result does not depend on map iteration; config
+// validation rejects two statuses sharing a folder.
 func statusFromWorkItemPath(path string, cfg *config.Config) (string, error) {
 	workDir, err := config.GetWorkFolderAbsPath(cfg)
 	if err != nil {
@@ -284,17 +325,25 @@ func statusFromWorkItemPath(path string, cfg *config.Config) (string, error) {
 	if !strings.HasPrefix(absPath, workDirWithSep) && absPath != workDir {
 		return "", nil
 	}
-	for status, folder := range cfg.StatusFolders {
+	statuses := make([]string, 0, len(cfg.StatusFolders))
+	for status := range cfg.StatusFolders {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	match, matchLen := "", -1
+	for _, status := range statuses {
+		folder := cfg.StatusFolders[status]
 		if folder == "" {
 			continue
 		}
 		statusDir := filepath.Join(workDir, folder)
 		statusDirWithSep := statusDir + string(filepath.Separator)
-		if strings.HasPrefix(absPath, statusDirWithSep) || absPath == statusDir {
-			return status, nil
+		if (strings.HasPrefix(absPath, statusDirWithSep) || absPath == statusDir) && len(statusDir) > matchLen {
+			match, matchLen = status, len(statusDir)
 		}
 	}
-	return "", nil
+	return match, nil
 }
 
 // workItemIDsFromFilenames extracts work item ID hints from filenames (e.g. 026-slices.prd.md -> 026).
@@ -381,6 +430,33 @@ func archiveWorkItems(workItems []string, sourcePath string, cfg *config.Config)
 	return archiveDir, nil
 }
 
+// truncateAtRuneBoundary returns s cut to at most maxBytes bytes without splitting a
+// multibyte rune, so the result is always valid UTF-8 when s is.
+func truncateAtRuneBoundary(s string, maxBytes int) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
+
+// truncateForDisplay shortens s to at most maxRunes runes, ending with "..." when truncated.
+func truncateForDisplay(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	if maxRunes <= 3 {
+		return string([]rune(s)[:maxRunes])
+	}
+	return string([]rune(s)[:maxRunes-3]) + "..."
+}
+
 // formatCommandPreview formats a command for dry-run output
 func formatCommandPreview(name string, args []string) string {
 	if len(args) == 0 {
@@ -538,6 +614,7 @@ func executeCommandCombinedOutputWithEnv(ctx context.Context, name string, args
 // startAndWait starts a command and waits for completion with context cancellation support.
 // If the context expires before the command finishes, the process is killed.
 func startAndWait(ctx context.Context, cmd *exec.Cmd) error {
+	recordExecutedCommand(cmd.Args)
 	if err := cmd.Start(); err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"kira/internal/config"
 
@@ -55,6 +56,43 @@ func TestFindWorkItemFile(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "work item with ID 999 not found")
 	})
+
+	t.Run("returns error when the ID is used in several folders", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(".work/1_todo/001-test-feature.prd.md", []byte(testWorkItemContent), 0o600))
+		require.NoError(t, os.WriteFile(".work/2_doing/001-other-feature.prd.md", []byte(testWorkItemContent), 0o600))
+
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		_, err = findWorkItemFile("001", cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "work item ID 001 is ambiguous")
+		assert.Contains(t, err.Error(), "001-other-feature.prd.md")
+	})
+
+	t.Run("does not match longer IDs", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir("/") }()
+
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.WriteFile(".work/1_todo/001-test-feature.prd.md", []byte(testWorkItemContent), 0o600))
+		other := "---\nid: 0012\ntitle: Other\nstatus: todo\nkind: prd\n---\n"
+		require.NoError(t, os.WriteFile(".work/1_todo/0012-other.prd.md", []byte(other), 0o600))
+
+		cfg, err := config.LoadConfig()
+		require.NoError(t, err)
+
+		foundPath, err := findWorkItemFile("001", cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "001-test-feature.prd.md", filepath.Base(foundPath))
+	})
 }
 
 func TestResolveSliceWorkItem(t *testing.T) {
@@ -281,6 +319,51 @@ func TestFormatCommandPreview(t *testing.T) {
 	})
 }
 
+func TestTruncateAtRuneBoundary(t *testing.T) {
+	t.Run("returns short strings unchanged", func(t *testing.T) {
+		assert.Equal(t, "abc", truncateAtRuneBoundary("abc", 10))
+	})
+
+	t.Run("cuts ASCII at the byte limit", func(t *testing.T) {
+		assert.Equal(t, "abcd", truncateAtRuneBoundary("abcdef", 4))
+	})
+
+	t.Run("backs off to a rune boundary inside an emoji", func(t *testing.T) {
+		// "ab" + 4-byte emoji; a 4-byte limit lands inside the emoji
+		result := truncateAtRuneBoundary("ab🚀cd", 4)
+		assert.Equal(t, "ab", result)
+		assert.True(t, utf8.ValidString(result))
+	})
+
+	t.Run("backs off to a rune boundary inside CJK", func(t *testing.T) {
+		// each CJK rune is 3 bytes
+		result := truncateAtRuneBoundary("日本語", 5)
+		assert.Equal(t, "日", result)
+		assert.True(t, utf8.ValidString(result))
+	})
+
+	t.Run("returns empty for non-positive limit", func(t *testing.T) {
+		assert.Equal(t, "", truncateAtRuneBoundary("abc", 0))
+	})
+}
+
+func TestTruncateForDisplay(t *testing.T) {
+	t.Run("returns short strings unchanged", func(t *testing.T) {
+		assert.Equal(t, "日本語", truncateForDisplay("日本語", 3))
+	})
+
+	t.Run("truncates by runes with ellipsis", func(t *testing.T) {
+		result := truncateForDisplay("日本語のテキスト", 6)
+		assert.Equal(t, "日本語...", result)
+		assert.True(t, utf8.ValidString(result))
+	})
+
+	t.Run("truncates emoji without breaking runes", func(t *testing.T) {
+		result := truncateForDisplay("🚀🚀🚀🚀🚀", 4)
+		assert.Equal(t, "🚀...", result)
+	})
+}
+
 func TestExecuteCommand(t *testing.T) {
 	t.Run("dry run returns empty string and prints preview", func(t *testing.T) {
 		ctx := context.Background()
@@ -420,3 +503,64 @@ func TestExecuteCommandCombinedOutput(t *testing.T) {
 		assert.Contains(t, err.Error(), "exit status")
 	})
 }
+
+func TestParseGitVersion(t *testing.T) {
+	cases := []struct {
+		output       string
+		major, minor int
+		ok           bool
+	}{
+		{"git version 2.39.2\n", 2, 39, true},
+		{"git version 2.39.3 (Apple Git-145)", 2, 39, true},
+		{"git version 2.45.1.windows.1", 2, 45, true},
+		{"git version 1.8.3.1", 1, 8, true},
+		{"not git", 0, 0, false},
+		{"git version unknown", 0, 0, false},
+	}
+	for _, tc := range cases {
+		major, minor, ok := parseGitVersion(tc.output)
+		assert.Equal(t, tc.ok, ok, tc.output)
+		assert.Equal(t, tc.major, major, tc.output)
+		assert.Equal(t, tc.minor, minor, tc.output)
+	}
+}
+
+func TestCheckGitAvailable(t *testing.T) {
+	t.Run("succeeds when git is on PATH", func(t *testing.T) {
+		require.NoError(t, checkGitAvailable())
+	})
+
+	t.Run("reports missing git with install hint", func(t *testing.T) {
+		t.Setenv("PATH", t.TempDir())
+
+		err := checkGitAvailable()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "git is required but was not found on PATH")
+		assert.Contains(t, err.Error(), "Install git")
+		assert.NotContains(t, err.Error(), "exec:")
+	})
+
+	t.Run("doctor reports missing git and keeps validating", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		origDir, err := os.Getwd()
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(tmpDir))
+		defer func() { _ = os.Chdir(origDir) }()
+		require.NoError(t, os.MkdirAll(".work", 0o700))
+		t.Setenv("PATH", t.TempDir())
+
+		oldStdout := os.Stdout
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		os.Stdout = w
+		runErr := runDoctor(testCfgWithDir(tmpDir), false)
+		_ = w.Close()
+		os.Stdout = oldStdout
+		require.NoError(t, runErr)
+
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(r)
+		assert.Contains(t, buf.String(), "git is required but was not found on PATH")
+		assert.Contains(t, buf.String(), "Validating work items...")
+	})
+}
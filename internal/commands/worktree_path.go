@@ -0,0 +1,69 @@
+// Package commands implements the CLI commands for the kira tool.
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// resolveWorkItemWorktree returns the worktree root and the path of a work item's worktree (for
+// polyrepo, the folder holding main/ and the project worktrees). With worktree.path_template the
+// path is rendered from the template and the root is its parent; otherwise the path is
+// <derived worktree root>/<branch>.
+func resolveWorkItemWorktree(cfg *config.Config, behavior WorkspaceBehavior, workItemID, slug, branchName string) (root, path string, err error) {
+	if cfg.Worktree == nil || cfg.Worktree.PathTemplate == "" {
+		root, err = deriveWorktreeRoot(cfg, behavior)
+		if err != nil {
+			return "", "", err
+		}
+		return root, filepath.Join(root, branchName), nil
+	}
+
+	repoRoot, err := getRepoRoot()
+	if err != nil {
+		// Fallback to current directory, as deriveWorktreeRoot does
+		repoRoot, err = os.Getwd()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to determine current directory: %w", err)
+		}
+	}
+	path, err = renderWorktreePathTemplate(cfg.Worktree.PathTemplate, repoRoot, workItemID, slug, branchName)
+	if err != nil {
+		return "", "", err
+	}
+	return filepath.Dir(path), path, nil
+}
+
+// renderWorktreePathTemplate fills in {repo}, {id}, {slug} and {branch}. A leading ~ is the home
+// directory and relative paths are resolved against repoRoot. The result must be outside
+// repoRoot, since nesting worktrees inside the main working tree confuses git and tooling.
+func renderWorktreePathTemplate(template, repoRoot, workItemID, slug, branchName string) (string, error) {
+	path := strings.NewReplacer(
+		"{repo}", filepath.Base(repoRoot),
+		"{id}", workItemID,
+		"{slug}", slug,
+		"{branch}", branchName,
+	).Replace(template)
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("worktree.path_template: failed to resolve home directory: %w", err)
+		}
+		path = filepath.Join(home, path[1:])
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoRoot, path)
+	}
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(repoRoot, path)
+	if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("worktree.path_template renders %s, which is inside the repository %s; worktrees must be created outside the main working tree", path, repoRoot)
+	}
+	return path, nil
+}
@@ -6,7 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,6 +29,8 @@ type Config struct {
 	IDE           *IDEConfig             `yaml:"ide"`
 	Workspace     *WorkspaceConfig       `yaml:"workspace"`
 	Users         UsersConfig            `yaml:"users"`
+	Assignment    *AssignmentConfig      `yaml:"assignment"`
+	List          *ListConfig            `yaml:"list"`
 	Fields        map[string]FieldConfig `yaml:"fields"`
 	Slices        *SlicesConfig          `yaml:"slices"`
 	Review        *ReviewConfig          `yaml:"review"`
@@ -35,6 +39,21 @@ type Config struct {
 	DocsFolder    string                 `yaml:"docs_folder"` // default: ".docs"
 	CursorInstall *CursorInstallConfig   `yaml:"cursor_install"`
 	Workflows     *WorkflowsConfig       `yaml:"workflows"`
+	Output        *OutputConfig          `yaml:"output"`
+	Hooks         *HooksConfig           `yaml:"hooks"`
+	Naming        *NamingConfig          `yaml:"naming"`
+	Logging       *LoggingConfig         `yaml:"logging"`
+	Discovery     *DiscoveryConfig       `yaml:"discovery"`
+	Conflicts     *ConflictsConfig       `yaml:"conflicts"`
+	Workflow      *WorkflowConfig        `yaml:"workflow"`
+	// Worktree controls where kira start creates work item worktrees.
+	Worktree *WorktreeConfig `yaml:"worktree"`
+	// ArchivedStatuses are left out of kira list and assign --pick unless --include-archived (or
+	// --status) asks for them. kira doctor still validates them.
+	ArchivedStatuses []string `yaml:"archived_statuses"`
+	// TerminalStatuses are statuses in which work is finished; kira assign warns before assigning
+	// a work item in one of them. Default: DefaultTerminalStatuses.
+	TerminalStatuses []string `yaml:"terminal_statuses"`
 	// ConfigDir is the absolute path to the directory containing kira.yml (set at load time; not persisted).
 	ConfigDir string `yaml:"-"`
 }
@@ -45,6 +64,107 @@ type WorkflowsConfig struct {
 	Scripts map[string]string `yaml:"scripts"` // optional: workflow name -> path relative to workflows root
 }
 
+// ListConfig contains settings for the list command.
+type ListConfig struct {
+	TimestampFormat string `yaml:"timestamp_format"` // Go time layout for created/updated columns; default: "2006-01-02"
+}
+
+// NamingConfig contains settings for work item IDs and file names.
+type NamingConfig struct {
+	// PerFolderIDs numbers work items per status folder instead of across the work folder:
+	// kira move gives a moved work item the next free ID of its target folder.
+	PerFolderIDs bool `yaml:"per_folder_ids"`
+}
+
+// PerFolderIDs reports whether work item IDs are numbered per status folder (naming.per_folder_ids).
+func PerFolderIDs(cfg *Config) bool {
+	return cfg.Naming != nil && cfg.Naming.PerFolderIDs
+}
+
+// DiscoveryConfig contains settings for how work items are found in the status folders.
+type DiscoveryConfig struct {
+	// MaxDepth is how many folder levels below a status folder are scanned for work items:
+	// 1 only the status folder itself, 2 also its subfolders (e.g. 2_doing/epic-x/). Unset means
+	// no limit.
+	MaxDepth *int `yaml:"max_depth"`
+}
+
+// DiscoveryMaxDepth returns discovery.max_depth, or 0 (no limit) when unset.
+func DiscoveryMaxDepth(cfg *Config) int {
+	if cfg == nil || cfg.Discovery == nil || cfg.Discovery.MaxDepth == nil {
+		return 0
+	}
+	return *cfg.Discovery.MaxDepth
+}
+
+// WorkflowConfig contains settings that tie work item statuses to git events. Not to be confused
+// with WorkflowsConfig (workflows:), which configures kira run scripts.
+type WorkflowConfig struct {
+	// AdvanceOnMerge is the status kira latest moves the current work item to once its branch is
+	// fully merged into trunk (e.g. done). Empty (default) leaves the work item alone.
+	AdvanceOnMerge string `yaml:"advance_on_merge"`
+}
+
+// ConflictsConfig contains settings for how kira latest reports merge conflicts.
+type ConflictsConfig struct {
+	// ResolutionHelp replaces the instructions printed below conflicts (how to resolve, continue
+	// and abort), e.g. to point at a team's own process. Empty keeps kira's instructions.
+	ResolutionHelp string `yaml:"resolution_help"`
+}
+
+// LoggingConfig contains settings for kira's audit log.
+type LoggingConfig struct {
+	// File is appended a JSON line per kira invocation (see --log-file); relative paths are
+	// resolved against the directory of kira.yml. Empty disables the log.
+	File string `yaml:"file"`
+}
+
+// HooksConfig contains commands kira runs at points of its own commands.
+type HooksConfig struct {
+	// AfterUpdate runs with sh -c in each repository kira latest updated successfully;
+	// {repo}, {path} and {branch} are replaced with the repository name, path and current branch.
+	AfterUpdate string `yaml:"after_update"`
+}
+
+// OutputConfig contains settings for how commands render their output.
+type OutputConfig struct {
+	AssigneeDisplay string `yaml:"assignee_display"` // both (default: "Name <email>"), name, or email
+}
+
+// Assignee display modes for output.assignee_display.
+const (
+	AssigneeDisplayBoth  = "both"
+	AssigneeDisplayName  = "name"
+	AssigneeDisplayEmail = "email"
+)
+
+// AssignmentConfig contains settings for the assign command.
+type AssignmentConfig struct {
+	RequireKnownUser bool              `yaml:"require_known_user"` // default: false; when true, behaves as kira assign --known-only
+	FieldDefaults    map[string]string `yaml:"field_defaults"`     // work item kind -> target field when --field is not given
+	Changelog        bool              `yaml:"changelog"`          // default: false; when true, kira assign appends to <work folder>/CHANGELOG.md
+	// ValueTransforms rewrite the assignee value (the user's email) before kira assign writes it,
+	// applied in order, e.g. to strip +tags from addresses. Default: none.
+	ValueTransforms []ValueTransformConfig `yaml:"value_transforms"`
+	// Teams are named groups of users that kira assign --round-robin assigns work items to in turn.
+	Teams map[string]*TeamConfig `yaml:"teams"`
+	// RichAssignee makes kira assign write each assignee as an object recording who was assigned
+	// and since when ({email: ..., since: ...}) instead of the plain email. Default: false.
+	RichAssignee bool `yaml:"rich_assignee"`
+}
+
+// TeamConfig is one team of assignment.teams.
+type TeamConfig struct {
+	Members []string `yaml:"members"` // user identifiers as accepted by kira assign, e.g. emails
+	Cursor  int      `yaml:"cursor"`  // index of the member --round-robin assigns next; updated by kira assign
+}
+
+// ValueTransformConfig is one regular expression replacement of assignment.value_transforms.
+type ValueTransformConfig struct {
+	Pattern string `yaml:"pattern"` // Go (RE2) regular expression matched against the value
+	Replace string `yaml:"replace"` // replacement; $1 or ${name} refer to capture groups
+}
+
 // DoneConfig contains settings for the done command (merge PR, pull trunk, update status, cleanup).
 type DoneConfig struct {
 	CleanupBranch           *bool  `yaml:"cleanup_branch"`            // default: true (nil = delete branch after merge)
@@ -63,6 +183,22 @@ type ReviewConfig struct {
 	CommitMove  *bool `yaml:"commit_move"`  // optional: commit the move to review (align with move command)
 }
 
+// WorktreeConfig contains settings for the worktrees created by kira start.
+type WorktreeConfig struct {
+	// PathTemplate is the path of a work item's worktree, e.g. ~/worktrees/{repo}/{id}-{slug}.
+	// Placeholders: {repo}, {id}, {slug}, {branch}. Empty = <worktree root>/{branch}.
+	PathTemplate string `yaml:"path_template"`
+	// IDECommands are IDE commands kira start tries in order after ide.command, opening the
+	// worktree with the first one that is installed, e.g. [cursor, code, idea].
+	IDECommands []string `yaml:"ide_commands"`
+}
+
+// WorktreePathPlaceholders are the placeholders worktree.path_template may use.
+var WorktreePathPlaceholders = []string{"{repo}", "{id}", "{slug}", "{branch}"}
+
+// worktreePlaceholderRegexp matches {name} placeholders in worktree.path_template.
+var worktreePlaceholderRegexp = regexp.MustCompile(`\{[^{}]*\}`)
+
 // CursorInstallConfig contains settings for where to install Cursor skills and commands.
 // When BasePath is empty, the project root is used (.agent/skills and .cursor/commands).
 type CursorInstallConfig struct {
@@ -86,8 +222,15 @@ type CheckEntry struct {
 
 // GitConfig contains git-related settings.
 type GitConfig struct {
-	TrunkBranch string `yaml:"trunk_branch"` // default: "" (auto-detect main/master)
-	Remote      string `yaml:"remote"`       // default: "origin"
+	TrunkBranch  string `yaml:"trunk_branch"`  // default: "" (auto-detect main/master)
+	Remote       string `yaml:"remote"`        // default: "origin"
+	UseAutostash bool   `yaml:"use_autostash"` // default: false; kira latest rebases with --autostash instead of kira's stash/pop
+	// UpdateSubmodules makes kira latest run git submodule update --init --recursive after a
+	// successful update of a repository with submodules. Default: false (it may clone or fetch).
+	UpdateSubmodules bool `yaml:"update_submodules"`
+	// RebaseMerges makes kira latest rebase with --rebase-merges, keeping the merge commits of a
+	// branch instead of flattening them. Default: false.
+	RebaseMerges bool `yaml:"rebase_merges"`
 }
 
 // StartConfig contains settings for the start command.
@@ -95,8 +238,13 @@ type StartConfig struct {
 	MoveTo              string `yaml:"move_to"`               // default: "doing"
 	StatusAction        string `yaml:"status_action"`         // default: "commit_and_push"
 	StatusCommitMessage string `yaml:"status_commit_message"` // optional template
+	MaxTitleLength      int    `yaml:"max_title_length"`      // default: 100 (bytes of the sanitized title in branch/worktree names)
 }
 
+// MinMaxTitleLength is the smallest allowed start.max_title_length: room for at least one
+// character plus the "-xxxxxx" hash suffix appended to truncated titles.
+const MinMaxTitleLength = 8
+
 // IDEConfig contains IDE-related settings.
 type IDEConfig struct {
 	Command string   `yaml:"command"` // IDE command name (e.g., "cursor", "code")
@@ -137,6 +285,7 @@ type ProjectConfig struct {
 type ValidationConfig struct {
 	RequiredFields []string `yaml:"required_fields"`
 	IDFormat       string   `yaml:"id_format"`
+	IDWidth        int      `yaml:"id_width"` // digits new and repaired IDs are zero-padded to; default: the digits id_format requires, else 3
 	StatusValues   []string `yaml:"status_values"`
 	Strict         bool     `yaml:"strict"` // If true, flag fields not in configuration
 }
@@ -195,6 +344,10 @@ type FieldConfig struct {
 // HardcodedFields is a list of fields that cannot be configured.
 var HardcodedFields = []string{"id", "title", "status", "kind", "created"}
 
+// WorkItemOverrideField is the front matter block holding per-work-item config overrides
+// (git trunk branch, remote and worktree root for that item's start and latest).
+const WorkItemOverrideField = "kira"
+
 const fieldTypeEnum = "enum"
 
 // DefaultConfig provides default configuration values.
@@ -218,6 +371,7 @@ var DefaultConfig = Config{
 	Validation: ValidationConfig{
 		RequiredFields: []string{"id", "title", "status", "kind", "created"},
 		IDFormat:       "^\\d{3}$",
+		IDWidth:        3,
 		StatusValues:   []string{"backlog", "todo", "doing", "review", "done", "released", "abandoned", "archived"},
 		Strict:         false,
 	},
@@ -272,6 +426,9 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	// Expand $VAR and ${VAR} in path-bearing values
+	expandConfigEnv(&config)
+
 	// Merge with defaults for missing fields
 	mergeWithDefaults(&config)
 
@@ -321,13 +478,8 @@ func LoadConfigFromDir(dir string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
-	}
-
-	mergeWithDefaults(&config)
-	if err := validateConfig(&config); err != nil {
+	config, err := ParseConfig(data)
+	if err != nil {
 		return nil, err
 	}
 
@@ -337,9 +489,88 @@ func LoadConfigFromDir(dir string) (*Config, error) {
 	}
 	config.ConfigDir = configDir
 
+	return config, nil
+}
+
+// expandConfigEnv expands $VAR and ${VAR} from the process environment in the path-bearing config
+// values: workspace paths, project paths and repo roots, the docs folder, the cursor install base
+// path and the IDE command and args. Unset variables expand to empty with a warning.
+func expandConfigEnv(config *Config) {
+	warned := make(map[string]bool)
+	expand := func(value string) string {
+		if !strings.Contains(value, "$") {
+			return value
+		}
+		return os.Expand(value, func(name string) string {
+			v, ok := os.LookupEnv(name)
+			if !ok && !warned[name] {
+				warned[name] = true
+				fmt.Fprintf(os.Stderr, "Warning: environment variable %s used in kira.yml is not set; expanding to empty\n", name)
+			}
+			return v
+		})
+	}
+
+	config.DocsFolder = expand(config.DocsFolder)
+	if config.Logging != nil {
+		config.Logging.File = expand(config.Logging.File)
+	}
+	if config.CursorInstall != nil {
+		config.CursorInstall.BasePath = expand(config.CursorInstall.BasePath)
+	}
+	if config.IDE != nil {
+		config.IDE.Command = expand(config.IDE.Command)
+		for i := range config.IDE.Args {
+			config.IDE.Args[i] = expand(config.IDE.Args[i])
+		}
+	}
+	if config.Workspace == nil {
+		return
+	}
+	ws := config.Workspace
+	ws.Root = expand(ws.Root)
+	ws.WorktreeRoot = expand(ws.WorktreeRoot)
+	ws.WorkFolder = expand(ws.WorkFolder)
+	ws.ArchitectureDoc = expand(ws.ArchitectureDoc)
+	for i := range ws.Projects {
+		ws.Projects[i].Path = expand(ws.Projects[i].Path)
+		ws.Projects[i].RepoRoot = expand(ws.Projects[i].RepoRoot)
+	}
+}
+
+// FilePath returns the path of the config file LoadConfigFromDir reads for dir: dir/kira.yml,
+// else the legacy dir/.work/kira.yml. When neither exists it returns dir/kira.yml.
+func FilePath(dir string) string {
+	rootPath := filepath.Join(dir, "kira.yml")
+	legacyPath := filepath.Join(dir, ".work", "kira.yml")
+	if _, err := os.Stat(rootPath); err != nil {
+		if _, err := os.Stat(legacyPath); err == nil {
+			return legacyPath
+		}
+	}
+	return rootPath
+}
+
+// ParseConfig parses kira.yml content, expands environment variables, merges defaults and
+// validates the result. ConfigDir is left empty.
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	expandConfigEnv(&config)
+	mergeWithDefaults(&config)
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	return &config, nil
 }
 
+// Validate checks the configuration for invalid values. Defaults should be merged first.
+func (c *Config) Validate() error {
+	return validateConfig(c)
+}
+
 // GetWorkFolderPath returns the configured work folder path, defaulting to ".work".
 func GetWorkFolderPath(cfg *Config) string {
 	if cfg != nil && cfg.Workspace != nil && cfg.Workspace.WorkFolder != "" {
@@ -479,7 +710,7 @@ func resolveCursorPath(base, subdir string) (string, error) {
 	return absPath, nil
 }
 
-func validateConfig(config *Config) error {
+func validateStartConfig(config *Config) error {
 	// Validate start.move_to is a valid status key
 	if config.Start != nil && config.Start.MoveTo != "" {
 		if _, exists := config.StatusFolders[config.Start.MoveTo]; !exists {
@@ -508,11 +739,40 @@ func validateConfig(config *Config) error {
 		}
 	}
 
+	// Validate start.max_title_length leaves room for the hash suffix
+	if config.Start != nil && config.Start.MaxTitleLength != 0 && config.Start.MaxTitleLength < MinMaxTitleLength {
+		return fmt.Errorf("invalid start.max_title_length %d: must be at least %d", config.Start.MaxTitleLength, MinMaxTitleLength)
+	}
+
+	return nil
+}
+
+func validateConfig(config *Config) error {
+	// Validate status folders
+	if err := validateStatusFolders(config); err != nil {
+		return err
+	}
+
+	// Validate start settings
+	if err := validateStartConfig(config); err != nil {
+		return err
+	}
+
 	// Validate workspace settings
 	if err := validateWorkspaceConfig(config); err != nil {
 		return err
 	}
 
+	// Validate assignment settings
+	if err := validateAssignmentConfig(config); err != nil {
+		return err
+	}
+
+	// Validate output settings
+	if err := validateOutputConfig(config); err != nil {
+		return err
+	}
+
 	// Validate field configuration
 	if err := validateFieldConfig(config); err != nil {
 		return err
@@ -528,9 +788,267 @@ func validateConfig(config *Config) error {
 		return err
 	}
 
+	// Validate archived statuses
+	if err := validateArchivedStatuses(config); err != nil {
+		return err
+	}
+
+	// Validate terminal statuses
+	if err := validateTerminalStatuses(config); err != nil {
+		return err
+	}
+
+	// Validate discovery depth
+	if err := validateDiscoveryConfig(config); err != nil {
+		return err
+	}
+
+	// Validate workflow settings
+	if err := validateWorkflowConfig(config); err != nil {
+		return err
+	}
+
+	// Validate worktree path template
+	if err := validateWorktreeConfig(config); err != nil {
+		return err
+	}
+
+	// Validate that id_width agrees with id_format
+	if err := validateIDWidth(config); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// validateStatusFolders checks that no two statuses share a folder. A shared folder would list its
+// work items under both statuses and make the status of a work item path ambiguous.
+func validateStatusFolders(config *Config) error {
+	statuses := make([]string, 0, len(config.StatusFolders))
+	for status := range config.StatusFolders {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	seen := make(map[string]string)
+	for _, status := range statuses {
+		folder := config.StatusFolders[status]
+		if strings.TrimSpace(folder) == "" {
+			continue
+		}
+		key := filepath.ToSlash(filepath.Clean(folder))
+		if other, ok := seen[key]; ok {
+			return fmt.Errorf("status_folders: statuses '%s' and '%s' both use folder '%s'; each status needs its own folder", other, status, folder)
+		}
+		seen[key] = status
+	}
+	return nil
+}
+
+// validateArchivedStatuses checks that archived_statuses only names configured statuses.
+func validateArchivedStatuses(config *Config) error {
+	for _, status := range config.ArchivedStatuses {
+		if _, ok := config.StatusFolders[status]; !ok {
+			return fmt.Errorf("archived_statuses: '%s' is not a status in status_folders", status)
+		}
+	}
+	return nil
+}
+
+// validateDiscoveryConfig checks that discovery.max_depth, when set, is at least 1.
+func validateDiscoveryConfig(config *Config) error {
+	if config.Discovery != nil && config.Discovery.MaxDepth != nil && *config.Discovery.MaxDepth < 1 {
+		return fmt.Errorf("discovery.max_depth must be at least 1, got %d", *config.Discovery.MaxDepth)
+	}
+	return nil
+}
+
+// validateWorkflowConfig checks that workflow.advance_on_merge, when set, is a status folder.
+func validateWorkflowConfig(config *Config) error {
+	if config.Workflow == nil || config.Workflow.AdvanceOnMerge == "" {
+		return nil
+	}
+	if _, ok := config.StatusFolders[config.Workflow.AdvanceOnMerge]; !ok {
+		return fmt.Errorf("workflow.advance_on_merge: '%s' is not a status in status_folders", config.Workflow.AdvanceOnMerge)
+	}
+	return nil
+}
+
+// validateTerminalStatuses checks that terminal_statuses only names statuses that are status
+// folders or allowed status values (such as released, which has no folder).
+func validateTerminalStatuses(config *Config) error {
+	for _, status := range config.TerminalStatuses {
+		_, isFolder := config.StatusFolders[status]
+		if !isFolder && !slices.Contains(config.Validation.StatusValues, status) {
+			return fmt.Errorf("terminal_statuses: '%s' is not a status in status_folders or validation.status_values", status)
+		}
+	}
+	return nil
+}
+
+// idFormatDigitsRegexp matches an id_format of a fixed number of digits, such as ^\d{4}$.
+var idFormatDigitsRegexp = regexp.MustCompile(`^\^\\d\{(\d+)\}\$$`)
+
+// idFormatWidth returns the number of digits id_format requires, or the default width when
+// id_format is not a fixed number of digits.
+func idFormatWidth(idFormat string) int {
+	match := idFormatDigitsRegexp.FindStringSubmatch(idFormat)
+	if match == nil {
+		return DefaultConfig.Validation.IDWidth
+	}
+	width, err := strconv.Atoi(match[1])
+	if err != nil || width <= 0 {
+		return DefaultConfig.Validation.IDWidth
+	}
+	return width
+}
+
+// validateIDWidth checks that an ID zero-padded to validation.id_width matches
+// validation.id_format, so the IDs new and doctor --fix write pass validation.
+func validateIDWidth(config *Config) error {
+	idFormat, err := regexp.Compile(config.Validation.IDFormat)
+	if err != nil {
+		return fmt.Errorf("invalid validation.id_format %q: %w", config.Validation.IDFormat, err)
+	}
+	if config.Validation.IDWidth > 0 && !idFormat.MatchString(fmt.Sprintf("%0*d", config.Validation.IDWidth, 1)) {
+		return fmt.Errorf("validation.id_width %d does not match validation.id_format %s", config.Validation.IDWidth, config.Validation.IDFormat)
+	}
+	return nil
+}
+
+// validateWorktreeConfig checks that worktree.path_template only uses known placeholders and
+// includes {id} or {branch}, so each work item gets its own worktree.
+func validateWorktreeConfig(config *Config) error {
+	if config.Worktree == nil || config.Worktree.PathTemplate == "" {
+		return nil
+	}
+	template := config.Worktree.PathTemplate
+	for _, placeholder := range worktreePlaceholderRegexp.FindAllString(template, -1) {
+		known := false
+		for _, p := range WorktreePathPlaceholders {
+			if placeholder == p {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("worktree.path_template: unknown placeholder %s (use %s)", placeholder, strings.Join(WorktreePathPlaceholders, ", "))
+		}
+	}
+	if !strings.Contains(template, "{id}") && !strings.Contains(template, "{branch}") {
+		return fmt.Errorf("worktree.path_template must include {id} or {branch} so each work item gets its own worktree")
+	}
+	return nil
+}
+
+// DefaultTerminalStatuses are the terminal statuses used when terminal_statuses is not set.
+var DefaultTerminalStatuses = []string{"done", "released", "abandoned"}
+
+// IsTerminalStatus reports whether status is a terminal status (terminal_statuses, else
+// DefaultTerminalStatuses).
+func IsTerminalStatus(cfg *Config, status string) bool {
+	terminal := cfg.TerminalStatuses
+	if len(terminal) == 0 {
+		terminal = DefaultTerminalStatuses
+	}
+	for _, s := range terminal {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsArchivedStatus reports whether status is listed in archived_statuses.
+func IsArchivedStatus(cfg *Config, status string) bool {
+	for _, archived := range cfg.ArchivedStatuses {
+		if archived == status {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAssignmentConfig validates assignment.field_defaults target field names.
+func validateAssignmentConfig(config *Config) error {
+	if config.Assignment == nil {
+		return nil
+	}
+	for kind, field := range config.Assignment.FieldDefaults {
+		if strings.TrimSpace(field) == "" {
+			return fmt.Errorf("assignment.field_defaults.%s: field name cannot be empty", kind)
+		}
+		if strings.Contains(field, "/") || strings.Contains(field, "\\") || strings.Contains(field, "..") {
+			return fmt.Errorf("assignment.field_defaults.%s: invalid field name '%s': field name must not contain path separators or '..'", kind, field)
+		}
+	}
+	if err := validateValueTransforms(config.Assignment.ValueTransforms); err != nil {
+		return err
+	}
+	return validateTeams(config.Assignment.Teams)
+}
+
+// validateTeams checks that each assignment.teams entry has members and a cursor that is not
+// negative.
+func validateTeams(teams map[string]*TeamConfig) error {
+	for name, team := range teams {
+		if team == nil || len(team.Members) == 0 {
+			return fmt.Errorf("assignment.teams.%s: members cannot be empty", name)
+		}
+		for i, member := range team.Members {
+			if strings.TrimSpace(member) == "" {
+				return fmt.Errorf("assignment.teams.%s.members[%d]: user identifier cannot be empty", name, i)
+			}
+		}
+		if team.Cursor < 0 {
+			return fmt.Errorf("assignment.teams.%s.cursor: must not be negative, got %d", name, team.Cursor)
+		}
+	}
+	return nil
+}
+
+// validateValueTransforms checks that each assignment.value_transforms entry has a pattern that
+// compiles.
+func validateValueTransforms(transforms []ValueTransformConfig) error {
+	for i, transform := range transforms {
+		if transform.Pattern == "" {
+			return fmt.Errorf("assignment.value_transforms[%d]: pattern cannot be empty", i)
+		}
+		if _, err := regexp.Compile(transform.Pattern); err != nil {
+			return fmt.Errorf("assignment.value_transforms[%d]: invalid pattern '%s': %w", i, transform.Pattern, err)
+		}
+	}
+	return nil
+}
+
+// validateOutputConfig validates output.assignee_display.
+func validateOutputConfig(config *Config) error {
+	if config.Output == nil {
+		return nil
+	}
+	if err := ValidateAssigneeDisplay(config.Output.AssigneeDisplay); err != nil {
+		return fmt.Errorf("output.assignee_display: %w", err)
+	}
+	return nil
+}
+
+// ValidateAssigneeDisplay checks an assignee display mode; "" selects the default.
+func ValidateAssigneeDisplay(display string) error {
+	switch display {
+	case "", AssigneeDisplayBoth, AssigneeDisplayName, AssigneeDisplayEmail:
+		return nil
+	}
+	return fmt.Errorf("invalid assignee display '%s' (must be %s, %s, or %s)", display, AssigneeDisplayBoth, AssigneeDisplayName, AssigneeDisplayEmail)
+}
+
+// AssigneeDisplay returns output.assignee_display, defaulting to both.
+func AssigneeDisplay(cfg *Config) string {
+	if cfg.Output == nil || cfg.Output.AssigneeDisplay == "" {
+		return AssigneeDisplayBoth
+	}
+	return cfg.Output.AssigneeDisplay
+}
+
 const maxDocsFolderPathLen = 256
 
 // validateDocsFolder validates docs_folder: no .., no null byte, reasonable length, non-empty after trim.
@@ -773,6 +1291,9 @@ func mergeWithDefaults(config *Config) {
 	if config.Validation.IDFormat == "" {
 		config.Validation.IDFormat = DefaultConfig.Validation.IDFormat
 	}
+	if config.Validation.IDWidth <= 0 {
+		config.Validation.IDWidth = idFormatWidth(config.Validation.IDFormat)
+	}
 	if config.Validation.StatusValues == nil {
 		config.Validation.StatusValues = DefaultConfig.Validation.StatusValues
 	}
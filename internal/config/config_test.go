@@ -278,6 +278,32 @@ start:
 			_ = os.Remove("kira.yml")
 		}
 	})
+
+	t.Run("rejects max_title_length too small for hash suffix", func(t *testing.T) {
+		testConfig := `version: "1.0"
+start:
+  max_title_length: 5
+`
+		require.NoError(t, os.WriteFile("kira.yml", []byte(testConfig), 0o600))
+		defer func() { _ = os.Remove("kira.yml") }()
+
+		_, err := LoadConfig()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid start.max_title_length 5")
+	})
+
+	t.Run("accepts max_title_length", func(t *testing.T) {
+		testConfig := `version: "1.0"
+start:
+  max_title_length: 40
+`
+		require.NoError(t, os.WriteFile("kira.yml", []byte(testConfig), 0o600))
+		defer func() { _ = os.Remove("kira.yml") }()
+
+		config, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, 40, config.Start.MaxTitleLength)
+	})
 }
 
 func TestWorkspaceConfigDefaults(t *testing.T) {
@@ -1293,3 +1319,342 @@ func TestLoadConfigCursorInstall(t *testing.T) {
 		assert.Equal(t, filepath.Join(tmpDir, ".cursor", "commands"), filepath.Clean(commandsPath))
 	})
 }
+
+func TestParseConfig(t *testing.T) {
+	t.Run("applies defaults and validates", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\ngit:\n  trunk_branch: develop\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "develop", cfg.Git.TrunkBranch)
+		require.NotNil(t, cfg.Start)
+		assert.Equal(t, "doing", cfg.Start.MoveTo)
+	})
+
+	t.Run("returns validation error", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nstart:\n  status_action: bogus\n"))
+		require.Error(t, err)
+	})
+
+	t.Run("Validate accepts defaults", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.NoError(t, cfg.Validate())
+	})
+}
+
+func TestFilePath(t *testing.T) {
+	dir := t.TempDir()
+	assert.Equal(t, filepath.Join(dir, "kira.yml"), FilePath(dir))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".work"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".work", "kira.yml"), []byte("version: \"1.0\"\n"), 0o600))
+	assert.Equal(t, filepath.Join(dir, ".work", "kira.yml"), FilePath(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kira.yml"), []byte("version: \"1.0\"\n"), 0o600))
+	assert.Equal(t, filepath.Join(dir, "kira.yml"), FilePath(dir))
+}
+
+func TestDiscoveryConfigValidation(t *testing.T) {
+	t.Run("has no depth limit by default", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 0, DiscoveryMaxDepth(cfg))
+
+		cfg, err = ParseConfig([]byte("version: \"1.0\"\ndiscovery:\n  max_depth: 2\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, DiscoveryMaxDepth(cfg))
+	})
+
+	t.Run("rejects a max_depth below 1", func(t *testing.T) {
+		for _, value := range []string{"0", "-1"} {
+			_, err := ParseConfig([]byte("version: \"1.0\"\ndiscovery:\n  max_depth: " + value + "\n"))
+			require.Error(t, err, value)
+			assert.Contains(t, err.Error(), "discovery.max_depth must be at least 1, got "+value)
+		}
+	})
+}
+
+func TestAssignmentConfigValidation(t *testing.T) {
+	t.Run("accepts field defaults by kind", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  field_defaults:\n    issue: triager\n"))
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Assignment)
+		assert.Equal(t, "triager", cfg.Assignment.FieldDefaults["issue"])
+	})
+
+	t.Run("rejects invalid field names", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  field_defaults:\n    issue: ../triager\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "assignment.field_defaults.issue")
+
+		_, err = ParseConfig([]byte("version: \"1.0\"\nassignment:\n  field_defaults:\n    issue: \" \"\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "field name cannot be empty")
+	})
+
+	t.Run("accepts value transforms", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  value_transforms:\n    - pattern: '\\+[^@]*@'\n      replace: '@'\n"))
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Assignment)
+		assert.Equal(t, []ValueTransformConfig{{Pattern: `\+[^@]*@`, Replace: "@"}}, cfg.Assignment.ValueTransforms)
+	})
+
+	t.Run("rejects invalid value transform patterns", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  value_transforms:\n    - pattern: '(unclosed'\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "assignment.value_transforms[0]: invalid pattern")
+
+		_, err = ParseConfig([]byte("version: \"1.0\"\nassignment:\n  value_transforms:\n    - replace: x\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pattern cannot be empty")
+	})
+}
+
+func TestNamingConfig(t *testing.T) {
+	t.Run("parses naming.per_folder_ids", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nnaming:\n  per_folder_ids: true\n"))
+		require.NoError(t, err)
+		assert.True(t, PerFolderIDs(cfg))
+	})
+
+	t.Run("defaults to global ids", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.False(t, PerFolderIDs(cfg))
+	})
+}
+
+func TestHooksConfig(t *testing.T) {
+	t.Run("parses hooks.after_update", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nhooks:\n  after_update: make -C {path} deps\n"))
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Hooks)
+		assert.Equal(t, "make -C {path} deps", cfg.Hooks.AfterUpdate)
+	})
+
+	t.Run("defaults to no hooks", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.Nil(t, cfg.Hooks)
+	})
+}
+
+func TestOutputConfigValidation(t *testing.T) {
+	t.Run("defaults the assignee display to both", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.Equal(t, AssigneeDisplayBoth, AssigneeDisplay(cfg))
+	})
+
+	t.Run("accepts name and email", func(t *testing.T) {
+		for _, display := range []string{AssigneeDisplayName, AssigneeDisplayEmail} {
+			cfg, err := ParseConfig([]byte("version: \"1.0\"\noutput:\n  assignee_display: " + display + "\n"))
+			require.NoError(t, err)
+			assert.Equal(t, display, AssigneeDisplay(cfg))
+		}
+	})
+
+	t.Run("rejects unknown modes", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\noutput:\n  assignee_display: initials\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "output.assignee_display")
+	})
+}
+
+func TestLoadConfigEnvExpansion(t *testing.T) {
+	t.Run("expands environment variables in path values", func(t *testing.T) {
+		t.Setenv("HOME", "/home/tester")
+		t.Setenv("CI_PROJECT_DIR", "/builds/kira")
+		tmpDir := t.TempDir()
+		testConfig := `version: "1.0"
+ide:
+  command: $HOME/bin/cursor
+  args: ["${CI_PROJECT_DIR}"]
+workspace:
+  worktree_root: ${CI_PROJECT_DIR}/../worktrees
+  projects:
+    - name: api
+      path: $HOME/src/api
+      repo_root: ${CI_PROJECT_DIR}
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "kira.yml"), []byte(testConfig), 0o600))
+
+		cfg, err := LoadConfigFromDir(tmpDir)
+		require.NoError(t, err)
+		assert.Equal(t, "/home/tester/bin/cursor", cfg.IDE.Command)
+		assert.Equal(t, []string{"/builds/kira"}, cfg.IDE.Args)
+		assert.Equal(t, "/builds/kira/../worktrees", cfg.Workspace.WorktreeRoot)
+		require.Len(t, cfg.Workspace.Projects, 1)
+		assert.Equal(t, "/home/tester/src/api", cfg.Workspace.Projects[0].Path)
+		assert.Equal(t, "/builds/kira", cfg.Workspace.Projects[0].RepoRoot)
+	})
+
+	t.Run("ParseConfig expands them too", func(t *testing.T) {
+		t.Setenv("CI_PROJECT_DIR", "/builds/kira")
+
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nworkspace:\n  worktree_root: ${CI_PROJECT_DIR}/../worktrees\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "/builds/kira/../worktrees", cfg.Workspace.WorktreeRoot)
+	})
+
+	t.Run("expands unset variables to empty", func(t *testing.T) {
+		require.NoError(t, os.Unsetenv("KIRA_TEST_UNSET_DIR"))
+		tmpDir := t.TempDir()
+		testConfig := `version: "1.0"
+workspace:
+  projects:
+    - name: api
+      path: ${KIRA_TEST_UNSET_DIR}/api
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "kira.yml"), []byte(testConfig), 0o600))
+
+		cfg, err := LoadConfigFromDir(tmpDir)
+		require.NoError(t, err)
+		assert.Equal(t, "/api", cfg.Workspace.Projects[0].Path)
+	})
+}
+
+func TestArchivedStatusesConfig(t *testing.T) {
+	t.Run("accepts configured statuses", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\narchived_statuses: [archived]\n"))
+		require.NoError(t, err)
+		assert.True(t, IsArchivedStatus(cfg, "archived"))
+		assert.False(t, IsArchivedStatus(cfg, "done"))
+	})
+
+	t.Run("rejects unknown statuses", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\narchived_statuses: [attic]\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "archived_statuses: 'attic' is not a status in status_folders")
+	})
+}
+
+func TestTerminalStatusesConfig(t *testing.T) {
+	t.Run("defaults to done, released and abandoned", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.True(t, IsTerminalStatus(cfg, "done"))
+		assert.True(t, IsTerminalStatus(cfg, "released"))
+		assert.False(t, IsTerminalStatus(cfg, "doing"))
+	})
+
+	t.Run("uses configured statuses", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nterminal_statuses: [review]\n"))
+		require.NoError(t, err)
+		assert.True(t, IsTerminalStatus(cfg, "review"))
+		assert.False(t, IsTerminalStatus(cfg, "done"))
+	})
+
+	t.Run("rejects unknown statuses", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nterminal_statuses: [shipped]\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "terminal_statuses: 'shipped' is not a status in status_folders or validation.status_values")
+	})
+}
+
+func TestWorkflowConfig(t *testing.T) {
+	t.Run("advance_on_merge is off by default", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\n"))
+		require.NoError(t, err)
+		assert.Nil(t, cfg.Workflow)
+	})
+
+	t.Run("accepts a status folder", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nworkflow:\n  advance_on_merge: done\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "done", cfg.Workflow.AdvanceOnMerge)
+	})
+
+	t.Run("rejects unknown statuses", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nworkflow:\n  advance_on_merge: merged\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "workflow.advance_on_merge: 'merged' is not a status in status_folders")
+	})
+}
+
+func TestStatusFoldersValidation(t *testing.T) {
+	t.Run("rejects two statuses sharing a folder", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nstatus_folders:\n  doing: 2_doing\n  review: ./2_doing/\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status_folders: statuses 'doing' and 'review' both use folder './2_doing/'")
+	})
+
+	t.Run("rejects a status overriding another status's default folder", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nstatus_folders:\n  todo: 2_doing\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "both use folder '2_doing'")
+	})
+
+	t.Run("accepts nested status folders", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nstatus_folders:\n  archived: 4_done/archived\n"))
+		require.NoError(t, err)
+	})
+}
+
+func TestIDWidthConfig(t *testing.T) {
+	t.Run("defaults to the digits id_format requires", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nvalidation:\n  id_format: '^\\d{4}$'\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 4, cfg.Validation.IDWidth)
+	})
+
+	t.Run("defaults to 3 when id_format is not a fixed number of digits", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nvalidation:\n  id_format: '^\\d+$'\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.Validation.IDWidth)
+	})
+
+	t.Run("accepts a width id_format allows", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nvalidation:\n  id_format: '^\\d{3,5}$'\n  id_width: 5\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 5, cfg.Validation.IDWidth)
+	})
+
+	t.Run("rejects a width id_format does not allow", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nvalidation:\n  id_width: 4\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation.id_width 4 does not match validation.id_format ^\\d{3}$")
+	})
+
+	t.Run("rejects an invalid id_format", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nvalidation:\n  id_format: '^\\d{3$('\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid validation.id_format")
+	})
+}
+
+func TestWorktreeConfigValidation(t *testing.T) {
+	t.Run("accepts known placeholders", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nworktree:\n  path_template: ~/worktrees/{repo}/{id}-{slug}\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "~/worktrees/{repo}/{id}-{slug}", cfg.Worktree.PathTemplate)
+	})
+
+	t.Run("rejects unknown placeholders", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nworktree:\n  path_template: ../wt/{project}/{id}\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown placeholder {project}")
+	})
+
+	t.Run("requires a per work item placeholder", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nworktree:\n  path_template: ../wt/{repo}\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must include {id} or {branch}")
+	})
+}
+
+func TestTeamsConfig(t *testing.T) {
+	t.Run("parses assignment.teams", func(t *testing.T) {
+		cfg, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  teams:\n    backend:\n      members: [alice@example.com, bob@example.com]\n      cursor: 1\n"))
+		require.NoError(t, err)
+		require.NotNil(t, cfg.Assignment)
+		assert.Equal(t, &TeamConfig{Members: []string{"alice@example.com", "bob@example.com"}, Cursor: 1}, cfg.Assignment.Teams["backend"])
+	})
+
+	t.Run("rejects teams without members and negative cursors", func(t *testing.T) {
+		_, err := ParseConfig([]byte("version: \"1.0\"\nassignment:\n  teams:\n    backend:\n      members: []\n"))
+		require.EqualError(t, err, "assignment.teams.backend: members cannot be empty")
+
+		_, err = ParseConfig([]byte("version: \"1.0\"\nassignment:\n  teams:\n    backend:\n      members: [alice@example.com]\n      cursor: -1\n"))
+		require.EqualError(t, err, "assignment.teams.backend.cursor: must not be negative, got -1")
+	})
+}
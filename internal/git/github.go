@@ -330,6 +330,12 @@ func CreatePR(ctx context.Context, client *github.Client, owner, repo, base, hea
 	return prURL, nil
 }
 
+// ClosePullRequest closes a pull request without merging it.
+func ClosePullRequest(ctx context.Context, client *github.Client, owner, repo string, number int) error {
+	_, _, err := client.PullRequests.Edit(ctx, owner, repo, number, &github.PullRequest{State: github.String("closed")})
+	return err
+}
+
 // GetPullRequest fetches a single pull request by number.
 func GetPullRequest(ctx context.Context, client *github.Client, owner, repo string, number int) (*github.PullRequest, error) {
 	pr, _, err := client.PullRequests.Get(ctx, owner, repo, number)
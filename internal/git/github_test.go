@@ -475,3 +475,41 @@ func TestCountUnresolvedReviewThreads(t *testing.T) {
 		assert.Equal(t, 0, count)
 	})
 }
+
+func TestClosePullRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets the PR state to closed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			assert.Equal(t, "/repos/owner/repo/pulls/7", r.URL.Path)
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "closed", body["state"])
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"number":7,"state":"closed"}`))
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		require.NoError(t, err)
+		client := github.NewClient(server.Client())
+		client.BaseURL = baseURL
+
+		require.NoError(t, ClosePullRequest(ctx, client, "owner", "repo", 7))
+	})
+
+	t.Run("returns API errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		baseURL, err := url.Parse(server.URL + "/")
+		require.NoError(t, err)
+		client := github.NewClient(server.Client())
+		client.BaseURL = baseURL
+
+		require.Error(t, ClosePullRequest(ctx, client, "owner", "repo", 7))
+	})
+}
@@ -0,0 +1,68 @@
+// Package validation provides validation functionality for work items.
+package validation
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"kira/internal/config"
+)
+
+// WorkItemFiles returns the work item files under workFolder, in walk (lexical) order: the
+// markdown files other than templates and IDEAS.md. The folders in workFolder (the status
+// folders) are scanned down to discovery.max_depth levels; hidden folders are skipped.
+func WorkItemFiles(cfg *config.Config, workFolder string) ([]string, error) {
+	return walkWorkItemFiles(cfg, workFolder, 1, nil)
+}
+
+// StatusWorkItemFiles returns the work item files under statusPath, a status folder in
+// workFolder, down to discovery.max_depth levels. Hidden folders and the folders of other
+// statuses nested in this one are skipped.
+func StatusWorkItemFiles(cfg *config.Config, workFolder, statusPath string) ([]string, error) {
+	otherStatuses := make(map[string]bool)
+	for _, folder := range cfg.StatusFolders {
+		if path := filepath.Join(workFolder, folder); folder != "" && path != filepath.Clean(statusPath) {
+			otherStatuses[path] = true
+		}
+	}
+	return walkWorkItemFiles(cfg, statusPath, 0, otherStatuses)
+}
+
+// walkWorkItemFiles walks root for work item files. offset is the number of folder levels below
+// root that are not yet inside a status folder (1 when root is the work folder). The walk does
+// not descend past discovery.max_depth, into hidden folders, or into the folders in skip.
+func walkWorkItemFiles(cfg *config.Config, root string, offset int, skip map[string]bool) ([]string, error) {
+	maxDepth := config.DiscoveryMaxDepth(cfg)
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			if isWorkItemFileName(path) {
+				files = append(files, path)
+			}
+			return nil
+		}
+		if path == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		depth := len(strings.Split(rel, string(filepath.Separator))) - offset
+		if (maxDepth > 0 && depth >= maxDepth) || strings.HasPrefix(entry.Name(), ".") || skip[path] {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return files, err
+}
+
+// isWorkItemFileName reports whether path names a work item file: markdown, and neither a
+// template nor IDEAS.md.
+func isWorkItemFileName(path string) bool {
+	return strings.HasSuffix(path, ".md") && !strings.Contains(path, "template") && !strings.HasSuffix(path, "IDEAS.md")
+}
@@ -91,6 +91,14 @@ type WorkItem struct {
 
 // ValidateWorkItems validates all work items in the workspace.
 func ValidateWorkItems(cfg *config.Config) (*ValidationResult, error) {
+	return ValidateWorkItemsMatching(cfg, nil)
+}
+
+// ValidateWorkItemsMatching validates the work items whose file path include accepts (all of
+// them when include is nil). Every work item is still read for the duplicate ID check, and a
+// duplicate is reported when any of its files is included. Workflow rules apply to the whole
+// workspace and are always checked.
+func ValidateWorkItemsMatching(cfg *config.Config, include func(file string) bool) (*ValidationResult, error) {
 	result := &ValidationResult{}
 
 	workDirAbs, err := config.GetWorkFolderAbsPath(cfg)
@@ -104,56 +112,31 @@ func ValidateWorkItems(cfg *config.Config) (*ValidationResult, error) {
 		return nil, fmt.Errorf("failed to get work item files: %w", err)
 	}
 
-	// Track IDs for duplicate checking
-	idMap := make(map[string][]string)
+	// Track IDs for duplicate checking (per status folder with naming.per_folder_ids)
+	idMap := make(map[duplicateIDKey][]string)
 
 	for _, file := range files {
+		checked := include == nil || include(file)
 		workItem, err := parseWorkItemFile(file, workDirAbs)
 		if err != nil {
-			result.AddError(file, fmt.Sprintf("failed to parse file: %v", err))
+			if checked {
+				result.AddError(file, fmt.Sprintf("failed to parse file: %v", err))
+			}
 			continue
 		}
-
-		// Validate required fields
-		if err := validateRequiredFields(workItem, cfg); err != nil {
-			result.AddError(file, err.Error())
-		}
-
-		// Validate ID format
-		if err := validateIDFormat(workItem.ID, cfg); err != nil {
-			result.AddError(file, err.Error())
-		}
-
-		// Validate status values
-		if err := validateStatus(workItem.Status, cfg); err != nil {
-			result.AddError(file, err.Error())
-		}
-
-		// Validate date formats (uses field config if available, falls back to hardcoded logic)
-		if err := validateDateFormats(workItem, cfg); err != nil {
-			result.AddError(file, err.Error())
-		}
-
-		// Validate configured fields
-		if err := validateConfiguredFields(workItem, cfg); err != nil {
-			result.AddError(file, err.Error())
-		}
-
-		// Validate unknown fields in strict mode
-		if cfg.Validation.Strict {
-			if err := validateUnknownFields(workItem, cfg, file); err != nil {
-				result.AddError(file, err.Error())
-			}
+		if checked {
+			validateWorkItem(workItem, cfg, file, result)
 		}
 
 		// Track ID for duplicate checking
-		idMap[workItem.ID] = append(idMap[workItem.ID], file)
+		key := duplicateIDKey{scope: idScope(file, cfg), id: workItem.ID}
+		idMap[key] = append(idMap[key], file)
 	}
 
 	// Check for duplicate IDs
-	for id, files := range idMap {
-		if len(files) > 1 {
-			result.AddError(files[0], fmt.Sprintf("duplicate ID found: %s in files %s", id, strings.Join(files, ", ")))
+	for key, files := range idMap {
+		if len(files) > 1 && anyFileIncluded(files, include) {
+			result.AddError(files[0], fmt.Sprintf("duplicate ID found: %s in files %s", key.id, strings.Join(files, ", ")))
 		}
 	}
 
@@ -165,30 +148,56 @@ func ValidateWorkItems(cfg *config.Config) (*ValidationResult, error) {
 	return result, nil
 }
 
-func getWorkItemFiles(cfg *config.Config) ([]string, error) {
-	var files []string
-	workFolder := config.GetWorkFolderPath(cfg)
+// validateWorkItem runs the checks of a single work item and adds what fails to result.
+func validateWorkItem(workItem *WorkItem, cfg *config.Config, file string, result *ValidationResult) {
+	// Validate required fields
+	if err := validateRequiredFields(workItem, cfg); err != nil {
+		result.AddError(file, err.Error())
+	}
 
-	err := filepath.Walk(workFolder, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+	// Validate ID format
+	if err := validateIDFormat(workItem.ID, cfg); err != nil {
+		result.AddError(file, err.Error())
+	}
 
-		// Skip directories and non-markdown files
-		if info.IsDir() || !strings.HasSuffix(path, ".md") {
-			return nil
-		}
+	// Validate status values
+	if err := validateStatus(workItem.Status, cfg); err != nil {
+		result.AddError(file, err.Error())
+	}
 
-		// Skip template files and IDEAS.md
-		if strings.Contains(path, "template") || strings.HasSuffix(path, "IDEAS.md") {
-			return nil
+	// Validate date formats (uses field config if available, falls back to hardcoded logic)
+	if err := validateDateFormats(workItem, cfg); err != nil {
+		result.AddError(file, err.Error())
+	}
+
+	// Validate configured fields
+	if err := validateConfiguredFields(workItem, cfg); err != nil {
+		result.AddError(file, err.Error())
+	}
+
+	// Validate unknown fields in strict mode
+	if cfg.Validation.Strict {
+		if err := validateUnknownFields(workItem, cfg, file); err != nil {
+			result.AddError(file, err.Error())
 		}
+	}
+}
 
-		files = append(files, path)
-		return nil
-	})
+// anyFileIncluded reports whether include accepts one of files (always, when include is nil).
+func anyFileIncluded(files []string, include func(file string) bool) bool {
+	if include == nil {
+		return true
+	}
+	for _, file := range files {
+		if include(file) {
+			return true
+		}
+	}
+	return false
+}
 
-	return files, err
+func getWorkItemFiles(cfg *config.Config) ([]string, error) {
+	return WorkItemFiles(cfg, config.GetWorkFolderPath(cfg))
 }
 
 // validateWorkItemPath ensures a work item path is safe and within the work directory.
@@ -252,6 +261,9 @@ func parseWorkItemFile(
 	wi := &WorkItem{Fields: make(map[string]interface{})}
 	if len(yamlLines) > 0 {
 		if err := yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), wi); err != nil {
+			if tabErr := FrontMatterTabError(yamlLines); tabErr != nil {
+				err = tabErr
+			}
 			return nil, fmt.Errorf("failed to parse front matter: %w", err)
 		}
 	}
@@ -1170,6 +1182,35 @@ func GetNextID(cfg *config.Config) (string, error) {
 		return "", fmt.Errorf("failed to get work item files: %w", err)
 	}
 
+	nextID := maxWorkItemID(files, workDirAbs) + 1
+	return fmt.Sprintf("%0*d", idWidth(cfg), nextID), nil
+}
+
+// GetNextIDInFolder generates the next available work item ID among the work items under folder
+// (a status folder path as built from the work folder path), for naming.per_folder_ids.
+func GetNextIDInFolder(cfg *config.Config, folder string) (string, error) {
+	workDirAbs, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve work folder: %w", err)
+	}
+	files, err := getWorkItemFiles(cfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to get work item files: %w", err)
+	}
+
+	prefix := filepath.Clean(folder) + string(filepath.Separator)
+	var inFolder []string
+	for _, file := range files {
+		if strings.HasPrefix(filepath.Clean(file), prefix) {
+			inFolder = append(inFolder, file)
+		}
+	}
+	nextID := maxWorkItemID(inFolder, workDirAbs) + 1
+	return fmt.Sprintf("%0*d", idWidth(cfg), nextID), nil
+}
+
+// maxWorkItemID returns the highest numeric ID of the work items in files (0 when none).
+func maxWorkItemID(files []string, workDirAbs string) int {
 	var maxID int
 	for _, file := range files {
 		workItem, err := parseWorkItemFile(file, workDirAbs)
@@ -1177,15 +1218,221 @@ func GetNextID(cfg *config.Config) (string, error) {
 			continue
 		}
 
-		if id, err := strconv.Atoi(workItem.ID); err == nil {
-			if id > maxID {
-				maxID = id
-			}
+		if id, err := strconv.Atoi(workItem.ID); err == nil && id > maxID {
+			maxID = id
 		}
 	}
+	return maxID
+}
+
+// duplicateIDKey identifies an ID within the scope it must be unique in.
+type duplicateIDKey struct {
+	scope string
+	id    string
+}
 
-	nextID := maxID + 1
-	return fmt.Sprintf("%03d", nextID), nil
+// idScope returns the scope work item IDs must be unique in: the work item's status folder with
+// naming.per_folder_ids, else "" (the whole work folder).
+func idScope(file string, cfg *config.Config) string {
+	if !config.PerFolderIDs(cfg) {
+		return ""
+	}
+	rel, err := filepath.Rel(config.GetWorkFolderPath(cfg), file)
+	if err != nil {
+		return filepath.Dir(file)
+	}
+	return strings.SplitN(filepath.ToSlash(rel), "/", 2)[0]
+}
+
+// idWidth returns the number of digits IDs are zero-padded to.
+func idWidth(cfg *config.Config) int {
+	if cfg.Validation.IDWidth > 0 {
+		return cfg.Validation.IDWidth
+	}
+	return 3
+}
+
+// workItemFilenameIDRegexp matches the numeric prefix of a work item filename (001 in 001-title.prd.md).
+var workItemFilenameIDRegexp = regexp.MustCompile(`^(\d+)`)
+
+// idFromFilename infers a work item ID from the numeric prefix of its filename, zero-padded to
+// validation.id_width. Returns false when the filename has no numeric prefix.
+func idFromFilename(filePath string, cfg *config.Config) (string, bool) {
+	match := workItemFilenameIDRegexp.FindString(filepath.Base(filePath))
+	if match == "" {
+		return "", false
+	}
+	if pad := idWidth(cfg) - len(match); pad > 0 {
+		match = strings.Repeat("0", pad) + match
+	}
+	return match, true
+}
+
+// frontMatterTabWidth is the number of spaces FixFrontMatterTabs writes for each leading tab.
+const frontMatterTabWidth = 2
+
+// FrontMatterTabError returns an error naming the first front matter line indented with a tab, or
+// nil when there is none. YAML does not allow tabs for indentation, and the parser's own error for
+// them ("found character that cannot start any token") does not say so. yamlLines are the lines
+// between the --- separators; the line number counts from the top of the file.
+func FrontMatterTabError(yamlLines []string) error {
+	if i := firstTabIndentedLine(yamlLines); i >= 0 {
+		return fmt.Errorf("line %d: YAML uses tabs for indentation; use spaces", i+2)
+	}
+	return nil
+}
+
+// firstTabIndentedLine returns the index of the first line whose indentation contains a tab, or -1.
+func firstTabIndentedLine(lines []string) int {
+	for i, line := range lines {
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if strings.Contains(indent, "\t") {
+			return i
+		}
+	}
+	return -1
+}
+
+// FixFrontMatterTabs replaces the tabs in the indentation of front matter lines with spaces, in
+// the work items whose front matter does not parse because of them. Work items that parse, or
+// that still fail to parse with spaces, are left unchanged.
+func FixFrontMatterTabs(cfg *config.Config) (*ValidationResult, error) {
+	result := &ValidationResult{}
+
+	workDirAbs, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve work folder: %w", err)
+	}
+	files, err := getWorkItemFiles(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work item files: %w", err)
+	}
+
+	for _, file := range files {
+		fixed, err := fixFrontMatterTabsInFile(file, workDirAbs)
+		switch {
+		case err != nil:
+			result.AddError(file, fmt.Sprintf("failed to fix tab indentation: %v", err))
+		case fixed > 0:
+			result.AddError(file, fmt.Sprintf("fixed tab indentation: %d front matter lines now use spaces", fixed))
+		}
+	}
+
+	return result, nil
+}
+
+// fixFrontMatterTabsInFile rewrites the tab-indented front matter lines of one work item and
+// returns how many lines it changed.
+func fixFrontMatterTabsInFile(filePath, workDirAbs string) (int, error) {
+	content, err := safeReadWorkItemFile(filePath, workDirAbs)
+	if err != nil {
+		return 0, err
+	}
+	lines := strings.Split(string(content), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != yamlSeparator {
+		return 0, nil
+	}
+	end := 1
+	for end < len(lines) && strings.TrimSpace(lines[end]) != yamlSeparator {
+		end++
+	}
+	yamlLines := lines[1:end]
+	if firstTabIndentedLine(yamlLines) < 0 {
+		return 0, nil
+	}
+	var probe map[string]interface{}
+	if yaml.Unmarshal([]byte(strings.Join(yamlLines, "\n")), &probe) == nil {
+		return 0, nil
+	}
+
+	fixedLines := make([]string, len(yamlLines))
+	fixed := 0
+	for i, line := range yamlLines {
+		body := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(body)]
+		fixedLines[i] = strings.ReplaceAll(indent, "\t", strings.Repeat(" ", frontMatterTabWidth)) + body
+		if fixedLines[i] != line {
+			fixed++
+		}
+	}
+	if err := yaml.Unmarshal([]byte(strings.Join(fixedLines, "\n")), &probe); err != nil {
+		return 0, fmt.Errorf("front matter still does not parse with spaces: %w", err)
+	}
+	copy(yamlLines, fixedLines)
+	return fixed, os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0o600)
+}
+
+// FixMissingIDs writes an ID into work items whose front matter lacks one, inferred from the
+// filename's numeric prefix. Items without a numeric prefix, or whose inferred ID is already
+// used by another work item, are reported as failures and left unchanged.
+func FixMissingIDs(cfg *config.Config) (*ValidationResult, error) {
+	result := &ValidationResult{}
+
+	workDirAbs, err := config.GetWorkFolderAbsPath(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve work folder: %w", err)
+	}
+	files, err := getWorkItemFiles(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work item files: %w", err)
+	}
+
+	usedIDs := make(map[string]string)
+	var missing []string
+	for _, file := range files {
+		workItem, err := parseWorkItemFile(file, workDirAbs)
+		if err != nil {
+			continue
+		}
+		if workItem.ID == "" {
+			missing = append(missing, file)
+			continue
+		}
+		usedIDs[workItem.ID] = file
+	}
+
+	for _, file := range missing {
+		id, ok := idFromFilename(file, cfg)
+		if !ok {
+			result.AddError(file, "failed to fix missing id: filename has no numeric prefix")
+			continue
+		}
+		if other, used := usedIDs[id]; used {
+			result.AddError(file, fmt.Sprintf("failed to fix missing id: id %s from the filename is already used by %s", id, other))
+			continue
+		}
+		if err := setWorkItemID(file, id, workDirAbs); err != nil {
+			result.AddError(file, fmt.Sprintf("failed to fix missing id: %v", err))
+			continue
+		}
+		usedIDs[id] = file
+		result.AddError(file, fmt.Sprintf("fixed missing id: set to %s from the filename", id))
+	}
+
+	return result, nil
+}
+
+// setWorkItemID sets the id field of a work item, replacing an empty id line or inserting one at
+// the top of the front matter. The ID is quoted so YAML keeps its leading zeros.
+func setWorkItemID(filePath, id string, workDirAbs string) error {
+	content, err := safeReadWorkItemFile(filePath, workDirAbs)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != yamlSeparator {
+		return fmt.Errorf("no front matter")
+	}
+	idLine := fmt.Sprintf("id: %s", yamlQuotedString(id))
+	for i := 1; i < len(lines) && strings.TrimSpace(lines[i]) != yamlSeparator; i++ {
+		if strings.HasPrefix(lines[i], "id:") {
+			lines[i] = idLine
+			return os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0o600)
+		}
+	}
+	lines = append(lines[:1], append([]string{idLine}, lines[1:]...)...)
+	return os.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0o600)
 }
 
 // FixDuplicateIDs fixes duplicate work item IDs by assigning new IDs.
@@ -1201,18 +1448,19 @@ func FixDuplicateIDs(cfg *config.Config) (*ValidationResult, error) {
 		return nil, fmt.Errorf("failed to get work item files: %w", err)
 	}
 
-	// Group files by ID
-	idGroups := make(map[string][]string)
+	// Group files by ID (per status folder with naming.per_folder_ids)
+	idGroups := make(map[duplicateIDKey][]string)
 	for _, file := range files {
 		workItem, err := parseWorkItemFile(file, workDirAbs)
 		if err != nil {
 			continue
 		}
-		idGroups[workItem.ID] = append(idGroups[workItem.ID], file)
+		key := duplicateIDKey{scope: idScope(file, cfg), id: workItem.ID}
+		idGroups[key] = append(idGroups[key], file)
 	}
 
 	// Fix duplicates by assigning new IDs to newer files
-	for _, files := range idGroups {
+	for key, files := range idGroups {
 		if len(files) > 1 {
 			// Sort files by modification time (newest first)
 			sort.Slice(files, func(i, j int) bool {
@@ -1223,7 +1471,7 @@ func FixDuplicateIDs(cfg *config.Config) (*ValidationResult, error) {
 
 			// Keep the oldest file with the original ID, assign new IDs to others
 			for i := 1; i < len(files); i++ {
-				newID, err := GetNextID(cfg)
+				newID, err := nextIDInScope(cfg, key.scope)
 				if err != nil {
 					result.AddError(files[i], fmt.Sprintf("failed to generate new ID: %v", err))
 					continue
@@ -1240,6 +1488,15 @@ func FixDuplicateIDs(cfg *config.Config) (*ValidationResult, error) {
 	return result, nil
 }
 
+// nextIDInScope generates the next available ID in scope, as returned by idScope: the work
+// folder, or a status folder with naming.per_folder_ids.
+func nextIDInScope(cfg *config.Config, scope string) (string, error) {
+	if scope == "" {
+		return GetNextID(cfg)
+	}
+	return GetNextIDInFolder(cfg, filepath.Join(config.GetWorkFolderPath(cfg), scope))
+}
+
 func updateWorkItemID(
 	filePath, newID string,
 	workDirAbs string,
@@ -1415,22 +1672,22 @@ func isHardcodedField(fieldName string) bool {
 }
 
 // validateUnknownFields checks for fields that are not defined in the configuration.
-// This is only called when strict mode is enabled.
+// This is only called when strict mode is enabled. The kira: config override block is always allowed.
 func validateUnknownFields(workItem *WorkItem, cfg *config.Config, _ string) error {
 	var unknownFields []string
 
 	if len(cfg.Fields) == 0 {
 		// If no fields are configured, all custom fields are unknown in strict mode
 		for fieldName := range workItem.Fields {
-			if !isHardcodedField(fieldName) {
+			if !isHardcodedField(fieldName) && fieldName != config.WorkItemOverrideField {
 				unknownFields = append(unknownFields, fieldName)
 			}
 		}
 	} else {
 		// Check each field against configuration
 		for fieldName := range workItem.Fields {
-			// Skip hardcoded fields
-			if isHardcodedField(fieldName) {
+			// Skip hardcoded fields and the config override block
+			if isHardcodedField(fieldName) || fieldName == config.WorkItemOverrideField {
 				continue
 			}
 			// Check if field is configured
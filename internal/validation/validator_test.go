@@ -145,6 +145,131 @@ created: 2024-01-01
 	})
 }
 
+func TestPerFolderIDs(t *testing.T) {
+	workItem := func(id, status string) string {
+		return "---\nid: " + id + "\ntitle: Item " + id + "\nstatus: " + status + "\nkind: prd\ncreated: 2024-01-01\n---\n\n# Item\n"
+	}
+	setup := func(t *testing.T) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		require.NoError(t, os.MkdirAll(".work/2_doing", 0o700))
+		require.NoError(t, os.WriteFile(".work/1_todo/001-a.prd.md", []byte(workItem("001", "todo")), 0o600))
+		require.NoError(t, os.WriteFile(".work/1_todo/004-b.prd.md", []byte(workItem("004", "todo")), 0o600))
+		require.NoError(t, os.WriteFile(".work/2_doing/001-c.prd.md", []byte(workItem("001", "doing")), 0o600))
+	}
+
+	t.Run("next id is scoped to the folder", func(t *testing.T) {
+		setup(t)
+		cfg := defaultTestConfig(t)
+
+		id, err := GetNextIDInFolder(cfg, ".work/2_doing")
+		require.NoError(t, err)
+		assert.Equal(t, "002", id)
+
+		id, err = GetNextIDInFolder(cfg, ".work/3_review")
+		require.NoError(t, err)
+		assert.Equal(t, "001", id)
+	})
+
+	t.Run("the same id in different folders is only a duplicate with global ids", func(t *testing.T) {
+		setup(t)
+		cfg := defaultTestConfig(t)
+
+		result, err := ValidateWorkItems(cfg)
+		require.NoError(t, err)
+		assert.Contains(t, result.Error(), "duplicate ID found: 001")
+
+		cfg.Naming = &config.NamingConfig{PerFolderIDs: true}
+		result, err = ValidateWorkItems(cfg)
+		require.NoError(t, err)
+		assert.NotContains(t, result.Error(), "duplicate ID")
+	})
+
+	t.Run("fix leaves the same id in different folders alone", func(t *testing.T) {
+		setup(t)
+		cfg := defaultTestConfig(t)
+		cfg.Naming = &config.NamingConfig{PerFolderIDs: true}
+
+		result, err := FixDuplicateIDs(cfg)
+		require.NoError(t, err)
+		assert.False(t, result.HasErrors())
+		for _, path := range []string{".work/1_todo/001-a.prd.md", ".work/2_doing/001-c.prd.md"} {
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "id: 001\n", path)
+		}
+	})
+
+	t.Run("fix numbers a duplicate within its folder", func(t *testing.T) {
+		setup(t)
+		require.NoError(t, os.WriteFile(".work/2_doing/001-d.prd.md", []byte(workItem("001", "doing")), 0o600))
+		cfg := defaultTestConfig(t)
+		cfg.Naming = &config.NamingConfig{PerFolderIDs: true}
+
+		result, err := FixDuplicateIDs(cfg)
+		require.NoError(t, err)
+		assert.False(t, result.HasErrors())
+		var ids []string
+		for _, path := range []string{".work/2_doing/001-c.prd.md", ".work/2_doing/001-d.prd.md"} {
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+			ids = append(ids, strings.SplitN(string(content), "\n", 3)[1])
+		}
+		assert.ElementsMatch(t, []string{"id: 001", "id: 002"}, ids, "numbered after 2_doing's own ids, not 1_todo's 004")
+	})
+}
+
+func TestWorkItemFiles(t *testing.T) {
+	setup := func(t *testing.T) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+		for _, dir := range []string{".work/2_doing/epic-x/part-1", ".work/2_doing/.drafts", ".work/templates"} {
+			require.NoError(t, os.MkdirAll(dir, 0o700))
+		}
+		for _, path := range []string{
+			".work/IDEAS.md",
+			".work/2_doing/001-top.prd.md",
+			".work/2_doing/epic-x/002-nested.prd.md",
+			".work/2_doing/epic-x/part-1/003-deeper.prd.md",
+			".work/2_doing/.drafts/004-hidden.prd.md",
+			".work/templates/template.prd.md",
+		} {
+			require.NoError(t, os.WriteFile(path, []byte(minimalWorkItemContent), 0o600))
+		}
+	}
+
+	t.Run("scans every level below the status folders by default", func(t *testing.T) {
+		setup(t)
+		files, err := WorkItemFiles(defaultTestConfig(t), ".work")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			".work/2_doing/001-top.prd.md",
+			".work/2_doing/epic-x/002-nested.prd.md",
+			".work/2_doing/epic-x/part-1/003-deeper.prd.md",
+		}, files)
+	})
+
+	t.Run("stops at discovery.max_depth", func(t *testing.T) {
+		setup(t)
+		cfg := defaultTestConfig(t)
+		maxDepth := 2
+		cfg.Discovery = &config.DiscoveryConfig{MaxDepth: &maxDepth}
+
+		files, err := WorkItemFiles(cfg, ".work")
+		require.NoError(t, err)
+		assert.Equal(t, []string{".work/2_doing/001-top.prd.md", ".work/2_doing/epic-x/002-nested.prd.md"}, files)
+
+		files, err = StatusWorkItemFiles(cfg, ".work", ".work/2_doing")
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+	})
+}
+
 func TestFixDuplicateIDs(t *testing.T) {
 	t.Run("fixes duplicate IDs", func(t *testing.T) {
 		// Create a temporary workspace
@@ -189,6 +314,146 @@ created: 2024-01-02
 	})
 }
 
+func TestFixMissingIDs(t *testing.T) {
+	setup := func(t *testing.T, files map[string]string) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(".work/1_todo", name), []byte(content), 0o600))
+		}
+		return defaultTestConfig(t)
+	}
+	noID := "---\ntitle: Truncated\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n---\n\n# Truncated\n"
+
+	t.Run("infers id from filename prefix padded to id width", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"7-truncated.prd.md": noID})
+
+		result, err := FixMissingIDs(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "fixed missing id: set to 007 from the filename", result.Errors[0].Message)
+
+		content, err := os.ReadFile(".work/1_todo/7-truncated.prd.md")
+		require.NoError(t, err)
+		assert.Equal(t, "---\nid: \"007\"\ntitle: Truncated\nstatus: todo\nkind: prd\ncreated: 2024-01-01\n---\n\n# Truncated\n", string(content))
+
+		workItem, err := parseWorkItemFile(".work/1_todo/7-truncated.prd.md", cfg.ConfigDir)
+		require.NoError(t, err)
+		assert.Equal(t, "007", workItem.ID, "quoted id keeps its leading zeros")
+	})
+
+	t.Run("replaces an empty id line and honors id_width", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"12-empty.prd.md": "---\nid:\ntitle: Empty\n---\n"})
+		cfg.Validation.IDFormat = "^\\d{4}$"
+		cfg.Validation.IDWidth = 4
+
+		result, err := FixMissingIDs(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+
+		content, err := os.ReadFile(".work/1_todo/12-empty.prd.md")
+		require.NoError(t, err)
+		assert.Equal(t, "---\nid: \"0012\"\ntitle: Empty\n---\n", string(content))
+	})
+
+	t.Run("reports filenames without a numeric prefix as unfixable", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"truncated.prd.md": noID})
+
+		result, err := FixMissingIDs(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "failed to fix missing id: filename has no numeric prefix", result.Errors[0].Message)
+
+		content, err := os.ReadFile(".work/1_todo/truncated.prd.md")
+		require.NoError(t, err)
+		assert.Equal(t, noID, string(content))
+	})
+
+	t.Run("does not reuse an id held by another work item", func(t *testing.T) {
+		cfg := setup(t, map[string]string{
+			"001-existing.prd.md":  minimalWorkItemContent,
+			"001-truncated.prd.md": noID,
+		})
+
+		result, err := FixMissingIDs(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Contains(t, result.Errors[0].Message, "failed to fix missing id: id 001 from the filename is already used by")
+
+		content, err := os.ReadFile(".work/1_todo/001-truncated.prd.md")
+		require.NoError(t, err)
+		assert.Equal(t, noID, string(content))
+	})
+
+	t.Run("leaves items with an id alone", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"002-other.prd.md": minimalWorkItemContent})
+
+		result, err := FixMissingIDs(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, result.Errors)
+	})
+}
+
+func TestFrontMatterTabs(t *testing.T) {
+	setup := func(t *testing.T, files map[string]string) *config.Config {
+		t.Helper()
+		tmpDir := t.TempDir()
+		require.NoError(t, os.Chdir(tmpDir))
+		t.Cleanup(func() { _ = os.Chdir("/") })
+		require.NoError(t, os.MkdirAll(".work/1_todo", 0o700))
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(".work/1_todo", name), []byte(content), 0o600))
+		}
+		return defaultTestConfig(t)
+	}
+	tabbed := "---\nid: \"001\"\ntitle: Tabbed\nstatus: todo\nkind: prd\ncreated: 2024-01-01\ntags:\n\t- api\n\t- auth\n---\n\n# Tabbed\n"
+
+	t.Run("reports the first tab-indented line instead of the generic parse error", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"001-tabbed.prd.md": tabbed})
+
+		_, err := parseWorkItemFile(".work/1_todo/001-tabbed.prd.md", cfg.ConfigDir)
+		require.Error(t, err)
+		assert.Equal(t, "failed to parse front matter: line 8: YAML uses tabs for indentation; use spaces", err.Error())
+
+		result, err := ValidateWorkItems(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Contains(t, result.Errors[0].Message, "line 8: YAML uses tabs for indentation; use spaces")
+	})
+
+	t.Run("ignores tabs outside the indentation", func(t *testing.T) {
+		assert.NoError(t, FrontMatterTabError([]string{"title: a\tb", "tags:", "  - api"}))
+	})
+
+	t.Run("fix replaces leading tabs with spaces", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"001-tabbed.prd.md": tabbed})
+
+		result, err := FixFrontMatterTabs(cfg)
+		require.NoError(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "fixed tab indentation: 2 front matter lines now use spaces", result.Errors[0].Message)
+
+		content, err := os.ReadFile(".work/1_todo/001-tabbed.prd.md")
+		require.NoError(t, err)
+		assert.Equal(t, strings.ReplaceAll(tabbed, "\t", "  "), string(content))
+
+		workItem, err := parseWorkItemFile(".work/1_todo/001-tabbed.prd.md", cfg.ConfigDir)
+		require.NoError(t, err)
+		assert.Equal(t, "Tabbed", workItem.Title)
+	})
+
+	t.Run("fix leaves front matter that parses alone", func(t *testing.T) {
+		cfg := setup(t, map[string]string{"002-other.prd.md": minimalWorkItemContent})
+
+		result, err := FixFrontMatterTabs(cfg)
+		require.NoError(t, err)
+		assert.Empty(t, result.Errors)
+	})
+}
+
 func TestFieldValidation(t *testing.T) {
 	t.Run("validates string field with format", func(t *testing.T) {
 		tmpDir := t.TempDir()